@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/config"
+	"github.com/wonjinsin/simple-chatbot/internal/database"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+)
+
+func main() {
+	// Set timezone to UTC for the entire program
+	time.Local = time.UTC
+
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize PostgreSQL database connection
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("failed to initialize database: %v", err)
+	}
+	defer db.Close()
+
+	entClient := database.NewEntClient(db, cfg)
+	defer entClient.Close()
+
+	svc := usecase.NewAPIKeyServiceImpl(postgres.NewAPIKeyRepository(entClient))
+	ctx := context.Background()
+
+	command, args := os.Args[1], os.Args[2:]
+
+	switch command {
+	case "create":
+		fs := flag.NewFlagSet("create", flag.ExitOnError)
+		name := fs.String("name", "", "key name (e.g. the client it is issued to)")
+		scopes := fs.String("scopes", "ask", "comma-separated scopes: ask, ingest, admin")
+		_ = fs.Parse(args)
+
+		key, token, err := svc.CreateAPIKey(ctx, *name, strings.Split(*scopes, ","))
+		if err != nil {
+			log.Fatalf("Failed to create api key: %v", err)
+		}
+		printToken(key, token)
+
+	case "rotate":
+		fs := flag.NewFlagSet("rotate", flag.ExitOnError)
+		id := fs.String("id", "", "key ID of the key to rotate")
+		_ = fs.Parse(args)
+
+		key, token, err := svc.RotateAPIKey(ctx, *id)
+		if err != nil {
+			log.Fatalf("Failed to rotate api key: %v", err)
+		}
+		fmt.Printf("Revoked api key %s\n", *id)
+		printToken(key, token)
+
+	case "revoke":
+		fs := flag.NewFlagSet("revoke", flag.ExitOnError)
+		id := fs.String("id", "", "key ID of the key to revoke")
+		_ = fs.Parse(args)
+
+		if err := svc.RevokeAPIKey(ctx, *id); err != nil {
+			log.Fatalf("Failed to revoke api key: %v", err)
+		}
+		fmt.Printf("Revoked api key %s\n", *id)
+
+	case "list":
+		keys, err := svc.ListAPIKeys(ctx)
+		if err != nil {
+			log.Fatalf("Failed to list api keys: %v", err)
+		}
+		printKeys(keys)
+
+	default:
+		fmt.Printf("Unknown command: %s\n", command)
+		printUsage()
+		os.Exit(1)
+	}
+}
+
+// printToken prints a newly issued key; the token cannot be shown again
+func printToken(key *domain.APIKey, token string) {
+	fmt.Printf("Created api key %s (%s) with scopes %s\n",
+		key.KeyID, key.Name, strings.Join(key.Scopes.Strings(), ","))
+	fmt.Println("Store this token now, it will not be shown again:")
+	fmt.Println(token)
+}
+
+// printKeys prints API keys as a table
+func printKeys(keys domain.APIKeys) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY ID\tNAME\tSCOPES\tCREATED\tLAST USED\tREVOKED")
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			key.KeyID,
+			key.Name,
+			strings.Join(key.Scopes.Strings(), ","),
+			key.CreatedAt.Format(time.RFC3339),
+			formatOptionalTime(key.LastUsedAt),
+			formatOptionalTime(key.RevokedAt),
+		)
+	}
+	_ = w.Flush()
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func printUsage() {
+	fmt.Println("Usage: go run cmd/apikey/main.go [command] [flags]")
+	fmt.Println("\nCommands:")
+	fmt.Println("  create -name <name> [-scopes ask,ingest,admin]  - Issue a new api key")
+	fmt.Println("  rotate -id <key id>                             - Replace a key with a new one")
+	fmt.Println("  revoke -id <key id>                             - Revoke a key")
+	fmt.Println("  list                                            - List api keys")
+}
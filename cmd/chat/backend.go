@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	pkgConstants "github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// chatRequest is one turn of the REPL conversation
+type chatRequest struct {
+	messages domain.ChatMessages
+	filter   domain.KnowledgeFilter
+	debug    bool // Return the rendered prompt with the answer
+	// onChunk receives the answer as it is generated; nil answers in a single response
+	onChunk func(chunk string) error
+}
+
+// chatBackend answers REPL turns, either in-process or through a running server
+type chatBackend interface {
+	Chat(ctx context.Context, req chatRequest) (*domain.Answer, error)
+}
+
+// inProcessBackend answers with the inquiry service running inside the REPL
+type inProcessBackend struct {
+	svc usecase.InquiryService
+}
+
+// Chat answers the conversation with the in-process inquiry service
+func (b *inProcessBackend) Chat(ctx context.Context, req chatRequest) (*domain.Answer, error) {
+	if req.onChunk != nil {
+		return b.svc.ChatStream(ctx, req.messages, req.filter, req.onChunk)
+	}
+	return b.svc.Chat(ctx, req.messages, req.filter)
+}
+
+// remoteBackend answers through the OpenAI-compatible API of a running server
+type remoteBackend struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+}
+
+// completionEvent is a chat completion (chunk) or the error event that replaces it
+type completionEvent struct {
+	dto.ChatCompletionResponse
+	Error *dto.OpenAIError `json:"error"`
+}
+
+// Chat answers the conversation with POST /v1/chat/completions
+func (b *remoteBackend) Chat(ctx context.Context, req chatRequest) (*domain.Answer, error) {
+	body := dto.ChatCompletionRequest{
+		Messages: dto.ToChatCompletionMessages(req.messages),
+		Stream:   req.onChunk != nil,
+		Debug:    req.debug,
+	}
+	if req.filter.Category != "" {
+		body.Filter = &dto.ChatCompletionFilter{Category: req.filter.Category}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode chat completion request")
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		strings.TrimRight(b.baseURL, "/")+"/v1/chat/completions",
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create chat completion request")
+	}
+	httpReq.Header.Set(pkgConstants.HeaderContentType, pkgConstants.ContentTypeJSONCharset)
+	if b.apiKey != "" {
+		httpReq.Header.Set(pkgConstants.HeaderAuthorization, "Bearer "+b.apiKey)
+	}
+
+	resp, err := b.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call server", constants.UpstreamError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var event completionEvent
+		if err := json.NewDecoder(resp.Body).Decode(&event); err != nil || event.Error == nil {
+			return nil, errors.New(
+				constants.UpstreamError,
+				"server responded with "+resp.Status,
+				err,
+			)
+		}
+		return nil, toServerError(event.Error)
+	}
+
+	if req.onChunk == nil {
+		var event completionEvent
+		if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+			return nil, errors.Wrap(err, "failed to decode chat completion", constants.UpstreamError)
+		}
+		return toDomainAnswer(&event.ChatCompletionResponse), nil
+	}
+	return readCompletionStream(resp, req.onChunk)
+}
+
+// readCompletionStream emits the streamed answer chunk by chunk and returns the full answer
+// with the sources and prompt of the last chunk
+func readCompletionStream(
+	resp *http.Response,
+	onChunk func(chunk string) error,
+) (*domain.Answer, error) {
+	var text strings.Builder
+	answer := &domain.Answer{}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		if data == "[DONE]" {
+			answer.Text = text.String()
+			return answer, nil
+		}
+
+		var event completionEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return nil, errors.Wrap(err, "failed to decode stream event", constants.UpstreamError)
+		}
+		if event.Error != nil {
+			return nil, toServerError(event.Error)
+		}
+
+		for _, choice := range event.Choices {
+			if choice.Delta == nil || choice.Delta.Content == "" {
+				continue
+			}
+			text.WriteString(string(choice.Delta.Content))
+			if err := onChunk(string(choice.Delta.Content)); err != nil {
+				return nil, errors.Wrap(err, "failed to emit stream chunk")
+			}
+		}
+		if len(event.Sources) > 0 || len(event.Prompt) > 0 {
+			details := toDomainAnswer(&event.ChatCompletionResponse)
+			answer.Sources, answer.Prompt = details.Sources, details.Prompt
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read stream", constants.UpstreamError)
+	}
+	return nil, errors.New(constants.UpstreamError, "stream ended before [DONE]", nil)
+}
+
+// toServerError converts an OpenAI-compatible error to an error with the server's code
+func toServerError(e *dto.OpenAIError) error {
+	return errors.New(constants.ErrorCode(e.Code), e.Message, nil)
+}
+
+// toDomainAnswer converts a chat completion to a domain answer with its sources and prompt
+func toDomainAnswer(resp *dto.ChatCompletionResponse) *domain.Answer {
+	answer := &domain.Answer{}
+	for _, choice := range resp.Choices {
+		if choice.Message != nil {
+			answer.Text = string(choice.Message.Content)
+		}
+	}
+	for _, source := range resp.Sources {
+		answer.Sources = append(answer.Sources, &domain.InquirySimilarityResult{
+			Knowledge: &domain.InquiryKnowledge{
+				ID:          source.KnowledgeID,
+				Instruction: source.Instruction,
+				Category:    source.Category,
+				Intent:      source.Intent,
+			},
+			SimilarityScore: source.SimilarityScore,
+		})
+	}
+	for _, msg := range resp.Prompt {
+		answer.Prompt = append(answer.Prompt, &domain.ChatMessage{
+			Role:    domain.ChatRole(msg.Role),
+			Content: string(msg.Content),
+		})
+	}
+	return answer
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/config"
+	"github.com/wonjinsin/simple-chatbot/internal/database"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/filesystem"
+	chatgptRepo "github.com/wonjinsin/simple-chatbot/internal/repository/langchain/chatGPT"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+func main() {
+	// Set timezone to UTC for the entire program
+	time.Local = time.UTC
+
+	server := flag.String("server", "", "base URL of a running server (empty = run the service in-process)")
+	apiKey := flag.String("api-key", os.Getenv("CHAT_API_KEY"), "API key for -server (default CHAT_API_KEY)")
+	stream := flag.Bool("stream", true, "stream answers as they are generated")
+	flag.Parse()
+
+	var backend chatBackend
+	if *server != "" {
+		backend = &remoteBackend{
+			client:  &http.Client{Timeout: 2 * time.Minute},
+			baseURL: *server,
+			apiKey:  *apiKey,
+		}
+		fmt.Printf("Connected to %s\n", *server)
+	} else {
+		inProcess, closeBackend := newInProcessBackend()
+		defer closeBackend()
+		backend = inProcess
+		fmt.Println("Running the service in-process")
+	}
+	fmt.Println("Type a question, or /help for commands")
+
+	repl := &chatREPL{backend: backend, stream: *stream, out: os.Stdout}
+	repl.run(context.Background(), os.Stdin)
+}
+
+// newInProcessBackend wires the inquiry service against the configured database and provider
+func newInProcessBackend() (chatBackend, func()) {
+	// Load configuration
+	cfg := config.Load()
+
+	// Initialize chat model and embedder
+	llms, err := database.NewChatModels(cfg, cfg.OpenAIChatModel)
+	if err != nil {
+		log.Fatalf("Failed to initialize chat model: %v", err)
+	}
+	embedder, err := database.NewEmbedder(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize embedder: %v", err)
+	}
+	promptTemplateRepo, err := filesystem.NewPromptTemplateRepository(cfg.PromptTemplateDir)
+	if err != nil {
+		log.Fatalf("Failed to load prompt templates: %v", err)
+	}
+	experimentRepo, err := filesystem.NewExperimentRepository(cfg.ExperimentsFile)
+	if err != nil {
+		log.Fatalf("Failed to load experiments: %v", err)
+	}
+
+	// Initialize PostgreSQL database connection
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	entClient := database.NewEntClient(db, cfg)
+
+	var injectionClassifierRepo repository.InjectionClassifierRepository
+	if cfg.InjectionGuardLLM {
+		injectionClassifierRepo = chatgptRepo.NewInjectionClassifierRepository(
+			llms[cfg.OpenAIChatModel],
+			promptTemplateRepo,
+		)
+	}
+
+	svc := usecase.NewInquiryServiceImpl(usecase.InquiryServiceOptions{
+		EmbeddingRepo:           chatgptRepo.NewEmbeddingRepository(embedder),
+		KnowledgeRepo:           postgres.NewInquiryKnowledgeRepository(entClient),
+		AnswerRefineRepo:        chatgptRepo.NewAnswerRefineRepo(llms, cfg.OpenAIChatModel, promptTemplateRepo),
+		ExperimentRepo:          experimentRepo,
+		ExposureRepo:            postgres.NewExperimentExposureRepository(entClient),
+		ConversationRepo:        postgres.NewConversationRepository(entClient),
+		AuditRepo:               postgres.NewAuditRepository(entClient),
+		InjectionClassifierRepo: injectionClassifierRepo,
+		Ingestion: usecase.IngestionConfig{
+			Concurrency:     cfg.EmbedConcurrency,
+			TokensPerMinute: cfg.EmbedTokensPerMinute,
+		},
+		Guardrails: usecase.GuardrailConfig{
+			Injection: usecase.InjectionGuardConfig{
+				Enabled:         cfg.InjectionGuardEnabled,
+				Action:          domain.GuardAction(cfg.InjectionGuardAction),
+				ClassifyContext: cfg.InjectionGuardLLMContext,
+			},
+			PII: usecase.PIIConfig{
+				Enabled:        cfg.PIIRedactionEnabled,
+				RestoreAnswers: cfg.PIIRestoreAnswers,
+			},
+			Moderation: usecase.ModerationConfig{
+				Enabled: cfg.AnswerModerationEnabled,
+				Policy: domain.AnswerPolicy{
+					BannedPhrases:   cfg.AnswerPolicyBannedPhrases,
+					AllowedURLHosts: cfg.AnswerPolicyURLAllowlist,
+					MaxLength:       cfg.AnswerPolicyMaxLength,
+					RefundPromises:  cfg.AnswerPolicyRefundPromises,
+					MinGrounding:    cfg.AnswerPolicyMinGrounding,
+				},
+				Action:           domain.ModerationAction(cfg.AnswerModerationAction),
+				MaxRegenerations: cfg.AnswerModerationMaxRegenerations,
+				HandoffMessage:   cfg.AnswerHandoffMessage,
+			},
+		},
+		CompanySlots: domain.NewSlots(cfg.CompanySlots),
+	})
+
+	return &inProcessBackend{svc: svc}, func() {
+		_ = entClient.Close()
+		_ = db.Close()
+	}
+}
+
+// chatREPL reads questions and commands line by line and keeps the conversation history
+type chatREPL struct {
+	backend chatBackend
+	stream  bool
+	out     io.Writer
+
+	history domain.ChatMessages
+	filter  domain.KnowledgeFilter
+	debug   bool
+	last    *domain.Answer
+}
+
+// run reads lines from in until EOF or /exit
+func (r *chatREPL) run(ctx context.Context, in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(r.out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(r.out)
+			return
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "/"):
+			if !r.command(line) {
+				return
+			}
+		default:
+			r.ask(ctx, line)
+		}
+	}
+}
+
+// command runs a REPL command and reports whether the REPL should keep running
+func (r *chatREPL) command(line string) bool {
+	name, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch name {
+	case "/exit", "/quit":
+		return false
+
+	case "/help":
+		printHelp(r.out)
+
+	case "/reset":
+		r.history, r.last = nil, nil
+		fmt.Fprintln(r.out, "Conversation history cleared")
+
+	case "/sources":
+		if r.last == nil {
+			fmt.Fprintln(r.out, "No answer yet")
+			break
+		}
+		printSources(r.out, r.last.Sources)
+
+	case "/filter":
+		switch {
+		case arg == "":
+		case arg == "off":
+			r.filter = domain.KnowledgeFilter{}
+		default:
+			key, value, ok := strings.Cut(arg, "=")
+			if !ok || strings.TrimSpace(key) != "category" {
+				fmt.Fprintln(r.out, "Usage: /filter category=NAME | /filter off")
+				return true
+			}
+			r.filter.Category = strings.TrimSpace(value)
+		}
+		if r.filter.Category == "" {
+			fmt.Fprintln(r.out, "Filter: none")
+		} else {
+			fmt.Fprintf(r.out, "Filter: category=%s\n", r.filter.Category)
+		}
+
+	case "/debug":
+		r.debug = !r.debug
+		if r.debug {
+			fmt.Fprintln(r.out, "Debug on: the rendered prompt is printed after each answer")
+		} else {
+			fmt.Fprintln(r.out, "Debug off")
+		}
+
+	default:
+		fmt.Fprintf(r.out, "Unknown command: %s (try /help)\n", name)
+	}
+	return true
+}
+
+// ask sends the question with the conversation history and prints the answer
+func (r *chatREPL) ask(ctx context.Context, question string) {
+	messages := append(r.history[:len(r.history):len(r.history)], &domain.ChatMessage{
+		Role:    domain.ChatRoleUser,
+		Content: question,
+	})
+
+	req := chatRequest{messages: messages, filter: r.filter, debug: r.debug}
+	if r.stream {
+		req.onChunk = func(chunk string) error {
+			_, err := fmt.Fprint(r.out, chunk)
+			return err
+		}
+	}
+
+	answer, err := r.backend.Chat(ctx, req)
+	if err != nil {
+		fmt.Fprintf(r.out, "\nError [%s]: %s\n", errors.GetCode(err), errors.PublicMessage(err))
+		return
+	}
+	if !r.stream {
+		fmt.Fprint(r.out, answer.Text)
+	}
+	fmt.Fprintln(r.out)
+
+	r.history = append(messages, &domain.ChatMessage{
+		Role:    domain.ChatRoleAssistant,
+		Content: answer.Text,
+	})
+	r.last = answer
+
+	if r.debug {
+		printPrompt(r.out, answer.Prompt)
+	}
+}
+
+// printSources prints the retrieved knowledge of an answer with similarity scores
+func printSources(w io.Writer, sources domain.InquirySimilarityResults) {
+	if len(sources) == 0 {
+		fmt.Fprintln(w, "No knowledge was retrieved")
+		return
+	}
+	for i, source := range sources {
+		fmt.Fprintf(w, "%d. [%.4f] #%d %s/%s: %s\n",
+			i+1,
+			source.SimilarityScore,
+			source.Knowledge.ID,
+			source.Knowledge.Category,
+			source.Knowledge.Intent,
+			source.Knowledge.Instruction,
+		)
+	}
+}
+
+// printPrompt prints the rendered prompt of an answer message by message
+func printPrompt(w io.Writer, prompt domain.ChatMessages) {
+	if len(prompt) == 0 {
+		fmt.Fprintln(w, "(no rendered prompt was returned)")
+		return
+	}
+	fmt.Fprintln(w, "----- rendered prompt -----")
+	for _, msg := range prompt {
+		fmt.Fprintf(w, "[%s]\n%s\n\n", msg.Role, msg.Content)
+	}
+	fmt.Fprintln(w, "---------------------------")
+}
+
+func printHelp(w io.Writer) {
+	fmt.Fprintln(w, "Commands:")
+	fmt.Fprintln(w, "  /sources                Show the knowledge retrieved for the last answer")
+	fmt.Fprintln(w, "  /filter category=NAME   Only retrieve knowledge of a category (/filter off to clear)")
+	fmt.Fprintln(w, "  /debug                  Toggle printing the rendered prompt after each answer")
+	fmt.Fprintln(w, "  /reset                  Clear the conversation history")
+	fmt.Fprintln(w, "  /exit                   Quit")
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// answerReportFile is the JSON form of an answer quality report
+type answerReportFile struct {
+	Overall    answerMetricsFile           `json:"overall"`
+	Categories []categoryAnswerMetricsFile `json:"categories"`
+	Results    []answerResultFile          `json:"results"`
+}
+
+type answerMetricsFile struct {
+	Questions    int     `json:"questions"`
+	Failed       int     `json:"failed"`
+	Faithfulness float64 `json:"faithfulness"`
+	Relevance    float64 `json:"relevance"`
+	Completeness float64 `json:"completeness"`
+}
+
+type categoryAnswerMetricsFile struct {
+	Category string            `json:"category"`
+	Metrics  answerMetricsFile `json:"metrics"`
+}
+
+type answerResultFile struct {
+	Question        string             `json:"question"`
+	Category        string             `json:"category"`
+	ReferenceAnswer string             `json:"referenceAnswer"`
+	Answer          string             `json:"answer,omitempty"`
+	Sources         []answerSourceFile `json:"sources,omitempty"`
+	Faithfulness    int                `json:"faithfulness,omitempty"`
+	Relevance       int                `json:"relevance,omitempty"`
+	Completeness    int                `json:"completeness,omitempty"`
+	Reasoning       string             `json:"reasoning,omitempty"`
+	Error           string             `json:"error,omitempty"`
+}
+
+type answerSourceFile struct {
+	Instruction     string  `json:"instruction"`
+	Intent          string  `json:"intent"`
+	SimilarityScore float64 `json:"similarityScore"`
+}
+
+// writeAnswerReport writes the report as <out>.json and <out>.md
+func writeAnswerReport(out string, report *domain.AnswerEvalReport) error {
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(toAnswerReportFile(report), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(out+".json", append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+
+	var md strings.Builder
+	fmt.Fprintf(&md, "# Answer quality evaluation\n\n")
+	printAnswerReport(&md, report)
+	fmt.Fprintf(&md, "\n## Questions\n\n")
+	fmt.Fprintln(&md, "| Question | Category | Faithfulness | Relevance | Completeness | Notes |")
+	fmt.Fprintln(&md, "| -------- | -------- | -----------: | --------: | -----------: | ----- |")
+	for _, result := range report.Results {
+		if result.Judgement == nil {
+			fmt.Fprintf(&md, "| %s | %s | - | - | - | %s |\n",
+				markdownCell(result.Golden.Question), result.Golden.Category,
+				markdownCell(result.Error))
+			continue
+		}
+		fmt.Fprintf(&md, "| %s | %s | %d | %d | %d | %s |\n",
+			markdownCell(result.Golden.Question), result.Golden.Category,
+			result.Judgement.Faithfulness, result.Judgement.Relevance,
+			result.Judgement.Completeness, markdownCell(result.Judgement.Reasoning))
+	}
+	return os.WriteFile(out+".md", []byte(md.String()), 0o644)
+}
+
+// readAnswerReport reads the aggregates of a report written by writeAnswerReport
+func readAnswerReport(path string) (*domain.AnswerEvalReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f answerReportFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+
+	report := &domain.AnswerEvalReport{
+		Overall:    domain.AnswerQualityMetrics(f.Overall),
+		Categories: make([]domain.CategoryAnswerQualityMetrics, 0, len(f.Categories)),
+	}
+	for _, category := range f.Categories {
+		report.Categories = append(report.Categories, domain.CategoryAnswerQualityMetrics{
+			Category: category.Category,
+			Metrics:  domain.AnswerQualityMetrics(category.Metrics),
+		})
+	}
+	return report, nil
+}
+
+// printAnswerReport prints the aggregate scores as a Markdown table
+func printAnswerReport(w io.Writer, report *domain.AnswerEvalReport) {
+	fmt.Fprintln(w, "| Category | Questions | Failed | Faithfulness | Relevance | Completeness |")
+	fmt.Fprintln(w, "| -------- | --------: | -----: | -----------: | --------: | -----------: |")
+	row := func(name string, m domain.AnswerQualityMetrics) {
+		fmt.Fprintf(w, "| %s | %d | %d | %.2f | %.2f | %.2f |\n",
+			name, m.Questions, m.Failed, m.Faithfulness, m.Relevance, m.Completeness)
+	}
+	for _, category := range report.Categories {
+		row(category.Category, category.Metrics)
+	}
+	row("**overall**", report.Overall)
+}
+
+// markdownCell flattens text so it fits in a Markdown table cell
+func markdownCell(text string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(text), " "), "|", "\\|")
+}
+
+func toAnswerReportFile(report *domain.AnswerEvalReport) *answerReportFile {
+	f := &answerReportFile{
+		Overall:    answerMetricsFile(report.Overall),
+		Categories: make([]categoryAnswerMetricsFile, 0, len(report.Categories)),
+		Results:    make([]answerResultFile, 0, len(report.Results)),
+	}
+	for _, category := range report.Categories {
+		f.Categories = append(f.Categories, categoryAnswerMetricsFile{
+			Category: category.Category,
+			Metrics:  answerMetricsFile(category.Metrics),
+		})
+	}
+	for _, result := range report.Results {
+		r := answerResultFile{
+			Question:        result.Golden.Question,
+			Category:        result.Golden.Category,
+			ReferenceAnswer: result.Golden.ReferenceAnswer,
+			Answer:          result.Answer,
+			Error:           result.Error,
+		}
+		for _, source := range result.Sources {
+			r.Sources = append(r.Sources, answerSourceFile{
+				Instruction:     source.Knowledge.Instruction,
+				Intent:          source.Knowledge.Intent,
+				SimilarityScore: source.SimilarityScore,
+			})
+		}
+		if result.Judgement != nil {
+			r.Faithfulness = result.Judgement.Faithfulness
+			r.Relevance = result.Judgement.Relevance
+			r.Completeness = result.Judgement.Completeness
+			r.Reasoning = result.Judgement.Reasoning
+		}
+		f.Results = append(f.Results, r)
+	}
+	return f
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/config"
+	"github.com/wonjinsin/simple-chatbot/internal/database"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/filesystem"
+	chatgptRepo "github.com/wonjinsin/simple-chatbot/internal/repository/langchain/chatGPT"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/memory"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/file"
+)
+
+func main() {
+	// Set timezone to UTC for the entire program
+	time.Local = time.UTC
+
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+
+	switch command {
+	case "retrieval":
+		fs := flag.NewFlagSet("retrieval", flag.ExitOnError)
+		dataset := fs.String("dataset", "mock_data/data_set.csv", "labelled knowledge CSV")
+		k := fs.Int("k", 3, "number of retrieved entries scored per query")
+		holdout := fs.Float64("holdout", 0.2, "share of each intent held out as queries")
+		seed := fs.Int64("seed", 42, "seed of the holdout split")
+		index := fs.String("index", "postgres", "knowledge index: postgres (DB_NAME, must be empty) or memory")
+		out := fs.String("out", "eval/retrieval", "report path without extension (.json and .md)")
+		baseline := fs.String("baseline", "", "JSON report to compare against (fails on regression)")
+		tolerance := fs.Float64("tolerance", 0.01, "allowed drop of a metric below the baseline")
+		_ = fs.Parse(args)
+
+		runRetrieval(*dataset, *index, *out, *baseline, *tolerance, domain.RetrievalEvalConfig{
+			Holdout: *holdout,
+			Seed:    *seed,
+			K:       *k,
+		})
+
+	case "answers":
+		fs := flag.NewFlagSet("answers", flag.ExitOnError)
+		golden := fs.String("golden", "mock_data/golden_set.csv", "golden questions CSV")
+		judgeModel := fs.String("judge-model", "", "chat model of the judge (default OPENAI_CHAT_MODEL)")
+		out := fs.String("out", "eval/answers", "report path without extension (.json and .md)")
+		baseline := fs.String("baseline", "", "JSON report to compare against (fails on regression)")
+		tolerance := fs.Float64("tolerance", 0.25, "allowed drop of a score below the baseline")
+		_ = fs.Parse(args)
+
+		runAnswers(*golden, *judgeModel, *out, *baseline, *tolerance)
+
+	default:
+		fmt.Printf("Unknown command: %s\n", command)
+		printUsage()
+		os.Exit(1)
+	}
+}
+
+// runRetrieval evaluates retrieval on a held-out split of the dataset, writes the report and
+// exits non-zero when a metric regressed against the baseline
+func runRetrieval(
+	dataset, index, out, baselinePath string,
+	tolerance float64,
+	evalCfg domain.RetrievalEvalConfig,
+) {
+	// Load configuration
+	cfg := config.Load()
+
+	// Read the labelled dataset
+	csvRows, err := file.ReadCSVToMapArray(dataset)
+	if err != nil {
+		log.Fatalf("Failed to read dataset: %v", err)
+	}
+	items, err := domain.NewInquiryKnowledgeFromCSVs(csvRows)
+	if err != nil {
+		log.Fatalf("Failed to parse dataset: %v", err)
+	}
+
+	// Index the training split in a store dedicated to the run so held-out queries cannot match
+	// themselves
+	embedder, err := database.NewEmbedder(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize embedder: %v", err)
+	}
+	knowledgeRepo, closeIndex := newRetrievalIndex(cfg, index)
+	defer closeIndex()
+	svc := usecase.NewEvaluationServiceImpl(
+		chatgptRepo.NewEmbeddingRepository(embedder),
+		knowledgeRepo,
+	)
+
+	report, err := svc.EvaluateRetrieval(context.Background(), items, evalCfg)
+	if err != nil {
+		log.Fatalf("Failed to evaluate retrieval: %v", err)
+	}
+
+	if err := writeRetrievalReport(out, report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+	printRetrievalReport(os.Stdout, report)
+	fmt.Printf("\nReport written to %s.json and %s.md\n", out, out)
+
+	if baselinePath == "" {
+		return
+	}
+	baseline, err := readRetrievalReport(baselinePath)
+	if err != nil {
+		log.Fatalf("Failed to read baseline: %v", err)
+	}
+	if regressions := report.Regressions(baseline, tolerance); len(regressions) > 0 {
+		fmt.Println("\nRegressions against the baseline:")
+		for _, regression := range regressions {
+			fmt.Printf("  %s\n", regression)
+		}
+		closeIndex()
+		os.Exit(1)
+	}
+	fmt.Println("\nNo regressions against the baseline")
+}
+
+// newRetrievalIndex returns the empty knowledge store the retrieval evaluation indexes into, and
+// a function that releases it. "postgres" evaluates the production FindSimilars (pgvector HNSW
+// index) on the configured database, which must be dedicated to evaluation: it is migrated, and
+// refused if its knowledge base is not empty. "memory" needs no database.
+func newRetrievalIndex(
+	cfg *config.Config,
+	index string,
+) (repository.InquiryKnowledgeRepository, func()) {
+	switch index {
+	case "memory":
+		return memory.NewInquiryKnowledgeRepository(), func() {}
+	case "postgres":
+	default:
+		log.Fatalf("Unknown index %q (postgres or memory)", index)
+	}
+
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		time.Duration(cfg.DBAutoMigrateTimeout)*time.Second,
+	)
+	defer cancel()
+	if _, err := database.ApplyMigrations(ctx, db); err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+
+	knowledgeRepo := postgres.NewInquiryKnowledgeRepository(database.NewEntClient(db, cfg))
+	count, err := knowledgeRepo.CountEmbeddedInquiryKnowledge(ctx)
+	if err != nil {
+		log.Fatalf("Failed to count indexed knowledge: %v", err)
+	}
+	if count > 0 {
+		log.Fatalf(
+			"Database %s already holds %d embedded entries; set DB_NAME to an empty database "+
+				"dedicated to evaluation",
+			cfg.DBName,
+			count,
+		)
+	}
+	return knowledgeRepo, func() { _ = db.Close() }
+}
+
+// runAnswers answers the golden questions through the full inquiry pipeline over an in-memory
+// index of the knowledge dataset, has the judge score each answer, writes the report and exits
+// non-zero when a score regressed against the baseline
+func runAnswers(goldenPath, judgeModel, out, baselinePath string, tolerance float64) {
+	ctx := context.Background()
+
+	// Load configuration
+	cfg := config.Load()
+	if judgeModel == "" {
+		judgeModel = cfg.OpenAIChatModel
+	}
+
+	// Read the golden set
+	csvRows, err := file.ReadCSVToMapArray(goldenPath)
+	if err != nil {
+		log.Fatalf("Failed to read golden set: %v", err)
+	}
+	golden, err := domain.NewGoldenQuestionsFromCSVs(csvRows)
+	if err != nil {
+		log.Fatalf("Failed to parse golden set: %v", err)
+	}
+
+	// Initialize the configured provider (LLM_PROVIDER=fake runs offline)
+	llms, err := database.NewChatModels(cfg, cfg.OpenAIChatModel, judgeModel)
+	if err != nil {
+		log.Fatalf("Failed to initialize chat models: %v", err)
+	}
+	embedder, err := database.NewEmbedder(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize embedder: %v", err)
+	}
+	promptTemplateRepo, err := filesystem.NewPromptTemplateRepository(cfg.PromptTemplateDir)
+	if err != nil {
+		log.Fatalf("Failed to load prompt templates: %v", err)
+	}
+	experimentRepo, err := filesystem.NewExperimentRepository("")
+	if err != nil {
+		log.Fatalf("Failed to initialize experiments: %v", err)
+	}
+
+	var injectionClassifierRepo repository.InjectionClassifierRepository
+	if cfg.InjectionGuardLLM {
+		injectionClassifierRepo = chatgptRepo.NewInjectionClassifierRepository(
+			llms[cfg.OpenAIChatModel],
+			promptTemplateRepo,
+		)
+	}
+
+	// Wire the inquiry pipeline over throwaway in-memory stores. Experiments are disabled and
+	// questions are asked without an end user, so exposures and conversations are never stored.
+	inquirySvc := usecase.NewInquiryServiceImpl(usecase.InquiryServiceOptions{
+		EmbeddingRepo:           chatgptRepo.NewEmbeddingRepository(embedder),
+		KnowledgeRepo:           memory.NewInquiryKnowledgeRepository(),
+		AnswerRefineRepo:        chatgptRepo.NewAnswerRefineRepo(llms, cfg.OpenAIChatModel, promptTemplateRepo),
+		ExperimentRepo:          experimentRepo,
+		AuditRepo:               memory.NewAuditRepository(),
+		InjectionClassifierRepo: injectionClassifierRepo,
+		Ingestion: usecase.IngestionConfig{
+			Concurrency:     cfg.EmbedConcurrency,
+			TokensPerMinute: cfg.EmbedTokensPerMinute,
+		},
+		Guardrails: usecase.GuardrailConfig{
+			Injection: usecase.InjectionGuardConfig{
+				Enabled:         cfg.InjectionGuardEnabled,
+				Action:          domain.GuardAction(cfg.InjectionGuardAction),
+				ClassifyContext: cfg.InjectionGuardLLMContext,
+			},
+			PII: usecase.PIIConfig{
+				Enabled:        cfg.PIIRedactionEnabled,
+				RestoreAnswers: cfg.PIIRestoreAnswers,
+			},
+			Moderation: usecase.ModerationConfig{
+				Enabled: cfg.AnswerModerationEnabled,
+				Policy: domain.AnswerPolicy{
+					BannedPhrases:   cfg.AnswerPolicyBannedPhrases,
+					AllowedURLHosts: cfg.AnswerPolicyURLAllowlist,
+					MaxLength:       cfg.AnswerPolicyMaxLength,
+					RefundPromises:  cfg.AnswerPolicyRefundPromises,
+					MinGrounding:    cfg.AnswerPolicyMinGrounding,
+				},
+				Action:           domain.ModerationAction(cfg.AnswerModerationAction),
+				MaxRegenerations: cfg.AnswerModerationMaxRegenerations,
+				HandoffMessage:   cfg.AnswerHandoffMessage,
+			},
+		},
+		CompanySlots: domain.NewSlots(cfg.CompanySlots),
+	})
+	if _, err := inquirySvc.EmbedInquiryOrigins(ctx); err != nil {
+		log.Fatalf("Failed to index knowledge: %v", err)
+	}
+
+	svc := usecase.NewAnswerEvaluationServiceImpl(
+		inquirySvc,
+		chatgptRepo.NewAnswerJudgeRepository(llms[judgeModel], promptTemplateRepo),
+	)
+	report, err := svc.EvaluateAnswers(ctx, golden)
+	if err != nil {
+		log.Fatalf("Failed to evaluate answers: %v", err)
+	}
+
+	if err := writeAnswerReport(out, report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+	printAnswerReport(os.Stdout, report)
+	fmt.Printf("\nReport written to %s.json and %s.md\n", out, out)
+
+	if baselinePath == "" {
+		return
+	}
+	baseline, err := readAnswerReport(baselinePath)
+	if err != nil {
+		log.Fatalf("Failed to read baseline: %v", err)
+	}
+	if regressions := report.Regressions(baseline, tolerance); len(regressions) > 0 {
+		fmt.Println("\nRegressions against the baseline:")
+		for _, regression := range regressions {
+			fmt.Printf("  %s\n", regression)
+		}
+		os.Exit(1)
+	}
+	fmt.Println("\nNo regressions against the baseline")
+}
+
+func printUsage() {
+	fmt.Println("Usage: go run ./cmd/eval [command] [flags]")
+	fmt.Println("\nCommands:")
+	fmt.Println("  retrieval [-k 3] [-holdout 0.2] [-seed 42] [-index postgres|memory] [-out eval/retrieval]")
+	fmt.Println("            [-baseline report.json] [-tolerance 0.01]  - Score retrieval on held-out questions")
+	fmt.Println("  answers   [-golden mock_data/golden_set.csv] [-judge-model name] [-out eval/answers]")
+	fmt.Println("            [-baseline report.json] [-tolerance 0.25]  - Judge answers to golden questions")
+}
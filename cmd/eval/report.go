@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// retrievalReportFile is the JSON form of a retrieval report
+type retrievalReportFile struct {
+	K          int                   `json:"k"`
+	Holdout    float64               `json:"holdout"`
+	Seed       int64                 `json:"seed"`
+	IndexSize  int                   `json:"indexSize"`
+	Overall    retrievalMetricsFile  `json:"overall"`
+	Categories []categoryMetricsFile `json:"categories"`
+}
+
+type retrievalMetricsFile struct {
+	Queries        int     `json:"queries"`
+	RecallAtK      float64 `json:"recallAtK"`
+	MRR            float64 `json:"mrr"`
+	NDCGAtK        float64 `json:"ndcgAtK"`
+	IntentAccuracy float64 `json:"intentAccuracy"`
+}
+
+type categoryMetricsFile struct {
+	Category string               `json:"category"`
+	Metrics  retrievalMetricsFile `json:"metrics"`
+}
+
+// writeRetrievalReport writes the report as <out>.json and <out>.md
+func writeRetrievalReport(out string, report *domain.RetrievalReport) error {
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(toRetrievalReportFile(report), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(out+".json", append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+
+	var md strings.Builder
+	fmt.Fprintf(&md, "# Retrieval evaluation\n\n")
+	fmt.Fprintf(&md, "k=%d, holdout=%.2f, seed=%d, index size=%d\n\n",
+		report.Config.K, report.Config.Holdout, report.Config.Seed, report.IndexSize)
+	printRetrievalReport(&md, report)
+	return os.WriteFile(out+".md", []byte(md.String()), 0o644)
+}
+
+// readRetrievalReport reads a report written by writeRetrievalReport
+func readRetrievalReport(path string) (*domain.RetrievalReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f retrievalReportFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return toDomainRetrievalReport(&f), nil
+}
+
+// printRetrievalReport prints the metrics as a Markdown table
+func printRetrievalReport(w io.Writer, report *domain.RetrievalReport) {
+	fmt.Fprintln(w, "| Category | Queries | Recall@k | MRR | nDCG@k | Intent accuracy |")
+	fmt.Fprintln(w, "| -------- | ------: | -------: | --: | -----: | --------------: |")
+	row := func(name string, m domain.RetrievalMetrics) {
+		fmt.Fprintf(w, "| %s | %d | %.4f | %.4f | %.4f | %.4f |\n",
+			name, m.Queries, m.RecallAtK, m.MRR, m.NDCGAtK, m.IntentAccuracy)
+	}
+	for _, category := range report.Categories {
+		row(category.Category, category.Metrics)
+	}
+	row("**overall**", report.Overall)
+}
+
+func toRetrievalReportFile(report *domain.RetrievalReport) *retrievalReportFile {
+	f := &retrievalReportFile{
+		K:          report.Config.K,
+		Holdout:    report.Config.Holdout,
+		Seed:       report.Config.Seed,
+		IndexSize:  report.IndexSize,
+		Overall:    retrievalMetricsFile(report.Overall),
+		Categories: make([]categoryMetricsFile, 0, len(report.Categories)),
+	}
+	for _, category := range report.Categories {
+		f.Categories = append(f.Categories, categoryMetricsFile{
+			Category: category.Category,
+			Metrics:  retrievalMetricsFile(category.Metrics),
+		})
+	}
+	return f
+}
+
+func toDomainRetrievalReport(f *retrievalReportFile) *domain.RetrievalReport {
+	report := &domain.RetrievalReport{
+		Config: domain.RetrievalEvalConfig{
+			Holdout: f.Holdout,
+			Seed:    f.Seed,
+			K:       f.K,
+		},
+		IndexSize:  f.IndexSize,
+		Overall:    domain.RetrievalMetrics(f.Overall),
+		Categories: make([]domain.CategoryRetrievalMetrics, 0, len(f.Categories)),
+	}
+	for _, category := range f.Categories {
+		report.Categories = append(report.Categories, domain.CategoryRetrievalMetrics{
+			Category: category.Category,
+			Metrics:  domain.RetrievalMetrics(category.Metrics),
+		})
+	}
+	return report
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/golang-migrate/migrate/v4/source"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/migrate"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// Templates of the generated golang-migrate files; the version is filled in per run so generated
+// migrations are numbered like the ones scaffolded by create
+const (
+	upFileTemplate   = "%06d{{ with .Name }}_{{ . }}{{ end }}.up.sql"
+	downFileTemplate = "%06d{{ with .Name }}_{{ . }}{{ end }}.down.sql"
+	upSQLTemplate    = `-- Generated from the ent schema (internal/repository/postgres/dao/schema).
+{{ range .Changes }}
+{{ with .Comment }}-- {{ println . }}{{ end }}{{ printf "%s;\n" .Cmd }}{{ end }}`
+	downSQLTemplate = `{{ range $c := rev .Changes }}{{ with $stmts := .ReverseStmts }}` +
+		`{{ with $c.Comment }}-- reverse: {{ println . }}{{ end }}` +
+		`{{ range $stmts }}{{ printf "%s;\n" . }}{{ end }}{{ end }}{{ end }}`
+)
+
+// generatedMigration is a migration file generated from the ent schema
+type generatedMigration struct {
+	Name    string
+	Content []byte
+}
+
+// diffSchema generates the migration that brings the migrations in dir to the ent schema. The
+// migrations are replayed on devURL, an empty Postgres database with pgvector available, and the
+// result is compared with the ent schema. No files means the migrations already match the schema.
+func diffSchema(ctx context.Context, dir, devURL, name string) ([]generatedMigration, error) {
+	if devURL == "" {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"a dev database is required: pass -dev-url or set MIGRATE_DEV_URL",
+			nil,
+		)
+	}
+
+	// Step 1: Copy the migrations to a scratch directory and checksum them (atlas refuses to
+	// replay a directory without atlas.sum, which golang-migrate does not use)
+	scratch, err := os.MkdirTemp("", "migrate-diff-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create scratch directory")
+	}
+	defer os.RemoveAll(scratch)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list migrations")
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read migration")
+		}
+		if err := os.WriteFile(filepath.Join(scratch, filepath.Base(file)), content, 0o644); err != nil {
+			return nil, errors.Wrap(err, "failed to copy migration")
+		}
+	}
+
+	migrationDir, err := sqltool.NewGolangMigrateDir(scratch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open scratch directory")
+	}
+	sum, err := migrationDir.Checksum()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to checksum migrations")
+	}
+	if err := atlasmigrate.WriteSumFile(migrationDir, sum); err != nil {
+		return nil, errors.Wrap(err, "failed to write migration checksum")
+	}
+
+	// Step 2: Replay the migrations on the dev database and plan the changes to the ent schema
+	version, err := nextMigrationVersion(dir)
+	if err != nil {
+		return nil, err
+	}
+	formatter, err := migrationFormatter(version)
+	if err != nil {
+		return nil, err
+	}
+	atlas, err := schema.NewMigrateURL(
+		devURL,
+		schema.WithDir(migrationDir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(formatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithErrNoPlan(true),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open dev database")
+	}
+	if err := atlas.NamedDiff(ctx, name, migrate.Tables...); err != nil {
+		if errors.Is(err, atlasmigrate.ErrNoPlan) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to diff migrations against the ent schema")
+	}
+
+	// Step 3: Collect the generated up and down files
+	generated, err := filepath.Glob(filepath.Join(scratch, fmt.Sprintf("%06d_*.sql", version)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list generated migrations")
+	}
+	migrations := make([]generatedMigration, 0, len(generated))
+	for _, file := range generated {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read generated migration")
+		}
+		migrations = append(migrations, generatedMigration{Name: filepath.Base(file), Content: content})
+	}
+	return migrations, nil
+}
+
+// nextMigrationVersion returns the version after the latest migration in dir
+func nextMigrationVersion(dir string) (uint, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	var latest uint
+	for _, entry := range entries {
+		if m, err := source.DefaultParse(entry.Name()); err == nil && m.Version > latest {
+			latest = m.Version
+		}
+	}
+	return latest + 1, nil
+}
+
+// migrationFormatter formats planned changes as golang-migrate up and down files of a version
+func migrationFormatter(version uint) (atlasmigrate.Formatter, error) {
+	funcs := template.FuncMap{"rev": reverseChanges}
+	var templates []*template.Template
+	for _, text := range []string{
+		fmt.Sprintf(upFileTemplate, version),
+		upSQLTemplate,
+		fmt.Sprintf(downFileTemplate, version),
+		downSQLTemplate,
+	} {
+		tpl, err := template.New("").Funcs(funcs).Parse(text)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse migration template")
+		}
+		templates = append(templates, tpl)
+	}
+	formatter, err := atlasmigrate.NewTemplateFormatter(templates...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migration formatter")
+	}
+	return formatter, nil
+}
+
+// reverseChanges returns the changes in reverse order, for the down migration
+func reverseChanges(changes []*atlasmigrate.Change) []*atlasmigrate.Change {
+	reversed := make([]*atlasmigrate.Change, len(changes))
+	for i, change := range changes {
+		reversed[len(changes)-1-i] = change
+	}
+	return reversed
+}
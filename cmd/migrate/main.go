@@ -1,65 +1,90 @@
 package main
 
 import (
-	"errors"
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/joho/godotenv"
+	"github.com/golang-migrate/migrate/v4/source"
+
+	"github.com/wonjinsin/simple-chatbot/internal/config"
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/database"
+	"github.com/wonjinsin/simple-chatbot/migrations"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 )
 
+// migrationNamePattern restricts scaffolded migration names to safe file name characters
+var migrationNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
 func main() {
 	// Set timezone to UTC for the entire program
 	time.Local = time.UTC
 
-	// Load .env.local
-	if err := godotenv.Load(".env.local"); err != nil {
-		log.Printf("Warning: .env.local not found: %v", err)
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(1)
 	}
 
-	// Build DATABASE_URL from individual components
-	dbHost := os.Getenv("DB_HOST")
-	dbPort := os.Getenv("DB_PORT")
-	dbUser := os.Getenv("DB_USER")
-	dbPassword := os.Getenv("DB_PASSWORD")
-	dbName := os.Getenv("DB_NAME")
-	dbSSLMode := os.Getenv("DB_SSLMODE")
+	command, args := os.Args[1], os.Args[2:]
 
-	if dbHost == "" || dbPort == "" || dbUser == "" || dbPassword == "" || dbName == "" {
-		log.Fatal(
-			"Required database environment variables are not set (DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME)",
-		)
+	// create only writes files, so it runs without a database
+	if command == "create" {
+		fs := flag.NewFlagSet("create", flag.ExitOnError)
+		dir := fs.String("dir", migrations.Dir, "directory of the migration files")
+		_ = fs.Parse(args)
+		if fs.NArg() != 1 {
+			log.Fatal("Usage: migrate create [-dir DIR] NAME")
+		}
+		if err := createMigration(*dir, fs.Arg(0)); err != nil {
+			log.Fatalf("Failed to create migration: %v", err)
+		}
+		return
 	}
 
-	if dbSSLMode == "" {
-		dbSSLMode = "disable"
+	// diff and check replay the migrations on a throwaway dev database instead of the configured one
+	if command == "diff" || command == "check" {
+		fs := flag.NewFlagSet(command, flag.ExitOnError)
+		dir := fs.String("dir", migrations.Dir, "directory of the migration files")
+		devURL := fs.String("dev-url", os.Getenv("MIGRATE_DEV_URL"), "URL of an empty Postgres database with pgvector")
+		_ = fs.Parse(args)
+		if command == "diff" {
+			if fs.NArg() != 1 {
+				log.Fatal("Usage: migrate diff [-dir DIR] [-dev-url URL] NAME")
+			}
+			if err := writeSchemaDiff(*dir, *devURL, fs.Arg(0)); err != nil {
+				log.Fatalf("Failed to generate migration: %v", err)
+			}
+			return
+		}
+		if err := checkSchemaDiff(*dir, *devURL); err != nil {
+			log.Fatalf("Migration check failed: %v", err)
+		}
+		return
 	}
 
-	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s&timezone=UTC",
-		dbUser, dbPassword, dbHost, dbPort, dbName, dbSSLMode)
+	// Load configuration
+	cfg := config.Load()
 
-	// Create migration instance
-	m, err := migrate.New(
-		"file://migrations",
-		databaseURL,
-	)
+	// Initialize PostgreSQL database connection
+	db, err := database.NewPostgresDB(cfg)
 	if err != nil {
-		log.Fatalf("Failed to create migrate instance: %v", err)
+		log.Fatalf("Failed to initialize database: %v", err)
 	}
-	defer m.Close()
 
-	// Parse command from arguments
-	if len(os.Args) < 2 {
-		printUsage()
-		os.Exit(1)
+	// Create migration instance over the embedded migrations (closing it closes db)
+	m, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("Failed to create migrate instance: %v", err)
 	}
-
-	command := os.Args[1]
+	defer m.Close()
 
 	switch command {
 	case "up":
@@ -69,13 +94,54 @@ func main() {
 		log.Println("Migration up completed successfully")
 
 	case "down":
-		if err := m.Down(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		if err := m.Steps(-1); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 			log.Fatalf("Migration down failed: %v", err)
 		}
 		log.Println("Migration down completed successfully")
 
+	case "steps":
+		n := intArg(command, args)
+		if err := m.Steps(n); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			log.Fatalf("Migration steps failed: %v", err)
+		}
+		log.Printf("Migrated %d step(s) successfully", n)
+
+	case "goto":
+		version := intArg(command, args)
+		if version < 0 {
+			log.Fatalf("Invalid version: %d", version)
+		}
+		if err := m.Migrate(uint(version)); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			log.Fatalf("Migration goto failed: %v", err)
+		}
+		log.Printf("Migrated to version %d successfully", version)
+
+	case "force":
+		// -1 marks the database as having no migration applied
+		version := intArg(command, args)
+		if err := m.Force(version); err != nil {
+			log.Fatalf("Failed to force version: %v", err)
+		}
+		log.Printf("Forced version %d (dirty flag cleared)", version)
+
+	case "drop":
+		fs := flag.NewFlagSet("drop", flag.ExitOnError)
+		yes := fs.Bool("yes", false, "confirm dropping every table of the database schema")
+		_ = fs.Parse(args)
+		if !*yes {
+			log.Fatal("drop deletes every table and its data; rerun with -yes to confirm")
+		}
+		if err := m.Drop(); err != nil {
+			log.Fatalf("Failed to drop database schema: %v", err)
+		}
+		log.Println("Dropped every table of the database schema")
+
 	case "version":
 		version, dirty, err := m.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			fmt.Println("No migration applied")
+			return
+		}
 		if err != nil {
 			log.Fatalf("Failed to get version: %v", err)
 		}
@@ -88,10 +154,108 @@ func main() {
 	}
 }
 
+// intArg parses the single integer argument of a command
+func intArg(command string, args []string) int {
+	if len(args) != 1 {
+		log.Fatalf("Usage: migrate %s N", command)
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		log.Fatalf("Invalid argument %q: %v", args[0], err)
+	}
+	return n
+}
+
+// createMigration writes empty up and down files numbered after the latest migration in dir
+func createMigration(dir, name string) error {
+	if !migrationNamePattern.MatchString(name) {
+		return errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("invalid name %q: use lowercase letters, digits and underscores", name),
+			nil,
+		)
+	}
+
+	version, err := nextMigrationVersion(dir)
+	if err != nil {
+		return err
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%06d_%s", version, name))
+	for _, direction := range []source.Direction{source.Up, source.Down} {
+		path := fmt.Sprintf("%s.%s.sql", base, direction)
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Printf("Created %s\n", path)
+	}
+	return nil
+}
+
+// writeSchemaDiff writes the migration generated from the ent schema to dir
+func writeSchemaDiff(dir, devURL, name string) error {
+	if !migrationNamePattern.MatchString(name) {
+		return errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("invalid name %q: use lowercase letters, digits and underscores", name),
+			nil,
+		)
+	}
+
+	generated, err := diffSchema(context.Background(), dir, devURL, name)
+	if err != nil {
+		return err
+	}
+	if len(generated) == 0 {
+		fmt.Println("Migrations already match the ent schema")
+		return nil
+	}
+	for _, file := range generated {
+		path := filepath.Join(dir, file.Name)
+		if err := os.WriteFile(path, file.Content, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("Created %s\n", path)
+	}
+	return nil
+}
+
+// checkSchemaDiff fails when the migrations in dir do not produce the ent schema, printing the
+// migration that would fix them
+func checkSchemaDiff(dir, devURL string) error {
+	generated, err := diffSchema(context.Background(), dir, devURL, "schema_drift")
+	if err != nil {
+		return err
+	}
+	if len(generated) == 0 {
+		fmt.Println("Migrations match the ent schema")
+		return nil
+	}
+	for _, file := range generated {
+		fmt.Printf("--- %s\n%s\n", file.Name, file.Content)
+	}
+	return errors.New(
+		constants.InvalidParameter,
+		"migrations do not match the ent schema: run make migrate-diff NAME=... and commit the result",
+		nil,
+	)
+}
+
 func printUsage() {
-	fmt.Println("Usage: go run cmd/migrate/main.go [command]")
+	fmt.Println("Usage: go run ./cmd/migrate [command]")
 	fmt.Println("\nCommands:")
-	fmt.Println("  up       - Run all pending migrations")
-	fmt.Println("  down     - Rollback last migration")
-	fmt.Println("  version  - Show current migration version")
+	fmt.Println("  up                    - Run all pending migrations")
+	fmt.Println("  down                  - Rollback the last migration")
+	fmt.Println("  steps N               - Apply N migrations (negative N rolls back)")
+	fmt.Println("  goto V                - Migrate up or down to version V")
+	fmt.Println("  force V               - Set version V and clear the dirty flag (-1 = none)")
+	fmt.Println("  drop -yes             - Drop every table of the database schema")
+	fmt.Println("  version               - Show current migration version")
+	fmt.Println("  create [-dir D] NAME  - Scaffold empty up/down files for a new migration")
+	fmt.Println("  diff [-dir D] NAME    - Generate up/down files from the ent schema (needs -dev-url)")
+	fmt.Println("  check [-dir D]        - Fail if the migrations differ from the ent schema (needs -dev-url)")
 }
@@ -8,16 +8,27 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/cloudwego/eino/callbacks"
+
 	"github.com/wonjinsin/simple-chatbot/internal/config"
 	"github.com/wonjinsin/simple-chatbot/internal/database"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	httpHandler "github.com/wonjinsin/simple-chatbot/internal/handler/http"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/filesystem"
 	chatgptRepo "github.com/wonjinsin/simple-chatbot/internal/repository/langchain/chatGPT"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/memory"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/oidc"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres"
 	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	pkgErrors "github.com/wonjinsin/simple-chatbot/pkg/errors"
 	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
 )
 
 func main() {
@@ -33,15 +44,55 @@ func main() {
 	// Initialize logger
 	logger.Initialize(cfg.Env)
 
+	// shutdownCtx is cancelled on SIGINT/SIGTERM; background jobs stop with it
+	shutdownCtx, stopSignals := signal.NotifyContext(
+		context.Background(),
+		syscall.SIGINT,
+		syscall.SIGTERM,
+	)
+	defer stopSignals()
+	var jobs sync.WaitGroup
+
+	// Capture error stacks in debug environments only
+	pkgErrors.EnableStackCapture(cfg.Env == "local" || cfg.Env == "dev")
+
+	// Initialize tracing (always honours incoming traceparent headers)
+	shutdownTracing, err := tracing.Initialize(context.Background(), tracing.Config{
+		ServiceName: "simple-chatbot",
+		Exporter:    cfg.TracingExporter,
+		FilePath:    cfg.TracingFile,
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize tracing: %v", err)
+	}
+	callbacks.AppendGlobalHandlers(chatgptRepo.NewTracingHandler())
+
+	// Load and validate prompt experiments
+	experimentRepo, err := filesystem.NewExperimentRepository(cfg.ExperimentsFile)
+	if err != nil {
+		log.Fatalf("failed to load experiments: %v", err)
+	}
+	experiments, err := experimentRepo.ListExperiments(context.Background())
+	if err != nil {
+		log.Fatalf("failed to list experiments: %v", err)
+	}
+
 	// Initialize LLM
-	// Initialize ChatGPT LLM
-	chatGPTLLM, err := database.NewChatGPTLLM(cfg.OpenAIAPIKey)
+	// Initialize chat models (default model plus every model an experiment variant overrides)
+	modelNames := []string{cfg.OpenAIChatModel}
+	for _, experiment := range experiments {
+		for _, variant := range experiment.Variants {
+			modelNames = append(modelNames, variant.Overrides.Model)
+		}
+	}
+	chatGPTLLMs, err := database.NewChatModels(cfg, modelNames...)
 	if err != nil {
 		log.Fatalf("failed to initialize ChatGPT LLM: %v", err)
 	}
+	chatGPTLLM := chatGPTLLMs[cfg.OpenAIChatModel]
 
-	// Initialize ChatGPT Embedder
-	chatGPTEmbedder, err := database.NewChatGPTEmbedder(cfg.OpenAIAPIKey)
+	// Initialize Embedder
+	chatGPTEmbedder, err := database.NewEmbedder(cfg)
 	if err != nil {
 		log.Fatalf("failed to initialize ChatGPT embedder: %v", err)
 	}
@@ -52,30 +103,212 @@ func main() {
 		log.Fatalf("failed to initialize database: %v", err)
 	}
 	defer db.Close()
+	metrics.RegisterDBStats(db, cfg.DBName)
+
+	// Apply pending migrations (replicas take turns through an advisory lock)
+	if cfg.DBAutoMigrate {
+		migrateCtx, cancel := context.WithTimeout(
+			context.Background(),
+			time.Duration(cfg.DBAutoMigrateTimeout)*time.Second,
+		)
+		applied, err := database.ApplyMigrations(migrateCtx, db)
+		cancel()
+		if err != nil {
+			log.Fatalf("failed to apply migrations: %v", err)
+		}
+		if len(applied) == 0 {
+			log.Println("database schema is up to date")
+		} else {
+			log.Printf("applied migrations %v", applied)
+		}
+	}
 
 	// Initialize EntGo client (shared across all repositories)
 	entClient := database.NewEntClient(db, cfg)
 	defer entClient.Close()
 
+	// Load and validate prompt templates
+	promptTemplateRepo, err := filesystem.NewPromptTemplateRepository(cfg.PromptTemplateDir)
+	if err != nil {
+		log.Fatalf("failed to load prompt templates: %v", err)
+	}
+	for _, experiment := range experiments {
+		for _, variant := range experiment.Variants {
+			if variant.Overrides.TemplateName == "" {
+				continue
+			}
+			if _, err := promptTemplateRepo.GetPromptTemplate(
+				context.Background(),
+				variant.Overrides.TemplateName,
+				variant.Overrides.TemplateVersion,
+			); err != nil {
+				log.Fatalf("experiment %s variant %s: %v", experiment.Name, variant.Name, err)
+			}
+		}
+	}
+
 	// Initialize repositories
 	embeddingRepo := chatgptRepo.NewEmbeddingRepository(chatGPTEmbedder)
 	inquiryKnowledgeRepo := postgres.NewInquiryKnowledgeRepository(entClient)
-	answerRefineRepo := chatgptRepo.NewAnswerRefineRepo(chatGPTLLM)
+	experimentExposureRepo := postgres.NewExperimentExposureRepository(entClient)
+	apiKeyRepo := postgres.NewAPIKeyRepository(entClient)
+	userRepo := postgres.NewUserRepository(entClient)
+	conversationRepo := postgres.NewConversationRepository(entClient)
+	auditRepo := postgres.NewAuditRepository(entClient)
+	answerFeedbackRepo := postgres.NewAnswerFeedbackRepository(entClient)
+	databaseHealthRepo := postgres.NewDatabaseHealthRepository(entClient)
+	answerRefineRepo := chatgptRepo.NewAnswerRefineRepo(
+		chatGPTLLMs,
+		cfg.OpenAIChatModel,
+		promptTemplateRepo,
+	)
+	basicChatRepo := chatgptRepo.NewBasicChatRepository(
+		chatGPTLLM,
+		cfg.OpenAIChatModel,
+		promptTemplateRepo,
+	)
+	var injectionClassifierRepo repository.InjectionClassifierRepository
+	if cfg.InjectionGuardLLM {
+		injectionClassifierRepo = chatgptRepo.NewInjectionClassifierRepository(
+			chatGPTLLM,
+			promptTemplateRepo,
+		)
+	}
 
 	// Wiring (Composition Root)
-	inquirySvc := usecase.NewInquiryServiceImpl(
+	inquirySvc := usecase.NewInquiryServiceImpl(usecase.InquiryServiceOptions{
+		EmbeddingRepo:           embeddingRepo,
+		KnowledgeRepo:           inquiryKnowledgeRepo,
+		AnswerRefineRepo:        answerRefineRepo,
+		ExperimentRepo:          experimentRepo,
+		ExposureRepo:            experimentExposureRepo,
+		ConversationRepo:        conversationRepo,
+		AuditRepo:               auditRepo,
+		InjectionClassifierRepo: injectionClassifierRepo,
+		Ingestion: usecase.IngestionConfig{
+			Concurrency:     cfg.EmbedConcurrency,
+			TokensPerMinute: cfg.EmbedTokensPerMinute,
+		},
+		Guardrails: usecase.GuardrailConfig{
+			Injection: usecase.InjectionGuardConfig{
+				Enabled:         cfg.InjectionGuardEnabled,
+				Action:          domain.GuardAction(cfg.InjectionGuardAction),
+				ClassifyContext: cfg.InjectionGuardLLMContext,
+			},
+			PII: usecase.PIIConfig{
+				Enabled:        cfg.PIIRedactionEnabled,
+				RestoreAnswers: cfg.PIIRestoreAnswers,
+			},
+			Moderation: usecase.ModerationConfig{
+				Enabled: cfg.AnswerModerationEnabled,
+				Policy: domain.AnswerPolicy{
+					BannedPhrases:   cfg.AnswerPolicyBannedPhrases,
+					AllowedURLHosts: cfg.AnswerPolicyURLAllowlist,
+					MaxLength:       cfg.AnswerPolicyMaxLength,
+					RefundPromises:  cfg.AnswerPolicyRefundPromises,
+					MinGrounding:    cfg.AnswerPolicyMinGrounding,
+				},
+				Action:           domain.ModerationAction(cfg.AnswerModerationAction),
+				MaxRegenerations: cfg.AnswerModerationMaxRegenerations,
+				HandoffMessage:   cfg.AnswerHandoffMessage,
+			},
+		},
+		CompanySlots: domain.NewSlots(cfg.CompanySlots),
+	})
+
+	basicChatSvc := usecase.NewBasicChatServiceImpl(basicChatRepo)
+
+	experimentSvc := usecase.NewExperimentServiceImpl(experimentRepo, experimentExposureRepo)
+
+	apiKeySvc := usecase.NewAPIKeyServiceImpl(apiKeyRepo)
+
+	auditSvc := usecase.NewAuditServiceImpl(auditRepo, usecase.AuditConfig{
+		Retention: time.Duration(cfg.AuditRetentionDays) * 24 * time.Hour,
+	})
+	jobs.Add(1)
+	go func() {
+		defer jobs.Done()
+		pruneAuditRecords(shutdownCtx, auditSvc)
+	}()
+
+	feedbackSvc := usecase.NewFeedbackServiceImpl(
+		auditRepo,
+		answerFeedbackRepo,
+		experimentExposureRepo,
 		embeddingRepo,
+		usecase.FeedbackConfig{
+			GapMaxSimilarity:     cfg.GapMaxSimilarity,
+			GapClusterSimilarity: cfg.GapClusterSimilarity,
+		},
+	)
+
+	healthSvc := usecase.NewHealthServiceImpl(
+		databaseHealthRepo,
 		inquiryKnowledgeRepo,
-		answerRefineRepo,
+		embeddingRepo,
+		basicChatRepo,
+		usecase.HealthConfig{
+			MigrationVersion: cfg.DBMigrationVersion,
+			ProbeLLM:         cfg.ReadyzProbeLLM,
+		},
 	)
 
+	// End-user authentication is only enabled when a JWKS source is configured
+	var userSvc usecase.UserService
+	if cfg.JWTEnabled() {
+		identityTokenRepo, err := oidc.NewIdentityTokenRepository(oidc.Config{
+			JWKSFile: cfg.JWTJWKSFile,
+			JWKSURL:  cfg.JWTJWKSURL,
+			Issuer:   cfg.JWTIssuer,
+			Audience: cfg.JWTAudience,
+		})
+		if err != nil {
+			log.Fatalf("failed to initialize identity token verification: %v", err)
+		}
+		userSvc = usecase.NewUserServiceImpl(identityTokenRepo, userRepo, conversationRepo)
+	}
+
+	// Per-client rate limiting (Postgres shares buckets across instances)
+	var rateLimitSvc usecase.RateLimitService
+	if cfg.RateLimitEnabled {
+		rateLimitRepo := memory.NewRateLimitRepository()
+		if cfg.RateLimitBackend == "postgres" {
+			rateLimitRepo = postgres.NewRateLimitRepository(entClient)
+		}
+		svc := usecase.NewRateLimitServiceImpl(rateLimitRepo)
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			pruneRateLimitBuckets(shutdownCtx, svc)
+		}()
+		rateLimitSvc = svc
+	}
+
 	// Create chi router
-	router := httpHandler.NewRouter(inquirySvc)
-	handler := http.TimeoutHandler(router, 59*time.Second, "Timeout")
+	router := httpHandler.NewRouter(httpHandler.RouterConfig{
+		InquirySvc:          inquirySvc,
+		BasicChatSvc:        basicChatSvc,
+		ExperimentSvc:       experimentSvc,
+		AuditSvc:            auditSvc,
+		FeedbackSvc:         feedbackSvc,
+		HealthSvc:           healthSvc,
+		APIKeySvc:           apiKeySvc,
+		UserSvc:             userSvc,
+		ChatCompletionModel: cfg.ChatCompletionModel,
+		AuthEnabled:         cfg.AuthEnabled,
+		RateLimitSvc:        rateLimitSvc,
+		RateLimitPolicies: map[string]*domain.RateLimitPolicy{
+			domain.RateLimitPolicyAsk:      newRateLimitPolicy(domain.RateLimitPolicyAsk, cfg.RateLimitAsk),
+			domain.RateLimitPolicyFeedback: newRateLimitPolicy(domain.RateLimitPolicyFeedback, cfg.RateLimitFeedback),
+			domain.RateLimitPolicyMe:       newRateLimitPolicy(domain.RateLimitPolicyMe, cfg.RateLimitMe),
+			domain.RateLimitPolicyIngest:   newRateLimitPolicy(domain.RateLimitPolicyIngest, cfg.RateLimitIngest),
+			domain.RateLimitPolicyAdmin:    newRateLimitPolicy(domain.RateLimitPolicyAdmin, cfg.RateLimitAdmin),
+		},
+	})
 
 	srv := &http.Server{
 		Addr:              fmt.Sprintf(":%s", cfg.Port),
-		Handler:           handler,
+		Handler:           router,
 		ReadHeaderTimeout: 5 * time.Second,
 		ReadTimeout:       10 * time.Second,
 		WriteTimeout:      60 * time.Second,
@@ -89,10 +322,9 @@ func main() {
 		}
 	}()
 
-	// Graceful shutdown
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
-	<-stop
+	// Graceful shutdown (background jobs stop once the signal cancels shutdownCtx)
+	<-shutdownCtx.Done()
+	stopSignals()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -101,9 +333,63 @@ func main() {
 		log.Printf("graceful shutdown failed: %v", err)
 		_ = srv.Close()
 	}
+	jobs.Wait()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("failed to flush traces: %v", err)
+	}
 	log.Println("bye")
 }
 
+// newRateLimitPolicy builds a named rate limit policy from its config
+func newRateLimitPolicy(name string, c config.RateLimitConfig) *domain.RateLimitPolicy {
+	return &domain.RateLimitPolicy{
+		Name:              name,
+		RequestsPerMinute: c.PerMinute,
+		Burst:             c.Burst,
+		Concurrency:       c.Concurrency,
+	}
+}
+
+// pruneRateLimitBuckets periodically deletes idle rate limit buckets until ctx is cancelled
+func pruneRateLimitBuckets(ctx context.Context, svc usecase.RateLimitService) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := svc.PruneIdleBuckets(ctx); err != nil && ctx.Err() == nil {
+			logger.LogError(ctx, "failed to prune rate limit buckets", err)
+		}
+	}
+}
+
+// pruneAuditRecords periodically deletes audit records past their retention period until ctx
+// is cancelled
+func pruneAuditRecords(ctx context.Context, svc usecase.AuditService) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		deleted, err := svc.PruneExpiredRecords(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.LogError(ctx, "failed to prune audit records", err)
+			}
+			continue
+		}
+		if deleted > 0 {
+			logger.LogInfo(ctx, fmt.Sprintf("pruned %d expired audit records", deleted))
+		}
+	}
+}
+
 func printBanner() {
 	// Read banner from file
 	bannerPath := "internal/config/banner.asc"
@@ -4,10 +4,22 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
+// DBApplicationName identifies the service's connections in pg_stat_activity
+const DBApplicationName = "simple-chatbot"
+
+const (
+	// LLMProviderOpenAI uses OpenAI for chat and embeddings
+	LLMProviderOpenAI = "openai"
+	// LLMProviderFake uses deterministic offline models for pipeline tests
+	LLMProviderFake = "fake"
+)
+
 // Config holds all application configuration
 type Config struct {
 	Port         string
@@ -19,6 +31,88 @@ type Config struct {
 	DBName       string
 	DBSSLMode    string
 	OpenAIAPIKey string
+
+	// LLM settings
+	LLMProvider       string // Chat and embedding provider: "openai" or "fake" (offline)
+	OpenAIChatModel   string // OpenAI chat model used for answer generation
+	PromptTemplateDir string // Directory containing *.yaml prompt templates
+	ExperimentsFile   string // YAML file with prompt experiments (empty = disabled)
+
+	// Ingestion settings
+	EmbedConcurrency     int // Number of embedding batches processed in parallel
+	EmbedTokensPerMinute int // Embedding token budget per minute (0 = unlimited)
+
+	// ChatCompletionModel is the model ID exposed by the OpenAI-compatible API
+	ChatCompletionModel string
+
+	// Auth settings
+	AuthEnabled bool   // Require an API key or end-user token on every endpoint except health checks
+	JWTJWKSFile string // Local JWKS document for end-user tokens (takes precedence over URL)
+	JWTJWKSURL  string // Identity provider JWKS URL for end-user tokens
+	JWTIssuer   string // Expected "iss" claim of end-user tokens
+	JWTAudience string // Expected "aud" claim of end-user tokens
+
+	// Request ID settings
+	DBTrIDComment bool // Prefix SQL statements with a /* trid=... */ comment (defeats statement caching)
+
+	// Migration settings
+	DBAutoMigrate        bool // Apply pending migrations when the server starts
+	DBAutoMigrateTimeout int  // Seconds to wait for the migration lock and apply migrations
+
+	// Readiness settings
+	DBMigrationVersion uint // Schema migration version /readyz requires (0 = any clean version)
+	ReadyzProbeLLM     bool // Let /readyz send a short prompt to the LLM provider
+
+	// Tracing settings
+	TracingExporter string // Span exporter: none, stdout, file or otlp (OTEL_EXPORTER_OTLP_* apply)
+	TracingFile     string // Span file of the file exporter
+
+	// Rate limit settings
+	RateLimitEnabled  bool            // Limit request rate and concurrency per client
+	RateLimitBackend  string          // Token bucket store: "memory" (per instance) or "postgres" (shared)
+	RateLimitAsk      RateLimitConfig // Question answering and chat routes (LLM calls)
+	RateLimitFeedback RateLimitConfig // Answer feedback route
+	RateLimitMe       RateLimitConfig // End-user routes
+	RateLimitIngest   RateLimitConfig // Ingestion routes
+	RateLimitAdmin    RateLimitConfig // Admin routes
+
+	// Audit settings
+	AuditRetentionDays int // Days audit records are kept (0 = forever)
+
+	// Prompt injection guard settings
+	InjectionGuardEnabled    bool   // Check questions and retrieved context for prompt injection
+	InjectionGuardAction     string // What to do with a detection: block, sanitize or log
+	InjectionGuardLLM        bool   // Also classify questions with the LLM when the heuristics find nothing
+	InjectionGuardLLMContext bool   // Also classify every retrieved entry with the LLM (one call each)
+
+	// PII redaction settings
+	PIIRedactionEnabled bool // Redact personal data before model calls; mask it in SQL logs and audits
+	PIIRestoreAnswers   bool // Put redacted values back into answers that repeat their placeholder
+
+	// Answer moderation settings
+	AnswerModerationEnabled          bool     // Check generated answers against the answer policy
+	AnswerModerationAction           string   // What to do with a violation: regenerate, fallback or handoff
+	AnswerModerationMaxRegenerations int      // Regenerations before a violating answer falls back
+	AnswerHandoffMessage             string   // Text of answers flagged for handoff to a human agent
+	AnswerPolicyBannedPhrases        []string // Phrases answers must not contain
+	AnswerPolicyURLAllowlist         []string // Hosts answers may link to (and their subdomains)
+	AnswerPolicyMaxLength            int      // Maximum answer length in characters (0 = unlimited)
+	AnswerPolicyRefundPromises       bool     // Flag refund promises the retrieved responses do not make
+	AnswerPolicyMinGrounding         float64  // Share of claims that must be grounded in retrieved responses
+
+	// Slot settings
+	CompanySlots map[string]string // Company-level slot values from SLOT_<NAME> variables
+
+	// Knowledge gap report settings
+	GapMaxSimilarity     float64 // Questions whose best match is less similar are gap candidates
+	GapClusterSimilarity float64 // Minimum similarity of questions grouped in one gap
+}
+
+// RateLimitConfig holds the limits of one route or group of routes
+type RateLimitConfig struct {
+	PerMinute   int // Sustained requests per minute (0 = no rate limit)
+	Burst       int // Requests allowed at once before the sustained rate applies
+	Concurrency int // In-flight requests per client (0 = unlimited)
 }
 
 // Load reads configuration from .env.local file and environment variables
@@ -36,7 +130,97 @@ func Load() *Config {
 		DBPassword:   mustGetEnv("DB_PASSWORD"),
 		DBName:       mustGetEnv("DB_NAME"),
 		DBSSLMode:    getEnvOrDefault("DB_SSLMODE", "disable"),
-		OpenAIAPIKey: mustGetEnv("OPENAI_API_KEY"),
+		OpenAIAPIKey: os.Getenv("OPENAI_API_KEY"),
+
+		LLMProvider:       getEnvOrDefault("LLM_PROVIDER", LLMProviderOpenAI),
+		OpenAIChatModel:   getEnvOrDefault("OPENAI_CHAT_MODEL", "gpt-4o-mini"),
+		PromptTemplateDir: getEnvOrDefault("PROMPT_TEMPLATE_DIR", "prompts"),
+		ExperimentsFile:   os.Getenv("EXPERIMENTS_FILE"),
+
+		EmbedConcurrency:     getEnvIntOrDefault("EMBED_CONCURRENCY", 4),
+		EmbedTokensPerMinute: getEnvIntOrDefault("EMBED_TOKENS_PER_MINUTE", 1000000),
+
+		ChatCompletionModel: getEnvOrDefault("CHAT_COMPLETION_MODEL", "simple-chatbot-rag"),
+
+		AuthEnabled: getEnvBoolOrDefault("AUTH_ENABLED", true),
+		JWTJWKSFile: os.Getenv("JWT_JWKS_FILE"),
+		JWTJWKSURL:  os.Getenv("JWT_JWKS_URL"),
+		JWTIssuer:   os.Getenv("JWT_ISSUER"),
+		JWTAudience: os.Getenv("JWT_AUDIENCE"),
+
+		DBTrIDComment: getEnvBoolOrDefault("DB_TRID_COMMENT", false),
+
+		DBAutoMigrate:        getEnvBoolOrDefault("DB_AUTO_MIGRATE", false),
+		DBAutoMigrateTimeout: getEnvIntOrDefault("DB_AUTO_MIGRATE_TIMEOUT_SECONDS", 300),
+
+		DBMigrationVersion: uint(getEnvIntOrDefault("DB_MIGRATION_VERSION", 0)),
+		ReadyzProbeLLM:     getEnvBoolOrDefault("READYZ_PROBE_LLM", false),
+
+		TracingExporter: getEnvOrDefault("TRACING_EXPORTER", "none"),
+		TracingFile:     getEnvOrDefault("TRACING_FILE", "traces.jsonl"),
+
+		RateLimitEnabled:  getEnvBoolOrDefault("RATE_LIMIT_ENABLED", true),
+		RateLimitBackend:  getEnvOrDefault("RATE_LIMIT_BACKEND", "memory"),
+		RateLimitAsk:      getRateLimitConfig("ASK", 60, 10, 4),
+		RateLimitFeedback: getRateLimitConfig("FEEDBACK", 30, 10, 0),
+		RateLimitMe:       getRateLimitConfig("ME", 120, 30, 0),
+		RateLimitIngest:   getRateLimitConfig("INGEST", 2, 1, 1),
+		RateLimitAdmin:    getRateLimitConfig("ADMIN", 120, 30, 0),
+
+		AuditRetentionDays: getEnvIntOrDefault("AUDIT_RETENTION_DAYS", 90),
+
+		InjectionGuardEnabled:    getEnvBoolOrDefault("INJECTION_GUARD_ENABLED", true),
+		InjectionGuardAction:     getEnvOrDefault("INJECTION_GUARD_ACTION", "log"),
+		InjectionGuardLLM:        getEnvBoolOrDefault("INJECTION_GUARD_LLM", false),
+		InjectionGuardLLMContext: getEnvBoolOrDefault("INJECTION_GUARD_LLM_CONTEXT", false),
+
+		PIIRedactionEnabled: getEnvBoolOrDefault("PII_REDACTION_ENABLED", true),
+		PIIRestoreAnswers:   getEnvBoolOrDefault("PII_RESTORE_ANSWERS", true),
+
+		AnswerModerationEnabled:          getEnvBoolOrDefault("ANSWER_MODERATION_ENABLED", true),
+		AnswerModerationAction:           getEnvOrDefault("ANSWER_MODERATION_ACTION", "regenerate"),
+		AnswerModerationMaxRegenerations: getEnvIntOrDefault("ANSWER_MODERATION_MAX_REGENERATIONS", 1),
+		AnswerHandoffMessage: getEnvOrDefault(
+			"ANSWER_HANDOFF_MESSAGE",
+			"I'm passing your question to a member of our support team, who will get back to you shortly.",
+		),
+		AnswerPolicyBannedPhrases:  getEnvList("ANSWER_POLICY_BANNED_PHRASES"),
+		AnswerPolicyURLAllowlist:   getEnvList("ANSWER_POLICY_URL_ALLOWLIST"),
+		AnswerPolicyMaxLength:      getEnvIntOrDefault("ANSWER_POLICY_MAX_LENGTH", 2000),
+		AnswerPolicyRefundPromises: getEnvBoolOrDefault("ANSWER_POLICY_REFUND_PROMISES", true),
+		AnswerPolicyMinGrounding:   getEnvFloatOrDefault("ANSWER_POLICY_MIN_GROUNDING", 0),
+
+		CompanySlots: getEnvPrefixed("SLOT_"),
+
+		GapMaxSimilarity:     getEnvFloatOrDefault("KNOWLEDGE_GAP_MAX_SIMILARITY", 0.6),
+		GapClusterSimilarity: getEnvFloatOrDefault("KNOWLEDGE_GAP_CLUSTER_SIMILARITY", 0.85),
+	}
+
+	switch cfg.LLMProvider {
+	case LLMProviderOpenAI:
+		cfg.OpenAIAPIKey = mustGetEnv("OPENAI_API_KEY")
+	case LLMProviderFake:
+	default:
+		panic(fmt.Sprintf("LLM_PROVIDER must be openai or fake, got %q", cfg.LLMProvider))
+	}
+
+	if cfg.RateLimitBackend != "memory" && cfg.RateLimitBackend != "postgres" {
+		panic(fmt.Sprintf("RATE_LIMIT_BACKEND must be memory or postgres, got %q", cfg.RateLimitBackend))
+	}
+
+	switch cfg.InjectionGuardAction {
+	case "block", "sanitize", "log":
+	default:
+		panic(fmt.Sprintf("INJECTION_GUARD_ACTION must be block, sanitize or log, got %q", cfg.InjectionGuardAction))
+	}
+
+	switch cfg.AnswerModerationAction {
+	case "regenerate", "fallback", "handoff":
+	default:
+		panic(fmt.Sprintf(
+			"ANSWER_MODERATION_ACTION must be regenerate, fallback or handoff, got %q",
+			cfg.AnswerModerationAction,
+		))
 	}
 
 	log.Printf("Configuration loaded: ENV=%s, PORT=%s, DB=%s@%s:%s/%s",
@@ -63,8 +247,87 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return value
 }
 
+// getEnvIntOrDefault reads an integer environment variable or returns default value
+func getEnvIntOrDefault(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		panic(fmt.Sprintf("environment variable %s must be an integer: %v", key, err))
+	}
+	return parsed
+}
+
+// getEnvFloatOrDefault reads a floating-point environment variable or returns default value
+func getEnvFloatOrDefault(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		panic(fmt.Sprintf("environment variable %s must be a number: %v", key, err))
+	}
+	return parsed
+}
+
+// getEnvList reads a comma-separated environment variable, dropping empty items
+func getEnvList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getEnvPrefixed reads every non-empty environment variable whose name starts with prefix,
+// keyed by the rest of the name (SLOT_ORDER_NUMBER -> ORDER_NUMBER)
+func getEnvPrefixed(prefix string) map[string]string {
+	values := make(map[string]string)
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if name, ok := strings.CutPrefix(key, prefix); ok && name != "" && value != "" {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// getRateLimitConfig reads the RATE_LIMIT_<policy>_* variables of a rate limit policy
+func getRateLimitConfig(policy string, perMinute, burst, concurrency int) RateLimitConfig {
+	return RateLimitConfig{
+		PerMinute:   getEnvIntOrDefault("RATE_LIMIT_"+policy+"_PER_MINUTE", perMinute),
+		Burst:       getEnvIntOrDefault("RATE_LIMIT_"+policy+"_BURST", burst),
+		Concurrency: getEnvIntOrDefault("RATE_LIMIT_"+policy+"_CONCURRENCY", concurrency),
+	}
+}
+
+// JWTEnabled reports whether end-user token authentication is configured
+func (c *Config) JWTEnabled() bool {
+	return c.JWTJWKSFile != "" || c.JWTJWKSURL != ""
+}
+
+// getEnvBoolOrDefault reads a boolean environment variable or returns default value
+func getEnvBoolOrDefault(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		panic(fmt.Sprintf("environment variable %s must be a boolean: %v", key, err))
+	}
+	return parsed
+}
+
 // GetDatabaseURL constructs PostgreSQL connection string
 func (c *Config) GetDatabaseURL() string {
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s&timezone=UTC",
-		c.DBUser, c.DBPassword, c.DBHost, c.DBPort, c.DBName, c.DBSSLMode)
+	return fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=%s&timezone=UTC&application_name=%s",
+		c.DBUser, c.DBPassword, c.DBHost, c.DBPort, c.DBName, c.DBSSLMode, DBApplicationName,
+	)
 }
@@ -1,8 +0,0 @@
-package constants
-
-// Context keys (use unexported type for safety)
-type contextKey string
-
-const (
-	ContextKeyTrID contextKey = "tr_id"
-)
@@ -5,15 +5,26 @@ type ErrorCode string
 // Error codes - 4 digit format starting with 0, aligned with HTTP status codes
 // 04xx: Client errors (matches HTTP 4xx)
 // 05xx: Server errors (matches HTTP 5xx)
+// The HTTP status of each code is registered in pkg/errors.
 
 const (
-	UnknownError ErrorCode = "0000" // HTTP 200 OK
+	UnknownError ErrorCode = "0000" // HTTP 500 Internal Server Error (error without a code)
 	// Client errors (04xx)
 	InvalidParameter ErrorCode = "0400" // HTTP 400 Bad Request
+	Unauthorized     ErrorCode = "0401" // HTTP 401 Unauthorized
+	Forbidden        ErrorCode = "0403" // HTTP 403 Forbidden
 	NotFound         ErrorCode = "0404" // HTTP 404 Not Found
 	ConstraintError  ErrorCode = "0409" // HTTP 409 Conflict
+	PromptInjection  ErrorCode = "0422" // HTTP 422 Unprocessable Entity (prompt-injection guard blocked the request)
+	RateLimited      ErrorCode = "0429" // HTTP 429 Too Many Requests
 
 	// Server errors (05xx)
-	InternalError ErrorCode = "0500" // HTTP 500 Internal Server Error
-	DatabaseError ErrorCode = "0500" // HTTP 500 Internal Server Error
+	InternalError      ErrorCode = "0500" // HTTP 500 Internal Server Error
+	DatabaseError      ErrorCode = "0500" // HTTP 500 Internal Server Error
+	UpstreamError      ErrorCode = "0502" // HTTP 502 Bad Gateway (LLM / embedding provider failed)
+	ServiceUnavailable ErrorCode = "0503" // HTTP 503 Service Unavailable
+	UpstreamTimeout    ErrorCode = "0504" // HTTP 504 Gateway Timeout (LLM / embedding provider timed out)
+	// AnswerPolicyViolation marks a generated answer that broke the answer policy. It is reported
+	// as a warning next to the moderated answer rather than returned to the client.
+	AnswerPolicyViolation ErrorCode = "0520" // HTTP 500 Internal Server Error
 )
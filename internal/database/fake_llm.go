@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	// fakeEmbeddingDimensions matches the knowledge embedding column
+	fakeEmbeddingDimensions = 1536
+	// fakeNoContextReply is the fake chat model's reply when the prompt has no retrieved answer
+	fakeNoContextReply = "I don't have enough information to answer that."
+	// fakeJudgeScore is the score the fake chat model gives on every judge criterion
+	fakeJudgeScore = 5
+)
+
+// fakeChatModel is a deterministic, offline chat model for pipeline tests.
+// It replies with the first retrieved answer ("Answer: ..." line) found in the prompt. When the
+// prompt asks for JSON, the reply is a JSON object with that answer and top judge scores, so
+// both the answer and judge chains can parse it.
+type fakeChatModel struct{}
+
+// NewFakeChatModel creates an offline chat model that never calls a provider
+func NewFakeChatModel() model.BaseChatModel {
+	return &fakeChatModel{}
+}
+
+// Generate returns the fake reply to the messages
+func (m *fakeChatModel) Generate(
+	_ context.Context,
+	input []*schema.Message,
+	_ ...model.Option,
+) (*schema.Message, error) {
+	return schema.AssistantMessage(fakeReply(input), nil), nil
+}
+
+// Stream returns the fake reply to the messages word by word
+func (m *fakeChatModel) Stream(
+	_ context.Context,
+	input []*schema.Message,
+	_ ...model.Option,
+) (*schema.StreamReader[*schema.Message], error) {
+	words := strings.SplitAfter(fakeReply(input), " ")
+	chunks := make([]*schema.Message, len(words))
+	for i, word := range words {
+		chunks[i] = schema.AssistantMessage(word, nil)
+	}
+	return schema.StreamReaderFromArray(chunks), nil
+}
+
+// fakeReply builds the fake chat model's reply to the messages
+func fakeReply(input []*schema.Message) string {
+	answer := fakeNoContextReply
+	wantsJSON := false
+	for _, msg := range input {
+		if strings.Contains(msg.Content, "JSON") {
+			wantsJSON = true
+		}
+		if answer != fakeNoContextReply {
+			continue
+		}
+		for line := range strings.Lines(msg.Content) {
+			if text, ok := strings.CutPrefix(strings.TrimSpace(line), "Answer: "); ok {
+				answer = text
+				break
+			}
+		}
+	}
+
+	if !wantsJSON {
+		return answer
+	}
+	reply, _ := json.Marshal(map[string]any{
+		"answer":       answer,
+		"faithfulness": fakeJudgeScore,
+		"relevance":    fakeJudgeScore,
+		"completeness": fakeJudgeScore,
+		"reasoning":    "scored by the offline fake provider",
+	})
+	return string(reply)
+}
+
+// fakeEmbedder is a deterministic, offline embedder for pipeline tests.
+// Texts are embedded as hashed bags of lowercased words, so texts sharing words are similar.
+type fakeEmbedder struct{}
+
+// NewFakeEmbedder creates an offline embedder that never calls a provider
+func NewFakeEmbedder() embedding.Embedder {
+	return &fakeEmbedder{}
+}
+
+// EmbedStrings embeds every text as a normalized hashed bag of words
+func (e *fakeEmbedder) EmbedStrings(
+	_ context.Context,
+	texts []string,
+	_ ...embedding.Option,
+) ([][]float64, error) {
+	embeddings := make([][]float64, len(texts))
+	for i, text := range texts {
+		vector := make([]float64, fakeEmbeddingDimensions)
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		for _, word := range words {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(word))
+			vector[h.Sum32()%fakeEmbeddingDimensions]++
+		}
+
+		var norm float64
+		for _, v := range vector {
+			norm += v * v
+		}
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for j := range vector {
+				vector[j] /= norm
+			}
+		}
+		embeddings[i] = vector
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,195 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// maxTracedQueryLength caps the SQL recorded on spans (vector literals can be very long)
+const maxTracedQueryLength = 2048
+
+// statementInstrumenter traces statements and tags them with the request's TrID
+type statementInstrumenter struct {
+	commentTrID bool // Prefix statements with a /* trid=... */ comment
+}
+
+// start prepares a statement for execution and starts its span.
+// Only the SQL text is recorded; arguments may contain user data and are left out.
+func (i statementInstrumenter) start(
+	ctx context.Context,
+	name, query string,
+) (context.Context, trace.Span, string) {
+	// TrIDs are validated to [a-zA-Z0-9._:-], so they cannot close the comment
+	if trID := utils.GetTrID(ctx); i.commentTrID && trID != "" {
+		query = "/* trid=" + trID + " */ " + query
+	}
+
+	traced := query
+	if len(traced) > maxTracedQueryLength {
+		traced = traced[:maxTracedQueryLength] + "..."
+	}
+	ctx, span := tracing.StartWithKind(ctx, trace.SpanKindClient, name,
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", traced),
+	)
+	return ctx, span, query
+}
+
+// instrumentedDriver is an ent driver that records a span for every statement and, when
+// enabled, tags it with the TrID so it can be found in pg_stat_activity and the Postgres logs
+type instrumentedDriver struct {
+	dialect.Driver
+	statementInstrumenter
+}
+
+// newInstrumentedDriver wraps an ent driver so its statements are traced and tagged
+func newInstrumentedDriver(drv dialect.Driver, commentTrID bool) dialect.Driver {
+	return &instrumentedDriver{
+		Driver:                drv,
+		statementInstrumenter: statementInstrumenter{commentTrID: commentTrID},
+	}
+}
+
+// Exec instruments and calls the underlying driver Exec method
+func (d *instrumentedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span, query := d.start(ctx, "db.exec", query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// ExecContext instruments and calls the underlying driver ExecContext method
+func (d *instrumentedDriver) ExecContext(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (sql.Result, error) {
+	drv, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New(
+			constants.DatabaseError,
+			"driver does not support ExecContext",
+			nil,
+		)
+	}
+	ctx, span, query := d.start(ctx, "db.exec", query)
+	result, err := drv.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return result, err
+}
+
+// Query instruments and calls the underlying driver Query method
+func (d *instrumentedDriver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span, query := d.start(ctx, "db.query", query)
+	err := d.Driver.Query(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// QueryContext instruments and calls the underlying driver QueryContext method
+func (d *instrumentedDriver) QueryContext(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (*sql.Rows, error) {
+	drv, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New(
+			constants.DatabaseError,
+			"driver does not support QueryContext",
+			nil,
+		)
+	}
+	ctx, span, query := d.start(ctx, "db.query", query)
+	rows, err := drv.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
+}
+
+// Tx starts a transaction whose statements are instrumented
+func (d *instrumentedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedTx{Tx: tx, statementInstrumenter: d.statementInstrumenter}, nil
+}
+
+// instrumentedTx is an ent transaction whose statements are instrumented
+type instrumentedTx struct {
+	dialect.Tx
+	statementInstrumenter
+}
+
+// Exec instruments and calls the underlying transaction Exec method
+func (t *instrumentedTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span, query := t.start(ctx, "db.exec", query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// Query instruments and calls the underlying transaction Query method
+func (t *instrumentedTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span, query := t.start(ctx, "db.query", query)
+	err := t.Tx.Query(ctx, query, args, v)
+	tracing.End(span, err)
+	return err
+}
+
+// ExecContext instruments and calls the underlying transaction ExecContext method
+func (t *instrumentedTx) ExecContext(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (sql.Result, error) {
+	tx, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New(
+			constants.DatabaseError,
+			"transaction does not support ExecContext",
+			nil,
+		)
+	}
+	ctx, span, query := t.start(ctx, "db.exec", query)
+	result, err := tx.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return result, err
+}
+
+// QueryContext instruments and calls the underlying transaction QueryContext method
+func (t *instrumentedTx) QueryContext(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (*sql.Rows, error) {
+	tx, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New(
+			constants.DatabaseError,
+			"transaction does not support QueryContext",
+			nil,
+		)
+	}
+	ctx, span, query := t.start(ctx, "db.query", query)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+	return rows, err
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/cloudwego/eino-ext/components/embedding/openai"
 	"github.com/cloudwego/eino-ext/components/model/ollama"
 	openaimodel "github.com/cloudwego/eino-ext/components/model/openai"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/wonjinsin/simple-chatbot/internal/config"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 )
 
@@ -14,8 +17,8 @@ func NewOllamaLLM() (*ollama.ChatModel, error) {
 	ctx := context.Background()
 	model, err := ollama.NewChatModel(ctx, &ollama.ChatModelConfig{
 		// Basic Configuration
-		BaseURL: "http://localhost:11434", // Ollama service address
-		Timeout: 30 * time.Second,         // Request timeout
+		BaseURL:    "http://localhost:11434",                // Ollama service address
+		HTTPClient: newProviderHTTPClient(30 * time.Second), // Request timeout and TrID forwarding
 
 		// Model Configuration
 		Model: "gemma3:1b", // Model name
@@ -26,12 +29,12 @@ func NewOllamaLLM() (*ollama.ChatModel, error) {
 	return model, nil
 }
 
-func NewChatGPTLLM(k string) (*openaimodel.ChatModel, error) {
+func NewChatGPTLLM(k, modelName string) (*openaimodel.ChatModel, error) {
 	ctx := context.Background()
 	model, err := openaimodel.NewChatModel(ctx, &openaimodel.ChatModelConfig{
-		APIKey:  k,
-		Model:   "gpt-4o-mini",
-		Timeout: 30 * time.Second,
+		APIKey:     k,
+		Model:      modelName,
+		HTTPClient: newProviderHTTPClient(30 * time.Second),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create openai chat model")
@@ -39,15 +42,53 @@ func NewChatGPTLLM(k string) (*openaimodel.ChatModel, error) {
 	return model, nil
 }
 
+// NewChatGPTLLMs creates one chat model per distinct model name
+func NewChatGPTLLMs(k string, modelNames ...string) (map[string]model.BaseChatModel, error) {
+	models := make(map[string]model.BaseChatModel, len(modelNames))
+	for _, name := range modelNames {
+		if name == "" || models[name] != nil {
+			continue
+		}
+		model, err := NewChatGPTLLM(k, name)
+		if err != nil {
+			return nil, err
+		}
+		models[name] = model
+	}
+	return models, nil
+}
+
 func NewChatGPTEmbedder(k string) (*openai.Embedder, error) {
 	ctx := context.Background()
 	embedder, err := openai.NewEmbedder(ctx, &openai.EmbeddingConfig{
-		APIKey:  k,
-		Model:   "text-embedding-3-small",
-		Timeout: 30 * time.Second,
+		APIKey:     k,
+		Model:      "text-embedding-3-small",
+		HTTPClient: newProviderHTTPClient(30 * time.Second),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create openai embedder")
 	}
 	return embedder, nil
 }
+
+// NewChatModels creates one chat model per distinct model name from the configured provider
+func NewChatModels(cfg *config.Config, modelNames ...string) (map[string]model.BaseChatModel, error) {
+	if cfg.LLMProvider == config.LLMProviderFake {
+		models := make(map[string]model.BaseChatModel, len(modelNames))
+		for _, name := range modelNames {
+			if name != "" {
+				models[name] = NewFakeChatModel()
+			}
+		}
+		return models, nil
+	}
+	return NewChatGPTLLMs(cfg.OpenAIAPIKey, modelNames...)
+}
+
+// NewEmbedder creates the embedder of the configured provider
+func NewEmbedder(cfg *config.Config) (embedding.Embedder, error) {
+	if cfg.LLMProvider == config.LLMProviderFake {
+		return NewFakeEmbedder(), nil
+	}
+	return NewChatGPTEmbedder(cfg.OpenAIAPIKey)
+}
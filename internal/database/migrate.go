@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/migrations"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// migrationLockKey is the advisory lock key that serialises replicas migrating at boot
+const migrationLockKey int64 = 0x73636d6967726174 // "scmigrat"
+
+// NewMigrator creates a golang-migrate instance that applies the embedded migrations to db.
+// Closing the migrator closes db.
+func NewMigrator(db *sql.DB) (*migrate.Migrate, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open embedded migrations")
+	}
+
+	driver, err := postgres.WithInstance(db, &postgres.Config{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migration driver")
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate instance")
+	}
+	return m, nil
+}
+
+// ApplyMigrations applies the pending embedded migrations and returns their versions in the
+// order they were applied. It holds a Postgres advisory lock meanwhile, so replicas starting
+// at once migrate one after another, and refuses to touch a dirty migration state.
+func ApplyMigrations(ctx context.Context, db *sql.DB) ([]uint, error) {
+	// The lock belongs to the session, so every statement runs on one dedicated connection
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get migration connection")
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return nil, errors.Wrap(err, "failed to acquire migration lock")
+	}
+	// Unlock before conn goes back to the pool (deferred calls run in reverse order)
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey)
+	}()
+
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open embedded migrations")
+	}
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migration driver")
+	}
+	// m is not closed: closing it would close conn before the lock is released
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate instance")
+	}
+
+	// Step 1: Refuse a dirty state, a previous migration failed halfway
+	version, dirty, err := m.Version()
+	hasVersion := !errors.Is(err, migrate.ErrNilVersion)
+	if err != nil && hasVersion {
+		return nil, errors.Wrap(err, "failed to get migration version")
+	}
+	if dirty {
+		return nil, errors.New(
+			constants.InternalError,
+			fmt.Sprintf("migration version %d is dirty: repair the schema and run `migrate force`", version),
+			nil,
+		)
+	}
+
+	// Step 2: List the migrations after the current version
+	pending, err := pendingMigrations(src, version, hasVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	// Step 3: Apply them
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, errors.Wrap(err, "failed to apply migrations")
+	}
+	return pending, nil
+}
+
+// pendingMigrations lists the versions of src after version, or all of them without a version
+func pendingMigrations(src source.Driver, version uint, hasVersion bool) ([]uint, error) {
+	next, err := src.First()
+	if hasVersion {
+		next, err = src.Next(version)
+	}
+
+	var pending []uint
+	for err == nil {
+		pending = append(pending, next)
+		next, err = src.Next(next)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrap(err, "failed to read embedded migrations")
+	}
+	return pending, nil
+}
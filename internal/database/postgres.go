@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
 
 	"entgo.io/ent/dialect"
@@ -10,6 +11,7 @@ import (
 	"github.com/wonjinsin/simple-chatbot/internal/config"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/pii"
 
 	// Import pgx driver for PostgreSQL database connectivity
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -40,14 +42,19 @@ func NewPostgresDB(cfg *config.Config) (*sql.DB, error) {
 
 // NewEntClient creates a new EntGo client from an existing database connection
 func NewEntClient(db *sql.DB, cfg *config.Config) *ent.Client {
-	drv := entsql.OpenDB(dialect.Postgres, db)
+	drv := newInstrumentedDriver(entsql.OpenDB(dialect.Postgres, db), cfg.DBTrIDComment)
 
 	// Create client with options
 	opts := []ent.Option{ent.Driver(drv)}
 
-	// Enable debug mode in development environment to log SQL queries
+	// Enable debug mode in development environment to log SQL queries (with personal data
+	// masked unless redaction is disabled)
 	if cfg.Env == "local" {
 		opts = append(opts, ent.Debug(), ent.Log(func(args ...any) {
+			if cfg.PIIRedactionEnabled {
+				log.Println(pii.Mask(fmt.Sprint(args...)))
+				return
+			}
 			log.Println(args...)
 		}))
 	}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// tridTransport forwards the request's TrID to LLM and embedding providers so their request
+// logs (and OpenAI's support tooling) can be matched with ours
+type tridTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip adds the TrID headers to a copy of the request
+func (t *tridTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if trID := utils.GetTrID(req.Context()); trID != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(constants.HeaderRequestID, trID)
+		req.Header.Set(constants.HeaderClientRequestID, trID)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// newProviderHTTPClient creates an HTTP client for LLM and embedding providers
+func newProviderHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &tridTransport{base: http.DefaultTransport},
+	}
+}
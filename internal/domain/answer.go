@@ -0,0 +1,35 @@
+package domain
+
+// AnswerMetadata describes how an answer was generated
+type AnswerMetadata struct {
+	TemplateName    string
+	TemplateVersion int
+	Model           string
+	Experiment      string
+	Variant         string
+}
+
+// Answer represents an LLM-generated answer with its generation metadata
+type Answer struct {
+	ID       int // ID of the answer's audit record (0 when the answer is not audited)
+	Text     string
+	Metadata AnswerMetadata
+	// RawOutput is the LLM output before it was parsed, when the answer was parsed from it
+	RawOutput string
+	// Sources is the knowledge retrieved as context for the answer, most similar first
+	Sources InquirySimilarityResults
+	// Prompt is the rendered prompt sent to the LLM, when the answer was generated from one
+	Prompt ChatMessages
+	// Handoff is set when the answer failed moderation and the customer should be handed off to a
+	// human agent
+	Handoff bool
+	// Warnings holds non-fatal errors that did not prevent the answer (logged by the handler)
+	Warnings []error
+}
+
+// GenerationOptions overrides how an answer is generated; zero values keep the defaults
+type GenerationOptions struct {
+	TemplateName    string
+	TemplateVersion int
+	Model           string
+}
@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+const (
+	// MinJudgeScore is the lowest score a judge gives on a criterion
+	MinJudgeScore = 1
+	// MaxJudgeScore is the highest score a judge gives on a criterion
+	MaxJudgeScore = 5
+)
+
+// GoldenQuestion is a question with the reference answer a good answer should match
+type GoldenQuestion struct {
+	Question        string
+	ReferenceAnswer string
+	Category        string
+}
+
+// GoldenQuestions is a collection of GoldenQuestion
+type GoldenQuestions []*GoldenQuestion
+
+// NewGoldenQuestionsFromCSVs creates golden questions from CSV rows with question,
+// reference_answer and category columns
+func NewGoldenQuestionsFromCSVs(csvRows []map[string]string) (GoldenQuestions, error) {
+	questions := make(GoldenQuestions, 0, len(csvRows))
+	for i, csvRow := range csvRows {
+		question := strings.TrimSpace(csvRow["question"])
+		if utils.IsEmptyOrWhitespace(question) {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("question of row %d cannot be empty", i+1),
+				nil,
+			)
+		}
+
+		reference := strings.TrimSpace(csvRow["reference_answer"])
+		if utils.IsEmptyOrWhitespace(reference) {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("reference answer of row %d cannot be empty", i+1),
+				nil,
+			)
+		}
+
+		questions = append(questions, &GoldenQuestion{
+			Question:        question,
+			ReferenceAnswer: reference,
+			Category:        strings.TrimSpace(csvRow["category"]),
+		})
+	}
+	return questions, nil
+}
+
+// AnswerJudgement is a judge's scores of one answer, each from MinJudgeScore to MaxJudgeScore
+type AnswerJudgement struct {
+	Faithfulness int // How well the answer sticks to the retrieved context
+	Relevance    int // How well the answer addresses the question
+	Completeness int // How much of the reference answer the answer covers
+	Reasoning    string
+}
+
+// Validate checks that every score is within the judge's scale
+func (j *AnswerJudgement) Validate() error {
+	for _, score := range []struct {
+		name  string
+		value int
+	}{
+		{"faithfulness", j.Faithfulness},
+		{"relevance", j.Relevance},
+		{"completeness", j.Completeness},
+	} {
+		if score.value < MinJudgeScore || score.value > MaxJudgeScore {
+			return errors.New(
+				constants.UpstreamError,
+				fmt.Sprintf(
+					"judge %s score %d is not between %d and %d",
+					score.name, score.value, MinJudgeScore, MaxJudgeScore,
+				),
+				nil,
+			)
+		}
+	}
+	return nil
+}
+
+// AnswerEvalResult is how one golden question was answered and judged. Error is set instead of
+// the judgement when the question could not be answered or judged.
+type AnswerEvalResult struct {
+	Golden    *GoldenQuestion
+	Answer    string
+	Sources   InquirySimilarityResults
+	Judgement *AnswerJudgement
+	Error     string
+}
+
+// AnswerQualityMetrics are judge scores averaged over the judged questions
+type AnswerQualityMetrics struct {
+	Questions    int
+	Failed       int // Questions that could not be answered or judged
+	Faithfulness float64
+	Relevance    float64
+	Completeness float64
+}
+
+// CategoryAnswerQualityMetrics are the answer quality metrics of the questions of one category
+type CategoryAnswerQualityMetrics struct {
+	Category string
+	Metrics  AnswerQualityMetrics
+}
+
+// AnswerEvalReport summarizes an answer quality evaluation run
+type AnswerEvalReport struct {
+	Overall    AnswerQualityMetrics
+	Categories []CategoryAnswerQualityMetrics // Sorted by category
+	Results    []AnswerEvalResult
+}
+
+// NewAnswerEvalReport aggregates the judged results per category and overall
+func NewAnswerEvalReport(results []AnswerEvalResult) *AnswerEvalReport {
+	var overall answerQualitySum
+	byCategory := make(map[string]*answerQualitySum)
+	for _, result := range results {
+		overall.add(result)
+
+		sum, ok := byCategory[result.Golden.Category]
+		if !ok {
+			sum = &answerQualitySum{}
+			byCategory[result.Golden.Category] = sum
+		}
+		sum.add(result)
+	}
+
+	categories := make([]CategoryAnswerQualityMetrics, 0, len(byCategory))
+	for category, sum := range byCategory {
+		categories = append(categories, CategoryAnswerQualityMetrics{
+			Category: category,
+			Metrics:  sum.mean(),
+		})
+	}
+	slices.SortFunc(categories, func(a, b CategoryAnswerQualityMetrics) int {
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	return &AnswerEvalReport{
+		Overall:    overall.mean(),
+		Categories: categories,
+		Results:    results,
+	}
+}
+
+// Regressions lists every overall and per-category score that is more than tolerance below the
+// baseline's, and any increase in failed questions
+func (r *AnswerEvalReport) Regressions(baseline *AnswerEvalReport, tolerance float64) []string {
+	var regressions []string
+	compare := func(scope string, current, base AnswerQualityMetrics) {
+		for _, m := range []struct {
+			name          string
+			current, base float64
+		}{
+			{"faithfulness", current.Faithfulness, base.Faithfulness},
+			{"relevance", current.Relevance, base.Relevance},
+			{"completeness", current.Completeness, base.Completeness},
+		} {
+			if m.current < m.base-tolerance {
+				regressions = append(regressions, fmt.Sprintf(
+					"%s %s: %.4f < baseline %.4f", scope, m.name, m.current, m.base,
+				))
+			}
+		}
+		if current.Failed > base.Failed {
+			regressions = append(regressions, fmt.Sprintf(
+				"%s failed: %d > baseline %d", scope, current.Failed, base.Failed,
+			))
+		}
+	}
+
+	compare("overall", r.Overall, baseline.Overall)
+	for _, base := range baseline.Categories {
+		for _, current := range r.Categories {
+			if current.Category == base.Category {
+				compare(current.Category, current.Metrics, base.Metrics)
+			}
+		}
+	}
+	return regressions
+}
+
+// answerQualitySum accumulates judge scores to average them over judged questions
+type answerQualitySum struct {
+	AnswerQualityMetrics
+}
+
+func (s *answerQualitySum) add(result AnswerEvalResult) {
+	s.Questions++
+	if result.Judgement == nil {
+		s.Failed++
+		return
+	}
+	s.Faithfulness += float64(result.Judgement.Faithfulness)
+	s.Relevance += float64(result.Judgement.Relevance)
+	s.Completeness += float64(result.Judgement.Completeness)
+}
+
+func (s *answerQualitySum) mean() AnswerQualityMetrics {
+	judged := s.Questions - s.Failed
+	if judged == 0 {
+		return AnswerQualityMetrics{Questions: s.Questions, Failed: s.Failed}
+	}
+	n := float64(judged)
+	return AnswerQualityMetrics{
+		Questions:    s.Questions,
+		Failed:       s.Failed,
+		Faithfulness: s.Faithfulness / n,
+		Relevance:    s.Relevance / n,
+		Completeness: s.Completeness / n,
+	}
+}
@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ModerationAction is what answer moderation does with an answer that violates the policy
+type ModerationAction string
+
+const (
+	ModerationActionRegenerate ModerationAction = "regenerate" // Generate again with the violations as feedback
+	ModerationActionFallback   ModerationAction = "fallback"   // Answer with the top retrieved response
+	ModerationActionHandoff    ModerationAction = "handoff"    // Flag the answer for a human agent
+)
+
+// Rules an answer can violate
+const (
+	PolicyRuleBannedPhrase  = "banned_phrase"
+	PolicyRuleRefundPromise = "refund_promise"
+	PolicyRuleURL           = "url"
+	PolicyRuleMaxLength     = "max_length"
+	PolicyRuleUngrounded    = "ungrounded"
+)
+
+// groundedWordShare is the share of a sentence's content words the sources must contain for
+// the sentence to count as grounded
+const groundedWordShare = 0.6
+
+// minClaimWords is the number of content words a sentence needs to count as a claim; shorter
+// sentences (greetings, "Thanks!") are not checked for grounding
+const minClaimWords = 3
+
+var (
+	sentenceSplitPattern = regexp.MustCompile(`[.!?]+\s+|\n+`)
+	urlPattern           = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'()\[\]]+|\bwww\.[^\s<>"'()\[\]]+`)
+	refundPromisePattern = regexp.MustCompile(`(?i)\b(we|i)\s*('ll|will|can|shall|are going to|am going to)\s+` +
+		`(\w+\s+){0,3}(refund|reimburse)|\b(full|guaranteed|immediate|instant|100%)\s+(refund|reimbursement)\b|` +
+		`\brefund(s|ed)?\b[^.\n]{0,40}\b(guaranteed?|no questions asked|immediately|right away|today)\b`)
+)
+
+// stopWords are left out of the grounding comparison
+var stopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "you": true, "your": true, "our": true, "are": true,
+	"with": true, "that": true, "this": true, "can": true, "will": true, "have": true, "has": true,
+	"not": true, "but": true, "any": true, "all": true, "from": true, "they": true, "them": true,
+	"was": true, "were": true, "been": true, "its": true, "into": true, "about": true, "please": true,
+	"would": true, "could": true, "should": true, "there": true, "their": true, "what": true,
+	"when": true, "which": true, "who": true, "how": true, "also": true, "may": true, "might": true,
+	"just": true, "than": true, "then": true, "more": true, "some": true, "such": true, "here": true,
+	"out": true, "yours": true, "ours": true, "let": true, "know": true, "help": true, "need": true,
+}
+
+// AnswerPolicy is what a generated answer must comply with before it reaches a customer
+type AnswerPolicy struct {
+	BannedPhrases   []string // Phrases the answer must not contain (case-insensitive)
+	AllowedURLHosts []string // Hosts (and their subdomains) the answer may link to; empty allows no links
+	MaxLength       int      // Maximum answer length in characters (0 = unlimited)
+	// RefundPromises flags promises of refunds the retrieved responses do not make
+	RefundPromises bool
+	// MinGrounding is the share of the answer's claims that must be grounded in the retrieved
+	// responses (0 = no grounding check)
+	MinGrounding float64
+}
+
+// PolicyViolation is a rule an answer broke
+type PolicyViolation struct {
+	Rule   string
+	Detail string
+}
+
+// PolicyViolations is a collection of PolicyViolation
+type PolicyViolations []PolicyViolation
+
+// Rules returns the distinct names of the violated rules
+func (vs PolicyViolations) Rules() []string {
+	var rules []string
+	for _, v := range vs {
+		if !slices.Contains(rules, v.Rule) {
+			rules = append(rules, v.Rule)
+		}
+	}
+	return rules
+}
+
+// String describes the violations, one "rule: detail" per violation
+func (vs PolicyViolations) String() string {
+	parts := make([]string, 0, len(vs))
+	for _, v := range vs {
+		parts = append(parts, v.Rule+": "+v.Detail)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Check returns the policy violations of answer, given the retrieved responses it should be
+// grounded in
+func (p AnswerPolicy) Check(answer string, sources []string) PolicyViolations {
+	var violations PolicyViolations
+	lower := strings.ToLower(answer)
+
+	for _, phrase := range p.BannedPhrases {
+		if phrase != "" && strings.Contains(lower, strings.ToLower(phrase)) {
+			violations = append(violations, PolicyViolation{
+				Rule:   PolicyRuleBannedPhrase,
+				Detail: fmt.Sprintf("contains %q", phrase),
+			})
+		}
+	}
+
+	if p.RefundPromises {
+		sourceText := normalizeSpace(strings.Join(sources, " "))
+		for _, promise := range refundPromisePattern.FindAllString(answer, -1) {
+			// A promise is within policy when a retrieved response makes it too; sharing the word
+			// "refund" with a response is not enough
+			if !strings.Contains(sourceText, normalizeSpace(promise)) {
+				violations = append(violations, PolicyViolation{
+					Rule:   PolicyRuleRefundPromise,
+					Detail: fmt.Sprintf("promises %q, which the retrieved responses do not", promise),
+				})
+			}
+		}
+	}
+
+	for _, link := range urlPattern.FindAllString(answer, -1) {
+		link = strings.TrimRight(link, ".,;:!?")
+		if !p.allowsURL(link) {
+			violations = append(violations, PolicyViolation{
+				Rule:   PolicyRuleURL,
+				Detail: fmt.Sprintf("links to %s, which is not allowlisted", link),
+			})
+		}
+	}
+
+	if p.MaxLength > 0 {
+		if length := utf8.RuneCountInString(answer); length > p.MaxLength {
+			violations = append(violations, PolicyViolation{
+				Rule:   PolicyRuleMaxLength,
+				Detail: fmt.Sprintf("%d characters, at most %d allowed", length, p.MaxLength),
+			})
+		}
+	}
+
+	if p.MinGrounding > 0 {
+		score, ungrounded := Grounding(answer, sources)
+		if score < p.MinGrounding {
+			detail := fmt.Sprintf("%.0f%% of claims grounded, at least %.0f%% required", score*100, p.MinGrounding*100)
+			if len(ungrounded) > 0 {
+				detail += fmt.Sprintf(" (e.g. %q)", ungrounded[0])
+			}
+			violations = append(violations, PolicyViolation{Rule: PolicyRuleUngrounded, Detail: detail})
+		}
+	}
+
+	return violations
+}
+
+// allowsURL reports whether link points to an allowlisted host or one of its subdomains
+func (p AnswerPolicy) allowsURL(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range p.AllowedURLHosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed != "" && (host == allowed || strings.HasSuffix(host, "."+allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grounding returns the share of the answer's claims (sentences with enough content words)
+// whose content words mostly appear in sources, and the claims that do not. An answer without
+// claims is fully grounded.
+func Grounding(answer string, sources []string) (float64, []string) {
+	vocabulary := contentVocabulary(sources)
+	var claims, grounded int
+	var ungrounded []string
+	for _, sentence := range sentenceSplitPattern.Split(answer, -1) {
+		share, words := groundedShare(sentence, vocabulary)
+		if words < minClaimWords {
+			continue
+		}
+		claims++
+		if share >= groundedWordShare {
+			grounded++
+		} else {
+			ungrounded = append(ungrounded, strings.TrimSpace(sentence))
+		}
+	}
+	if claims == 0 {
+		return 1, nil
+	}
+	return float64(grounded) / float64(claims), ungrounded
+}
+
+// groundedShare returns the share of the content words of text found in vocabulary, and the
+// number of content words
+func groundedShare(text string, vocabulary map[string]bool) (float64, int) {
+	words := contentWords(text)
+	if len(words) == 0 {
+		return 1, 0
+	}
+	found := 0
+	for _, word := range words {
+		if vocabulary[word] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(words)), len(words)
+}
+
+// normalizeSpace lowercases text and collapses its whitespace
+func normalizeSpace(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// contentVocabulary returns the set of content words of texts
+func contentVocabulary(texts []string) map[string]bool {
+	vocabulary := make(map[string]bool)
+	for _, text := range texts {
+		for _, word := range contentWords(text) {
+			vocabulary[word] = true
+		}
+	}
+	return vocabulary
+}
+
+// contentWords returns the lowercased words of text that carry meaning: stop words and words
+// shorter than three characters are left out and plural "s" endings are trimmed
+func contentWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '%'
+	})
+	words := make([]string, 0, len(fields))
+	for _, word := range fields {
+		if utf8.RuneCountInString(word) < 3 || stopWords[word] {
+			continue
+		}
+		if len(word) > 4 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+			word = strings.TrimSuffix(word, "s")
+		}
+		words = append(words, word)
+	}
+	return words
+}
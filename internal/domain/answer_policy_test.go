@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAnswerPolicyCheck(t *testing.T) {
+	sources := []string{
+		"You can return an item within 30 days of delivery for a full refund.",
+		"Track your order on the Orders page of your account.",
+	}
+	tests := []struct {
+		name   string
+		policy AnswerPolicy
+		answer string
+		rules  []string
+	}{
+		{
+			name:   "compliant",
+			policy: AnswerPolicy{BannedPhrases: []string{"guarantee"}, MaxLength: 200, RefundPromises: true},
+			answer: "You can return an item within 30 days of delivery.",
+		},
+		{
+			name:   "banned phrase in any case",
+			policy: AnswerPolicy{BannedPhrases: []string{"Lawsuit", ""}},
+			answer: "We cannot comment on any lawsuit.",
+			rules:  []string{PolicyRuleBannedPhrase},
+		},
+		{
+			name:   "refund promise not in sources",
+			policy: AnswerPolicy{RefundPromises: true},
+			answer: "We will refund you immediately.",
+			rules:  []string{PolicyRuleRefundPromise},
+		},
+		{
+			name:   "refund promise backed by sources",
+			policy: AnswerPolicy{RefundPromises: true},
+			answer: "Items returned within 30 days get a full refund.",
+		},
+		{
+			name:   "refund promises not checked",
+			answer: "We will refund you immediately.",
+		},
+		{
+			name:   "link outside allowlist",
+			policy: AnswerPolicy{AllowedURLHosts: []string{"example.com"}},
+			answer: "See https://evil.test/login for details.",
+			rules:  []string{PolicyRuleURL},
+		},
+		{
+			name:   "link to allowlisted subdomain",
+			policy: AnswerPolicy{AllowedURLHosts: []string{"example.com"}},
+			answer: "See https://help.example.com/returns.",
+		},
+		{
+			name:   "lookalike host",
+			policy: AnswerPolicy{AllowedURLHosts: []string{"example.com"}},
+			answer: "See www.notexample.com.",
+			rules:  []string{PolicyRuleURL},
+		},
+		{
+			name:   "no links allowed without allowlist",
+			answer: "Visit http://example.com",
+			rules:  []string{PolicyRuleURL},
+		},
+		{
+			name:   "too long",
+			policy: AnswerPolicy{MaxLength: 10},
+			answer: "This answer is too long.",
+			rules:  []string{PolicyRuleMaxLength},
+		},
+		{
+			name:   "length counts characters not bytes",
+			policy: AnswerPolicy{MaxLength: 5},
+			answer: "héllo",
+		},
+		{
+			name:   "ungrounded",
+			policy: AnswerPolicy{MinGrounding: 0.5},
+			answer: "Our stores offer free gift wrapping on birthdays.",
+			rules:  []string{PolicyRuleUngrounded},
+		},
+		{
+			name:   "grounded",
+			policy: AnswerPolicy{MinGrounding: 0.5},
+			answer: "Track your order on the Orders page of your account.",
+		},
+		{
+			name:   "several rules",
+			policy: AnswerPolicy{BannedPhrases: []string{"cheap"}, MaxLength: 20},
+			answer: "Cheap flights at https://flights.test",
+			rules:  []string{PolicyRuleBannedPhrase, PolicyRuleURL, PolicyRuleMaxLength},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Check(tt.answer, sources).Rules()
+			if !slices.Equal(got, tt.rules) {
+				t.Errorf("Check(%q) rules = %v, want %v", tt.answer, got, tt.rules)
+			}
+		})
+	}
+}
+
+func TestGrounding(t *testing.T) {
+	sources := []string{"Orders ship within two business days from our warehouse in Berlin."}
+	tests := []struct {
+		name       string
+		answer     string
+		want       float64
+		ungrounded []string
+	}{
+		{
+			name:   "grounded claim",
+			answer: "Orders ship within two business days.",
+			want:   1,
+		},
+		{
+			name:       "ungrounded claim",
+			answer:     "Delivery drones bring parcels overnight.",
+			want:       0,
+			ungrounded: []string{"Delivery drones bring parcels overnight."},
+		},
+		{
+			name:       "half grounded",
+			answer:     "Orders ship within two business days. Delivery drones bring parcels overnight.",
+			want:       0.5,
+			ungrounded: []string{"Delivery drones bring parcels overnight."},
+		},
+		{
+			name:   "short sentences are not claims",
+			answer: "Thanks! Happy to help.",
+			want:   1,
+		},
+		{
+			name:   "empty answer",
+			answer: "",
+			want:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ungrounded := Grounding(tt.answer, sources)
+			if got != tt.want {
+				t.Errorf("Grounding(%q) = %v, want %v", tt.answer, got, tt.want)
+			}
+			if !slices.Equal(ungrounded, tt.ungrounded) {
+				t.Errorf("Grounding(%q) ungrounded = %q, want %q", tt.answer, ungrounded, tt.ungrounded)
+			}
+		})
+	}
+}
+
+func TestRefundPromisePattern(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"We will refund your order.", true},
+		{"I'll gladly refund you.", true},
+		{"We can fully reimburse the shipping costs.", true},
+		{"You are eligible for a full refund.", true},
+		{"Refunds are guaranteed.", true},
+		{"Your refund will be processed right away.", true},
+		{"Refunds are processed within 5 to 7 business days.", false},
+		{"You can request a refund from the Orders page.", false},
+		{"We will review your request.", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := refundPromisePattern.MatchString(tt.text); got != tt.want {
+				t.Errorf("refundPromisePattern.MatchString(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// apiKeyPrefix marks a bearer token as an API key: sk_<keyID>_<secret>
+const apiKeyPrefix = "sk_"
+
+// APIKeyScope is a permission granted to an API key
+type APIKeyScope string
+
+const (
+	// APIKeyScopeAsk allows asking questions and chatting
+	APIKeyScopeAsk APIKeyScope = "ask"
+	// APIKeyScopeIngest allows (re)loading the knowledge base
+	APIKeyScopeIngest APIKeyScope = "ingest"
+	// APIKeyScopeAdmin allows administration and implies every other scope
+	APIKeyScopeAdmin APIKeyScope = "admin"
+)
+
+// APIKeyScopes is a collection of APIKeyScope
+type APIKeyScopes []APIKeyScope
+
+// ParseAPIKeyScopes validates and converts scope names to APIKeyScopes
+func ParseAPIKeyScopes(names []string) (APIKeyScopes, error) {
+	scopes := make(APIKeyScopes, 0, len(names))
+	seen := make(map[APIKeyScope]bool, len(names))
+	for _, name := range names {
+		scope := APIKeyScope(strings.ToLower(strings.TrimSpace(name)))
+		switch scope {
+		case APIKeyScopeAsk, APIKeyScopeIngest, APIKeyScopeAdmin:
+		default:
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("unknown api key scope %q", name),
+				nil,
+			)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+
+	if len(scopes) == 0 {
+		return nil, errors.New(constants.InvalidParameter, "api key needs at least one scope", nil)
+	}
+	return scopes, nil
+}
+
+// Strings converts the scopes to their names
+func (s APIKeyScopes) Strings() []string {
+	names := make([]string, len(s))
+	for i, scope := range s {
+		names[i] = string(scope)
+	}
+	return names
+}
+
+// APIKey is a credential for calling the API; only the hash of its secret is stored
+type APIKey struct {
+	ID         int
+	KeyID      string // Public identifier embedded in the token, safe to log
+	Name       string
+	SecretHash string
+	Scopes     APIKeyScopes
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// APIKeys is a collection of APIKey
+type APIKeys []*APIKey
+
+// NewAPIKey generates a new API key and returns it with its plaintext token.
+// The token is only available here; it cannot be recovered from the stored key.
+func NewAPIKey(name string, scopes APIKeyScopes) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if utils.IsEmptyOrWhitespace(name) {
+		return nil, "", errors.New(constants.InvalidParameter, "api key name cannot be empty", nil)
+	}
+	if len(scopes) == 0 {
+		return nil, "", errors.New(constants.InvalidParameter, "api key needs at least one scope", nil)
+	}
+
+	keyID, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &APIKey{
+		KeyID:      keyID,
+		Name:       name,
+		SecretHash: hashAPIKeySecret(secret),
+		Scopes:     scopes,
+		CreatedAt:  time.Now(),
+	}
+	return key, apiKeyPrefix + keyID + "_" + secret, nil
+}
+
+// ParseAPIKeyToken splits a bearer token into its key ID and secret
+func ParseAPIKeyToken(token string) (keyID, secret string, err error) {
+	rest, ok := strings.CutPrefix(token, apiKeyPrefix)
+	if ok {
+		keyID, secret, ok = strings.Cut(rest, "_")
+	}
+	if !ok || keyID == "" || secret == "" {
+		return "", "", errors.New(constants.Unauthorized, "malformed api key", nil)
+	}
+	return keyID, secret, nil
+}
+
+// IsAPIKeyToken reports whether a bearer token has the API key format
+func IsAPIKeyToken(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+// VerifySecret reports whether the secret matches the stored hash in constant time
+func (k *APIKey) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(k.SecretHash)) == 1
+}
+
+// IsRevoked reports whether the key has been revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// HasScope reports whether the key grants the scope; admin grants every scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == APIKeyScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// hashAPIKeySecret hashes a secret with SHA-256; secrets are random, so no salt or
+// key stretching is needed
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomString generates n random bytes and encodes them
+func randomString(n int, encode func([]byte) string) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate random bytes")
+	}
+	return encode(b), nil
+}
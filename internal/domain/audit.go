@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// AuditRetrieval is one knowledge entry retrieved as context for an answer
+type AuditRetrieval struct {
+	KnowledgeID     int
+	SimilarityScore float64
+}
+
+// AuditLatencies holds how long each stage of answering a question took
+type AuditLatencies struct {
+	Embedding    time.Duration
+	VectorSearch time.Duration
+	LLM          time.Duration
+	Total        time.Duration
+}
+
+// AuditRecord records how a single question was answered, or why it failed
+type AuditRecord struct {
+	ID        int
+	TrID      string
+	UserID    *int   // Set when the question came from an authenticated end user
+	APIKeyID  string // Set when the question came from an API key
+	Question  string
+	Intent    string // Intent of the most similar retrieved knowledge entry
+	Retrieved []AuditRetrieval
+	Metadata  AnswerMetadata
+	RawOutput string // LLM output before it was parsed
+	Answer    string
+	Handoff   bool // Set when moderation handed the customer off to a human agent
+	Latencies AuditLatencies
+	ErrorCode string // Empty when the question was answered
+	Error     string
+	CreatedAt time.Time
+
+	// QuestionEmbedding is the embedding the question was retrieved with, kept for the
+	// knowledge gap report (empty when the question failed before retrieval)
+	QuestionEmbedding Embedding
+}
+
+// AuditRecords is a collection of AuditRecord
+type AuditRecords []*AuditRecord
+
+// SetRetrieved records the retrieved knowledge entries and the intent of the most similar one
+func (a *AuditRecord) SetRetrieved(results InquirySimilarityResults) {
+	a.Retrieved = make([]AuditRetrieval, 0, len(results))
+	for _, result := range results {
+		a.Retrieved = append(a.Retrieved, AuditRetrieval{
+			KnowledgeID:     result.Knowledge.ID,
+			SimilarityScore: result.SimilarityScore,
+		})
+	}
+	if len(results) > 0 {
+		a.Intent = results[0].Knowledge.Intent
+	}
+}
+
+// SetError records the error code and internal message of a failed question
+func (a *AuditRecord) SetError(err error) {
+	if err == nil {
+		return
+	}
+	a.ErrorCode = string(errors.GetCode(err))
+	a.Error = err.Error()
+}
+
+// AskedBy reports whether the caller asked the audited question. Questions asked without
+// authentication (auth disabled) belong only to unauthenticated callers.
+func (a *AuditRecord) AskedBy(userID *int, apiKeyID string) bool {
+	switch {
+	case a.UserID != nil:
+		return userID != nil && *userID == *a.UserID
+	case a.APIKeyID != "":
+		return apiKeyID == a.APIKeyID
+	default:
+		return userID == nil && apiKeyID == ""
+	}
+}
+
+// AuditFilter narrows down a search of audit records; zero values do not filter
+type AuditFilter struct {
+	From      time.Time
+	To        time.Time
+	Intent    string
+	ErrorCode string
+	Offset    int
+	Limit     int
+}
+
+// Validate checks that the time range of the filter is not reversed
+func (f *AuditFilter) Validate() error {
+	if !f.From.IsZero() && !f.To.IsZero() && f.To.Before(f.From) {
+		return errors.New(constants.InvalidParameter, "to must not be before from", nil)
+	}
+	return nil
+}
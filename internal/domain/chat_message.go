@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// ChatRole represents the author of a chat message
+type ChatRole string
+
+const (
+	ChatRoleSystem    ChatRole = "system"
+	ChatRoleUser      ChatRole = "user"
+	ChatRoleAssistant ChatRole = "assistant"
+)
+
+// ChatMessage represents a single message in a conversation
+type ChatMessage struct {
+	Role    ChatRole
+	Content string
+}
+
+// NewChatMessage creates a new ChatMessage with validation
+func NewChatMessage(role, content string) (*ChatMessage, error) {
+	chatRole := ChatRole(strings.ToLower(strings.TrimSpace(role)))
+	switch chatRole {
+	case ChatRoleSystem, ChatRoleUser, ChatRoleAssistant:
+	default:
+		return nil, errors.New(constants.InvalidParameter, "unsupported message role: "+role, nil)
+	}
+
+	return &ChatMessage{
+		Role:    chatRole,
+		Content: content,
+	}, nil
+}
+
+// ChatMessages is a collection of ChatMessage
+type ChatMessages []*ChatMessage
+
+// SplitLastUserMessage returns the content of the last user message and the history before it
+func (ms ChatMessages) SplitLastUserMessage() (string, ChatMessages, error) {
+	for i := len(ms) - 1; i >= 0; i-- {
+		if ms[i].Role != ChatRoleUser {
+			continue
+		}
+		question := strings.TrimSpace(ms[i].Content)
+		if utils.IsEmptyOrWhitespace(question) {
+			return "", nil, errors.New(constants.InvalidParameter, "question cannot be empty", nil)
+		}
+		return question, ms[:i], nil
+	}
+
+	return "", nil, errors.New(constants.InvalidParameter, "no user message found", nil)
+}
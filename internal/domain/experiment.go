@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// VariantOverrides holds the generation settings a variant changes; zero values keep defaults
+type VariantOverrides struct {
+	SimilarityLimit int
+	TemplateName    string
+	TemplateVersion int
+	Model           string
+}
+
+// ExperimentVariant is one arm of an experiment
+type ExperimentVariant struct {
+	Name      string
+	Weight    int
+	Overrides VariantOverrides
+}
+
+// ExperimentVariants is a collection of ExperimentVariant
+type ExperimentVariants []*ExperimentVariant
+
+// Experiment compares generation settings on live traffic by splitting requests into variants
+type Experiment struct {
+	Name     string
+	Enabled  bool
+	Variants ExperimentVariants
+}
+
+// NewExperiment creates a new Experiment with validation
+func NewExperiment(name string, enabled bool, variants ExperimentVariants) (*Experiment, error) {
+	name = strings.TrimSpace(name)
+	if utils.IsEmptyOrWhitespace(name) {
+		return nil, errors.New(constants.InvalidParameter, "experiment name cannot be empty", nil)
+	}
+
+	if len(variants) < 2 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("experiment %q must have at least two variants", name),
+			nil,
+		)
+	}
+
+	seen := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		if utils.IsEmptyOrWhitespace(variant.Name) {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("experiment %q has a variant without a name", name),
+				nil,
+			)
+		}
+		if seen[variant.Name] {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("experiment %q has duplicate variant %q", name, variant.Name),
+				nil,
+			)
+		}
+		seen[variant.Name] = true
+
+		if variant.Weight <= 0 {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("experiment %q variant %q weight must be positive", name, variant.Name),
+				nil,
+			)
+		}
+		if variant.Overrides.SimilarityLimit < 0 || variant.Overrides.TemplateVersion < 0 {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("experiment %q variant %q has negative overrides", name, variant.Name),
+				nil,
+			)
+		}
+	}
+
+	return &Experiment{
+		Name:     name,
+		Enabled:  enabled,
+		Variants: variants,
+	}, nil
+}
+
+// Assign deterministically picks a variant for the subject (e.g. user ID or TrID).
+// The same subject always lands in the same variant for a given experiment.
+func (e *Experiment) Assign(subject string) *ExperimentVariant {
+	totalWeight := 0
+	for _, variant := range e.Variants {
+		totalWeight += variant.Weight
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(e.Name + ":" + subject))
+	bucket := int(hasher.Sum32() % uint32(totalWeight))
+
+	for _, variant := range e.Variants {
+		if bucket < variant.Weight {
+			return variant
+		}
+		bucket -= variant.Weight
+	}
+	return e.Variants[len(e.Variants)-1]
+}
+
+// Experiments is a collection of Experiment
+type Experiments []*Experiment
+
+// ExperimentExposure records that an answer was generated under an experiment variant
+type ExperimentExposure struct {
+	ID            int
+	Experiment    string
+	Variant       string
+	TrID          string
+	AnswerID      *int // Audit record of the answer, scored by the feedback on it
+	Latency       time.Duration
+	FeedbackScore *float64
+	CreatedAt     time.Time
+}
+
+// VariantStat aggregates the outcomes of a single experiment variant
+type VariantStat struct {
+	Experiment       string
+	Variant          string
+	Exposures        int
+	RatedExposures   int
+	AvgFeedbackScore *float64
+	AvgLatency       time.Duration
+	P95Latency       time.Duration
+}
+
+// VariantStats is a collection of VariantStat
+type VariantStats []*VariantStat
@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+const (
+	maxFeedbackReasonLength     = 1000
+	maxFeedbackCorrectionLength = 4000
+)
+
+// FeedbackRating is a thumbs up or down on an answer
+type FeedbackRating string
+
+const (
+	// FeedbackRatingUp marks an answer as helpful
+	FeedbackRatingUp FeedbackRating = "up"
+	// FeedbackRatingDown marks an answer as wrong or unhelpful
+	FeedbackRatingDown FeedbackRating = "down"
+)
+
+// Score returns the rating as a feedback score: 1 for up and 0 for down
+func (r FeedbackRating) Score() float64 {
+	if r == FeedbackRatingUp {
+		return 1
+	}
+	return 0
+}
+
+// AnswerFeedback is a user's rating of an audited answer
+type AnswerFeedback struct {
+	ID           int
+	AnswerID     int // ID of the audit record of the answer
+	Rating       FeedbackRating
+	Reason       string
+	Correction   string // What the answer should have said (optional)
+	KnowledgeIDs []int  // Knowledge entries the answer was generated from
+	UserID       *int
+	APIKeyID     string
+	CreatedAt    time.Time
+	// Warnings holds non-fatal errors that did not prevent the feedback (logged by the handler)
+	Warnings []error
+}
+
+// NewAnswerFeedback creates a new AnswerFeedback for an audited answer with validation.
+// A reason is required when the answer is rated down.
+func NewAnswerFeedback(
+	answer *AuditRecord,
+	rating, reason, correction string,
+	now time.Time,
+) (*AnswerFeedback, error) {
+	r := FeedbackRating(strings.ToLower(strings.TrimSpace(rating)))
+	if r != FeedbackRatingUp && r != FeedbackRatingDown {
+		return nil, errors.New(constants.InvalidParameter, "rating must be up or down", nil)
+	}
+
+	reason = strings.TrimSpace(reason)
+	correction = strings.TrimSpace(correction)
+	if r == FeedbackRatingDown && reason == "" {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"a reason is required when rating an answer down",
+			nil,
+		)
+	}
+	if len(reason) > maxFeedbackReasonLength {
+		return nil, errors.New(constants.InvalidParameter, "reason is too long", nil)
+	}
+	if len(correction) > maxFeedbackCorrectionLength {
+		return nil, errors.New(constants.InvalidParameter, "correction is too long", nil)
+	}
+
+	knowledgeIDs := make([]int, len(answer.Retrieved))
+	for i, item := range answer.Retrieved {
+		knowledgeIDs[i] = item.KnowledgeID
+	}
+
+	return &AnswerFeedback{
+		AnswerID:     answer.ID,
+		Rating:       r,
+		Reason:       reason,
+		Correction:   correction,
+		KnowledgeIDs: knowledgeIDs,
+		CreatedAt:    now,
+	}, nil
+}
+
+// KnowledgeFeedbackStat counts the feedback on answers generated from a knowledge entry
+type KnowledgeFeedbackStat struct {
+	Knowledge     *InquiryKnowledge
+	NegativeCount int
+	PositiveCount int
+}
+
+// KnowledgeFeedbackStats is a collection of KnowledgeFeedbackStat
+type KnowledgeFeedbackStats []*KnowledgeFeedbackStat
+
+// KnowledgeGapCandidate is a question the knowledge base may not cover: its best retrieved
+// entry was not similar enough, or its answer was rated down
+type KnowledgeGapCandidate struct {
+	AnswerID      int
+	Question      string
+	TopSimilarity float64 // Similarity of the best retrieved entry (0 when none was retrieved)
+	RatedDown     bool
+
+	// QuestionEmbedding is the stored embedding of the question (empty when none was stored)
+	QuestionEmbedding Embedding
+}
+
+// KnowledgeGapCandidates is a collection of KnowledgeGapCandidate
+type KnowledgeGapCandidates []*KnowledgeGapCandidate
+
+// KnowledgeGap is a cluster of similar questions the knowledge base does not answer well
+type KnowledgeGap struct {
+	Representative   string // Question the cluster was started from
+	Questions        []string
+	AnswerIDs        []int
+	AvgTopSimilarity float64
+	RatedDownCount   int
+}
+
+// KnowledgeGaps is a collection of KnowledgeGap
+type KnowledgeGaps []*KnowledgeGap
+
+// ClusterKnowledgeGaps groups candidates whose question embeddings are at least minSimilarity
+// similar to a cluster's first question. Clusters are ordered by size, then by how many of
+// their answers were rated down.
+func ClusterKnowledgeGaps(
+	candidates KnowledgeGapCandidates,
+	embeddings Embeddings,
+	minSimilarity float64,
+) KnowledgeGaps {
+	var (
+		gaps    KnowledgeGaps
+		leaders Embeddings
+		sums    []float64
+	)
+	for i, candidate := range candidates {
+		cluster := -1
+		for j, leader := range leaders {
+			if utils.CalculateVectorSimilarity(embeddings[i], leader) >= minSimilarity {
+				cluster = j
+				break
+			}
+		}
+		if cluster == -1 {
+			gaps = append(gaps, &KnowledgeGap{Representative: candidate.Question})
+			leaders = append(leaders, embeddings[i])
+			sums = append(sums, 0)
+			cluster = len(gaps) - 1
+		}
+
+		gap := gaps[cluster]
+		gap.Questions = append(gap.Questions, candidate.Question)
+		gap.AnswerIDs = append(gap.AnswerIDs, candidate.AnswerID)
+		if candidate.RatedDown {
+			gap.RatedDownCount++
+		}
+		sums[cluster] += candidate.TopSimilarity
+	}
+
+	for i, gap := range gaps {
+		gap.AvgTopSimilarity = sums[i] / float64(len(gap.Questions))
+	}
+
+	slices.SortStableFunc(gaps, func(a, b *KnowledgeGap) int {
+		if c := cmp.Compare(len(b.Questions), len(a.Questions)); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.RatedDownCount, a.RatedDownCount)
+	})
+	return gaps
+}
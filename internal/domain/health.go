@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+)
+
+// ComponentStatus is the health of a single dependency
+type ComponentStatus string
+
+const (
+	ComponentStatusUp      ComponentStatus = "up"
+	ComponentStatusDown    ComponentStatus = "down"
+	ComponentStatusSkipped ComponentStatus = "skipped" // Check disabled or blocked by a failed dependency
+)
+
+// Readiness components, in the order they are reported
+const (
+	ComponentDatabase   = "database"
+	ComponentPgvector   = "pgvector"
+	ComponentMigrations = "migrations"
+	ComponentKnowledge  = "knowledge"
+	ComponentEmbedding  = "embedding"
+	ComponentLLM        = "llm"
+)
+
+// ComponentHealth is the outcome of checking one dependency
+type ComponentHealth struct {
+	Name      string
+	Status    ComponentStatus
+	Latency   time.Duration
+	Detail    string // Version, count or client-safe failure reason
+	Err       error  // Failure cause (for logs)
+	CheckedAt time.Time
+}
+
+// MigrationState is the schema migration version recorded in the database
+type MigrationState struct {
+	Version uint
+	Dirty   bool // A migration failed halfway and needs manual repair
+}
+
+// HealthReport is the readiness of the service and each of its dependencies
+type HealthReport struct {
+	Components []*ComponentHealth
+	CheckedAt  time.Time
+}
+
+// Ready reports whether no component is down
+func (r *HealthReport) Ready() bool {
+	for _, component := range r.Components {
+		if component.Status == ComponentStatusDown {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// IngestionReport summarizes the result of a knowledge ingestion run
+type IngestionReport struct {
+	Rows            int
+	Batches         int
+	EstimatedTokens int
+	Duration        time.Duration
+}
+
+// RowsPerSecond returns the ingestion throughput in rows per second
+func (r *IngestionReport) RowsPerSecond() float64 {
+	if r == nil || r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Rows) / r.Duration.Seconds()
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -110,6 +111,16 @@ func (is InquiryKnowledges) SetEmbeddings(embeddings Embeddings) {
 	}
 }
 
+// KnowledgeFilter restricts which inquiry knowledge entries are retrieved; zero values do not filter
+type KnowledgeFilter struct {
+	Category string
+}
+
+// Matches reports whether the entry passes the filter
+func (f KnowledgeFilter) Matches(item *InquiryKnowledge) bool {
+	return f.Category == "" || strings.EqualFold(item.Category, f.Category)
+}
+
 // InquirySimilarityResult represents an inquiry knowledge entry with its similarity score
 type InquirySimilarityResult struct {
 	Knowledge       *InquiryKnowledge
@@ -118,3 +129,14 @@ type InquirySimilarityResult struct {
 
 // InquirySimilarityResults is a collection of InquirySimilarityResult
 type InquirySimilarityResults []*InquirySimilarityResult
+
+// ContextText formats the results as the LLM context of an answer
+func (rs InquirySimilarityResults) ContextText() string {
+	var contextBuilder strings.Builder
+	for _, entry := range rs {
+		contextBuilder.WriteString(fmt.Sprintf("Question: %s\n", entry.Knowledge.Instruction))
+		contextBuilder.WriteString(fmt.Sprintf("Answer: %s\n", entry.Knowledge.Response))
+		contextBuilder.WriteString(fmt.Sprintf("Similarity: %.4f\n\n", entry.SimilarityScore))
+	}
+	return contextBuilder.String()
+}
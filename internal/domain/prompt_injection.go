@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// GuardAction is what a guardrail does with content it flags
+type GuardAction string
+
+const (
+	GuardActionBlock    GuardAction = "block"    // Refuse the request
+	GuardActionSanitize GuardAction = "sanitize" // Remove the flagged content and continue
+	GuardActionLogOnly  GuardAction = "log"      // Record the detection and continue unchanged
+)
+
+// Where a prompt injection was found
+const (
+	InjectionSourceQuestion = "question"
+	InjectionSourceContext  = "context"
+)
+
+// How a prompt injection was detected
+const (
+	InjectionDetectorHeuristic = "heuristic"
+	InjectionDetectorLLM       = "llm"
+)
+
+// injectionRule is a heuristic that matches a known prompt-injection phrasing
+type injectionRule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// injectionRules are matched case-insensitively against questions and retrieved knowledge. They
+// target instructions aimed at the assistant itself ("ignore your previous instructions"), so
+// customers talking about store rules or instructions ("bypass the shipping rules", "show me
+// your instructions for returning an item", "ignore my previous delivery instructions") pass.
+var injectionRules = []injectionRule{
+	{
+		name: "ignore_instructions",
+		pattern: regexp.MustCompile(`(?i)\b(ignore|disregard|forget|override|bypass)\s+((all|any|of|the)\s+)*` +
+			`(previous|prior|above|earlier|preceding|your|system)\s+((system|original|initial)\s+)?` +
+			`(instructions?|prompts?|rules|guidelines|directives)\b`),
+	},
+	{
+		name: "role_override",
+		pattern: regexp.MustCompile(`(?i)\byou are (now|no longer) (an?|my)\b|` +
+			`\bfrom now on,? you (are|will act|will respond|must act|must respond)\b|` +
+			`\b(act|behave|respond) as (if you (were|are) )?(an? )?(unrestricted|unfiltered|uncensored|jailbroken)\b`),
+	},
+	{
+		name:    "jailbreak",
+		pattern: regexp.MustCompile(`(?i)\b(jailbreak|jailbroken|do anything now|developer mode|DAN mode)\b`),
+	},
+	{
+		name: "prompt_exfiltration",
+		pattern: regexp.MustCompile(`(?i)\b(reveal|show|print|repeat|output|leak|tell me)\b[^.\n]{0,30}?` +
+			`\b(system (prompt|message|instructions)|(hidden|initial) prompt|hidden instructions|` +
+			`your prompt|(prompt|instructions) you were given)\b`),
+	},
+	{
+		name:    "new_instructions",
+		pattern: regexp.MustCompile(`(?i)\b(new|updated|real|actual) (system )?instructions?\s*:`),
+	},
+	{
+		name: "role_marker",
+		pattern: regexp.MustCompile(`(?im)^\s*(system|assistant)\s*:|<\|(im_start|im_end|system|endoftext)\|>|` +
+			`\[/?INST\]|<</?SYS>>`),
+	},
+}
+
+// InjectionMatch is a span of text matched by a prompt-injection heuristic
+type InjectionMatch struct {
+	Rule       string
+	Start, End int // Byte offsets of the match
+}
+
+// InjectionMatches is a collection of InjectionMatch ordered by position
+type InjectionMatches []InjectionMatch
+
+// DetectInjection returns the spans of text matched by the prompt-injection heuristics
+func DetectInjection(text string) InjectionMatches {
+	var matches InjectionMatches
+	for _, rule := range injectionRules {
+		for _, loc := range rule.pattern.FindAllStringIndex(text, -1) {
+			matches = append(matches, InjectionMatch{Rule: rule.name, Start: loc[0], End: loc[1]})
+		}
+	}
+	slices.SortFunc(matches, func(a, b InjectionMatch) int { return a.Start - b.Start })
+	return matches
+}
+
+// Rules returns the distinct names of the matched rules
+func (ms InjectionMatches) Rules() []string {
+	var rules []string
+	for _, match := range ms {
+		if !slices.Contains(rules, match.Rule) {
+			rules = append(rules, match.Rule)
+		}
+	}
+	return rules
+}
+
+// Sanitize removes the matched spans from text and tidies the whitespace left behind
+func (ms InjectionMatches) Sanitize(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, match := range ms {
+		if match.Start < last {
+			// Overlaps the previous match, which already removed its start
+			last = max(last, match.End)
+			continue
+		}
+		b.WriteString(text[last:match.Start])
+		b.WriteString(" ")
+		last = match.End
+	}
+	b.WriteString(text[last:])
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// InjectionClassification is the verdict of an LLM prompt-injection classifier
+type InjectionClassification struct {
+	Injection bool
+	Reason    string
+}
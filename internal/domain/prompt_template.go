@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// PromptMessage is a single chat message of a prompt template with a Go-template body
+type PromptMessage struct {
+	Role     ChatRole
+	Template string
+}
+
+// PromptMessages is a collection of PromptMessage
+type PromptMessages []*PromptMessage
+
+// PromptTemplate represents a named, versioned chat prompt
+type PromptTemplate struct {
+	Name        string
+	Version     int
+	Description string
+	Variables   []string
+	Messages    PromptMessages
+}
+
+// NewPromptTemplate creates a new PromptTemplate with validation.
+// Every message must be a valid Go template that only references declared variables,
+// and every declared variable must be used by at least one message.
+func NewPromptTemplate(
+	name string,
+	version int,
+	description string,
+	variables []string,
+	messages PromptMessages,
+) (*PromptTemplate, error) {
+	name = strings.TrimSpace(name)
+	if utils.IsEmptyOrWhitespace(name) {
+		return nil, errors.New(constants.InvalidParameter, "prompt template name cannot be empty", nil)
+	}
+
+	if version <= 0 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("prompt template %q version must be greater than 0", name),
+			nil,
+		)
+	}
+
+	if len(messages) == 0 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("prompt template %q must have at least one message", name),
+			nil,
+		)
+	}
+
+	used := make(map[string]bool)
+	for i, msg := range messages {
+		switch msg.Role {
+		case ChatRoleSystem, ChatRoleUser, ChatRoleAssistant:
+		default:
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("prompt template %q message %d has invalid role %q", name, i, msg.Role),
+				nil,
+			)
+		}
+
+		tmpl, err := template.New(name).Parse(msg.Template)
+		if err != nil {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("prompt template %q message %d is not a valid Go template", name, i),
+				err,
+			)
+		}
+		collectFieldNames(tmpl.Root, used)
+	}
+
+	for field := range used {
+		if !slices.Contains(variables, field) {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("prompt template %q uses undeclared variable %q", name, field),
+				nil,
+			)
+		}
+	}
+
+	for _, variable := range variables {
+		if !used[variable] {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("prompt template %q declares unused variable %q", name, variable),
+				nil,
+			)
+		}
+	}
+
+	return &PromptTemplate{
+		Name:        name,
+		Version:     version,
+		Description: strings.TrimSpace(description),
+		Variables:   variables,
+		Messages:    messages,
+	}, nil
+}
+
+// MissingVariables returns the declared variables that are absent from the given values
+func (t *PromptTemplate) MissingVariables(values map[string]any) []string {
+	var missing []string
+	for _, variable := range t.Variables {
+		if _, ok := values[variable]; !ok {
+			missing = append(missing, variable)
+		}
+	}
+	return missing
+}
+
+// PromptTemplates is a collection of PromptTemplate
+type PromptTemplates []*PromptTemplate
+
+// collectFieldNames records the top-level field names (e.g. {{.context}}) used by a template
+func collectFieldNames(node parse.Node, used map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectFieldNames(child, used)
+		}
+	case *parse.ActionNode:
+		collectFieldNames(n.Pipe, used)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectFieldNames(cmd, used)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectFieldNames(arg, used)
+		}
+	case *parse.FieldNode:
+		if len(n.Ident) > 0 {
+			used[n.Ident[0]] = true
+		}
+	case *parse.IfNode:
+		collectFieldNames(n.Pipe, used)
+		collectFieldNames(n.List, used)
+		collectFieldNames(n.ElseList, used)
+	case *parse.RangeNode:
+		// The body of range/with rebinds dot, so only the pipeline and else branch are top-level
+		collectFieldNames(n.Pipe, used)
+		collectFieldNames(n.ElseList, used)
+	case *parse.WithNode:
+		collectFieldNames(n.Pipe, used)
+		collectFieldNames(n.ElseList, used)
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+)
+
+// Rate limit policies, each limiting a route or group of routes with its own buckets
+const (
+	// RateLimitPolicyAsk limits the routes calling the LLM (/inquiry/ask, /chat/*, /v1/*), which
+	// share one budget
+	RateLimitPolicyAsk      = "ask"
+	RateLimitPolicyFeedback = "feedback" // /inquiry/answers/{id}/feedback
+	RateLimitPolicyMe       = "me"       // /me/*
+	RateLimitPolicyIngest   = "ingest"   // /inquiry/embed/origins
+	RateLimitPolicyAdmin    = "admin"    // /admin/*
+)
+
+// RateLimitPolicy limits how often and how concurrently one client may call a group of routes
+type RateLimitPolicy struct {
+	Name              string
+	RequestsPerMinute int // Sustained request rate (0 = no rate limit)
+	Burst             int // Bucket capacity (0 = RequestsPerMinute)
+	Concurrency       int // In-flight requests per client (0 = unlimited)
+}
+
+// Capacity returns the token bucket capacity of the policy
+func (p *RateLimitPolicy) Capacity() int {
+	if p.Burst > 0 {
+		return p.Burst
+	}
+	return p.RequestsPerMinute
+}
+
+// RefillPerSecond returns the token refill rate of the policy
+func (p *RateLimitPolicy) RefillPerSecond() float64 {
+	return float64(p.RequestsPerMinute) / 60.0
+}
+
+// BucketKey returns the key of a client's bucket under the policy
+func (p *RateLimitPolicy) BucketKey(clientKey string) string {
+	return p.Name + ":" + clientKey
+}
+
+// RateLimitDecision is the outcome of taking a token from a client's bucket
+type RateLimitDecision struct {
+	Allowed    bool
+	Limit      int           // Bucket capacity
+	Remaining  int           // Whole tokens left after the request
+	RetryAfter time.Duration // Wait before retrying when not allowed
+}
+
+// NewRateLimitDecision builds a decision from the bucket state after taking a token
+func NewRateLimitDecision(policy *RateLimitPolicy, allowed bool, tokens float64) *RateLimitDecision {
+	decision := &RateLimitDecision{
+		Allowed:   allowed,
+		Limit:     policy.Capacity(),
+		Remaining: max(int(tokens), 0),
+	}
+	if !allowed && policy.RefillPerSecond() > 0 {
+		decision.RetryAfter = time.Duration((1 - tokens) / policy.RefillPerSecond() * float64(time.Second))
+	}
+	return decision
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewRateLimitDecision(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RateLimitPolicy
+		allowed bool
+		tokens  float64
+		want    RateLimitDecision
+	}{
+		{
+			name:    "allowed",
+			policy:  RateLimitPolicy{RequestsPerMinute: 60, Burst: 10},
+			allowed: true,
+			tokens:  4.6,
+			want:    RateLimitDecision{Allowed: true, Limit: 10, Remaining: 4},
+		},
+		{
+			name:    "capacity defaults to the rate",
+			policy:  RateLimitPolicy{RequestsPerMinute: 30},
+			allowed: true,
+			tokens:  29,
+			want:    RateLimitDecision{Allowed: true, Limit: 30, Remaining: 29},
+		},
+		{
+			name:   "refused retries once a token is refilled",
+			policy: RateLimitPolicy{RequestsPerMinute: 60, Burst: 10},
+			tokens: 0.25,
+			want:   RateLimitDecision{Limit: 10, RetryAfter: 750 * time.Millisecond},
+		},
+		{
+			name:   "refused on an empty bucket",
+			policy: RateLimitPolicy{RequestsPerMinute: 30, Burst: 5},
+			tokens: 0,
+			want:   RateLimitDecision{Limit: 5, RetryAfter: 2 * time.Second},
+		},
+		{
+			name:   "refused without a refill rate",
+			policy: RateLimitPolicy{Burst: 5},
+			tokens: 0,
+			want:   RateLimitDecision{Limit: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRateLimitDecision(&tt.policy, tt.allowed, tt.tokens); *got != tt.want {
+				t.Errorf("NewRateLimitDecision() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// RetrievalEvalConfig controls how a labelled knowledge set is split and scored
+type RetrievalEvalConfig struct {
+	Holdout float64 // Share of each intent held out as queries (0 < Holdout < 1)
+	Seed    int64   // Seed of the split, so runs are comparable
+	K       int     // Number of retrieved entries scored per query
+}
+
+// Validate checks that the split leaves entries on both sides and that K is positive
+func (c RetrievalEvalConfig) Validate() error {
+	if c.Holdout <= 0 || c.Holdout >= 1 {
+		return errors.New(constants.InvalidParameter, "holdout must be between 0 and 1", nil)
+	}
+	if c.K <= 0 {
+		return errors.New(constants.InvalidParameter, "k must be greater than 0", nil)
+	}
+	return nil
+}
+
+// SplitForRetrievalEval splits labelled knowledge into an index and held-out queries.
+// The split is stratified by intent and keeps at least one entry of each intent in the index,
+// so every query has something relevant to find.
+func SplitForRetrievalEval(
+	items InquiryKnowledges,
+	cfg RetrievalEvalConfig,
+) (index, queries InquiryKnowledges) {
+	byIntent := make(map[string]InquiryKnowledges)
+	var intents []string
+	for _, item := range items {
+		if _, ok := byIntent[item.Intent]; !ok {
+			intents = append(intents, item.Intent)
+		}
+		byIntent[item.Intent] = append(byIntent[item.Intent], item)
+	}
+	slices.Sort(intents)
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	for _, intent := range intents {
+		group := slices.Clone(byIntent[intent])
+		rng.Shuffle(len(group), func(i, j int) { group[i], group[j] = group[j], group[i] })
+
+		held := min(int(math.Round(float64(len(group))*cfg.Holdout)), len(group)-1)
+		queries = append(queries, group[:held]...)
+		index = append(index, group[held:]...)
+	}
+	return index, queries
+}
+
+// RetrievalQueryResult is what was retrieved for one held-out query
+type RetrievalQueryResult struct {
+	Query   *InquiryKnowledge
+	Results InquirySimilarityResults
+}
+
+// RetrievalMetrics are retrieval quality metrics averaged over queries. An entry is relevant to a
+// query when it has the same intent.
+type RetrievalMetrics struct {
+	Queries        int
+	RecallAtK      float64 // Relevant entries in the top K / min(K, relevant entries in the index)
+	MRR            float64 // Mean reciprocal rank of the first relevant entry (0 when not in the top K)
+	NDCGAtK        float64 // Normalized discounted cumulative gain of the top K
+	IntentAccuracy float64 // Share of queries whose top entry has the query's intent
+}
+
+// CategoryRetrievalMetrics are the retrieval metrics of the queries of one category
+type CategoryRetrievalMetrics struct {
+	Category string
+	Metrics  RetrievalMetrics
+}
+
+// RetrievalReport summarizes a retrieval evaluation run
+type RetrievalReport struct {
+	Config     RetrievalEvalConfig
+	IndexSize  int
+	Overall    RetrievalMetrics
+	Categories []CategoryRetrievalMetrics // Sorted by category
+}
+
+// NewRetrievalReport scores the results of every query against the index they were run on
+func NewRetrievalReport(
+	cfg RetrievalEvalConfig,
+	index InquiryKnowledges,
+	results []RetrievalQueryResult,
+) *RetrievalReport {
+	relevantInIndex := make(map[string]int)
+	for _, item := range index {
+		relevantInIndex[item.Intent]++
+	}
+
+	var overall retrievalMetricsSum
+	byCategory := make(map[string]*retrievalMetricsSum)
+	for _, result := range results {
+		scores := scoreRetrieval(result, relevantInIndex[result.Query.Intent], cfg.K)
+		overall.add(scores)
+
+		sum, ok := byCategory[result.Query.Category]
+		if !ok {
+			sum = &retrievalMetricsSum{}
+			byCategory[result.Query.Category] = sum
+		}
+		sum.add(scores)
+	}
+
+	categories := make([]CategoryRetrievalMetrics, 0, len(byCategory))
+	for category, sum := range byCategory {
+		categories = append(categories, CategoryRetrievalMetrics{
+			Category: category,
+			Metrics:  sum.mean(),
+		})
+	}
+	slices.SortFunc(categories, func(a, b CategoryRetrievalMetrics) int {
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	return &RetrievalReport{
+		Config:     cfg,
+		IndexSize:  len(index),
+		Overall:    overall.mean(),
+		Categories: categories,
+	}
+}
+
+// Regressions lists every overall and per-category metric that is more than tolerance below
+// the baseline's, and every baseline category the report does not cover
+func (r *RetrievalReport) Regressions(baseline *RetrievalReport, tolerance float64) []string {
+	var regressions []string
+	compare := func(scope string, current, base RetrievalMetrics) {
+		for _, m := range []struct {
+			name          string
+			current, base float64
+		}{
+			{"recall@k", current.RecallAtK, base.RecallAtK},
+			{"mrr", current.MRR, base.MRR},
+			{"ndcg@k", current.NDCGAtK, base.NDCGAtK},
+			{"intent_accuracy", current.IntentAccuracy, base.IntentAccuracy},
+		} {
+			if m.current < m.base-tolerance {
+				regressions = append(regressions, fmt.Sprintf(
+					"%s %s: %.4f < baseline %.4f", scope, m.name, m.current, m.base,
+				))
+			}
+		}
+	}
+
+	compare("overall", r.Overall, baseline.Overall)
+	for _, base := range baseline.Categories {
+		i := slices.IndexFunc(r.Categories, func(current CategoryRetrievalMetrics) bool {
+			return current.Category == base.Category
+		})
+		if i == -1 {
+			regressions = append(regressions, fmt.Sprintf(
+				"%s: missing from the report (%d baseline queries)", base.Category, base.Metrics.Queries,
+			))
+			continue
+		}
+		compare(base.Category, r.Categories[i].Metrics, base.Metrics)
+	}
+	return regressions
+}
+
+// scoreRetrieval scores the top k results of one query
+func scoreRetrieval(result RetrievalQueryResult, relevant, k int) RetrievalMetrics {
+	scores := RetrievalMetrics{Queries: 1}
+	top := result.Results[:min(k, len(result.Results))]
+
+	var hits int
+	var dcg float64
+	for rank, entry := range top {
+		if entry.Knowledge.Intent != result.Query.Intent {
+			continue
+		}
+		hits++
+		dcg += 1 / math.Log2(float64(rank+2))
+		if scores.MRR == 0 {
+			scores.MRR = 1 / float64(rank+1)
+		}
+	}
+
+	if ideal := min(k, relevant); ideal > 0 {
+		var idcg float64
+		for rank := range ideal {
+			idcg += 1 / math.Log2(float64(rank+2))
+		}
+		scores.RecallAtK = float64(hits) / float64(ideal)
+		scores.NDCGAtK = dcg / idcg
+	}
+	if len(top) > 0 && top[0].Knowledge.Intent == result.Query.Intent {
+		scores.IntentAccuracy = 1
+	}
+	return scores
+}
+
+// retrievalMetricsSum accumulates per-query metrics to average them
+type retrievalMetricsSum struct {
+	RetrievalMetrics
+}
+
+func (s *retrievalMetricsSum) add(m RetrievalMetrics) {
+	s.Queries += m.Queries
+	s.RecallAtK += m.RecallAtK
+	s.MRR += m.MRR
+	s.NDCGAtK += m.NDCGAtK
+	s.IntentAccuracy += m.IntentAccuracy
+}
+
+func (s *retrievalMetricsSum) mean() RetrievalMetrics {
+	if s.Queries == 0 {
+		return RetrievalMetrics{}
+	}
+	n := float64(s.Queries)
+	return RetrievalMetrics{
+		Queries:        s.Queries,
+		RecallAtK:      s.RecallAtK / n,
+		MRR:            s.MRR / n,
+		NDCGAtK:        s.NDCGAtK / n,
+		IntentAccuracy: s.IntentAccuracy / n,
+	}
+}
@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Slots of the knowledge base ({{Order Number}}, ...) filled per request
+const (
+	SlotOrderNumber     = "Order Number"
+	SlotInvoiceNumber   = "Invoice Number"
+	SlotPersonName      = "Person Name"
+	SlotClientLastName  = "Client Last Name"
+	SlotSalutation      = "Salutation"
+	SlotAccountType     = "Account Type"
+	SlotAccountCategory = "Account Category"
+)
+
+// inlineSlots identify or describe the customer and their records; an unresolved one is removed
+// from its sentence ("your order {{Order Number}} was shipped" reads fine without it). Any other
+// unresolved slot (a phone number, URL or menu label the sentence points to) removes the whole
+// sentence.
+var inlineSlots = map[string]bool{
+	NormalizeSlotName(SlotOrderNumber):     true,
+	NormalizeSlotName(SlotInvoiceNumber):   true,
+	NormalizeSlotName(SlotPersonName):      true,
+	NormalizeSlotName(SlotClientLastName):  true,
+	NormalizeSlotName(SlotSalutation):      true,
+	NormalizeSlotName(SlotAccountType):     true,
+	NormalizeSlotName(SlotAccountCategory): true,
+}
+
+var (
+	// slotPattern matches a slot with an optional "#" prefix, e.g. "#{{Invoice Number}}"
+	slotPattern = regexp.MustCompile(`#?\{\{\s*([^{}]+?)\s*\}\}`)
+	// sentenceEndPattern matches the end of a sentence or line, including the whitespace after it
+	sentenceEndPattern = regexp.MustCompile(`[.!?]+["'”’)\]]*[ \t]+|[.!?]+["'”’)\]]*$|\n+`)
+	// listMarkerPattern matches a segment that is only the number of a list item ("4. ")
+	listMarkerPattern = regexp.MustCompile(`^\s*\d+[.)]\s*$`)
+	// removalLeftoverPattern matches quotes or brackets left empty by a removed slot
+	removalLeftoverPattern  = regexp.MustCompile(`''|""|“”|‘’|\(\s*\)|\[\s*\]`)
+	spaceRunPattern         = regexp.MustCompile(`[ \t]{2,}`)
+	spaceBeforePunctPattern = regexp.MustCompile(`[ \t]+([,.;:!?])`)
+
+	orderNumberPattern = regexp.MustCompile(
+		`(?i)\border\s*(?:number|no\.?|num|id)?\s*(?:is\s*|:\s*)?#?\s*([a-z0-9-]*\d[a-z0-9-]*)`,
+	)
+	invoiceNumberPattern = regexp.MustCompile(
+		`(?i)\b(?:invoice|bill)\s*(?:number|no\.?|num|id)?\s*(?:is\s*|:\s*)?#?\s*([a-z0-9-]*\d[a-z0-9-]*)`,
+	)
+	personNamePattern = regexp.MustCompile(`\b[Mm]y name is ([A-Z][\pL'-]+(?:\s+[A-Z][\pL'-]+)?)`)
+)
+
+// minIdentifierLength keeps short numbers ("order 2 items") from being taken for identifiers
+const minIdentifierLength = 4
+
+// Slots maps normalized slot names to the values that replace them in answers
+type Slots map[string]string
+
+// NewSlots creates slots from values keyed by slot name ("Order Number", "order_number", ...).
+// Empty values are left out.
+func NewSlots(values map[string]string) Slots {
+	slots := make(Slots, len(values))
+	for name, value := range values {
+		slots.Set(name, value)
+	}
+	return slots
+}
+
+// NormalizeSlotName returns the key of a slot name: lowercase words separated by single spaces,
+// so "Order Number", "order_number" and "ORDER  NUMBER" are the same slot
+func NormalizeSlotName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(name, "_", " "))), " ")
+}
+
+// Set sets the value of a slot; an empty value is ignored
+func (s Slots) Set(name, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		s[NormalizeSlotName(name)] = value
+	}
+}
+
+// IsCustomerSlot reports whether a slot describes the customer or their records (an inline
+// slot), the only slots a request may fill; the others (phone numbers, URLs, ...) are
+// company values
+func IsCustomerSlot(name string) bool {
+	return inlineSlots[NormalizeSlotName(name)]
+}
+
+// Get returns the value of a slot
+func (s Slots) Get(name string) (string, bool) {
+	value, ok := s[NormalizeSlotName(name)]
+	return value, ok
+}
+
+// Merge returns the slots of s overridden by those of others, in order
+func (s Slots) Merge(others ...Slots) Slots {
+	merged := make(Slots, len(s))
+	for name, value := range s {
+		merged[name] = value
+	}
+	for _, other := range others {
+		for name, value := range other {
+			merged[name] = value
+		}
+	}
+	return merged
+}
+
+// ExtractSlots returns the order number, invoice number and name a customer gives in text
+func ExtractSlots(text string) Slots {
+	slots := make(Slots)
+	if m := orderNumberPattern.FindStringSubmatch(text); m != nil && len(m[1]) >= minIdentifierLength {
+		slots.Set(SlotOrderNumber, m[1])
+	}
+	if m := invoiceNumberPattern.FindStringSubmatch(text); m != nil && len(m[1]) >= minIdentifierLength {
+		slots.Set(SlotInvoiceNumber, m[1])
+	}
+	if m := personNamePattern.FindStringSubmatch(text); m != nil {
+		slots.Set(SlotPersonName, m[1])
+		if names := strings.Fields(m[1]); len(names) > 1 {
+			slots.Set(SlotClientLastName, names[len(names)-1])
+		}
+	}
+	return slots
+}
+
+// Resolve replaces the slots in text with their values. An unresolved inline slot is removed
+// from its sentence and any other unresolved slot removes its sentence; when that would leave
+// nothing, the unresolved slots are removed from their sentences instead.
+func (s Slots) Resolve(text string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	if resolved := s.resolveSentences(text, true); strings.TrimSpace(resolved) != "" {
+		return resolved
+	}
+	return s.resolveSentences(text, false)
+}
+
+// ResolveStream wraps onChunk so streamed chunks are resolved like Resolve before they are
+// emitted. Text is emitted a sentence at a time, since an unresolved slot can remove its whole
+// sentence; flush emits the last sentence once the stream ends.
+func (s Slots) ResolveStream(
+	onChunk func(chunk string) error,
+) (emit func(chunk string) error, flush func() error) {
+	var pending string
+	// held is the text of the sentences removed before anything was emitted, which Resolve
+	// would keep if every sentence turns out to be removed
+	var held strings.Builder
+	emitted := false
+	emit = func(chunk string) error {
+		pending += chunk
+		// The last sentence may be incomplete or still grow ("." followed by ".."), so keep it
+		sentences := splitSentences(pending)
+		if len(sentences) < 2 {
+			return nil
+		}
+		ready := pending[:len(pending)-len(sentences[len(sentences)-1])]
+		pending = sentences[len(sentences)-1]
+		if !emitted {
+			held.WriteString(ready)
+			ready = held.String()
+		}
+		resolved := s.resolveSentences(ready, true)
+		if strings.TrimSpace(resolved) == "" && (!emitted || resolved == "") {
+			return nil
+		}
+		emitted = true
+		return onChunk(resolved)
+	}
+	flush = func() error {
+		rest := pending
+		pending = ""
+		if !emitted {
+			rest = s.Resolve(held.String() + rest)
+		} else {
+			rest = s.resolveSentences(rest, true)
+		}
+		if rest == "" {
+			return nil
+		}
+		return onChunk(rest)
+	}
+	return emit, flush
+}
+
+// resolveSentences fills the slots of each sentence of text; with drop set, sentences whose
+// unresolved slots are not inline are removed instead
+func (s Slots) resolveSentences(text string, drop bool) string {
+	var b strings.Builder
+	for _, sentence := range splitSentences(text) {
+		if !drop || !s.dropsSentence(sentence) {
+			b.WriteString(s.fill(sentence))
+		}
+	}
+	return b.String()
+}
+
+// dropsSentence reports whether sentence has an unresolved slot that removes the sentence
+func (s Slots) dropsSentence(sentence string) bool {
+	for _, m := range slotPattern.FindAllStringSubmatch(sentence, -1) {
+		name := NormalizeSlotName(m[1])
+		if _, ok := s[name]; !ok && !inlineSlots[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// fill replaces the slots of sentence with their values and removes the unresolved ones,
+// tidying the quotes, brackets and spaces they leave behind
+func (s Slots) fill(sentence string) string {
+	removed := false
+	filled := slotPattern.ReplaceAllStringFunc(sentence, func(slot string) string {
+		m := slotPattern.FindStringSubmatch(slot)
+		if value, ok := s[NormalizeSlotName(m[1])]; ok {
+			prefix := slot[:strings.Index(slot, "{{")]
+			return prefix + value
+		}
+		removed = true
+		return ""
+	})
+	if !removed {
+		return filled
+	}
+	filled = removalLeftoverPattern.ReplaceAllString(filled, "")
+	filled = spaceRunPattern.ReplaceAllString(filled, " ")
+	return spaceBeforePunctPattern.ReplaceAllString(filled, "$1")
+}
+
+// splitSentences splits text into sentences and lines, each keeping the separator after it
+func splitSentences(text string) []string {
+	var sentences []string
+	last := 0
+	for _, loc := range sentenceEndPattern.FindAllStringIndex(text, -1) {
+		// A list item's number belongs to the sentence after it
+		if listMarkerPattern.MatchString(text[last:loc[1]]) {
+			continue
+		}
+		sentences = append(sentences, text[last:loc[1]])
+		last = loc[1]
+	}
+	if last < len(text) {
+		sentences = append(sentences, text[last:])
+	}
+	return sentences
+}
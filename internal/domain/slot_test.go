@@ -0,0 +1,299 @@
+package domain
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestSlotsResolve(t *testing.T) {
+	tests := []struct {
+		name  string
+		slots map[string]string
+		text  string
+		want  string
+	}{
+		{
+			name: "no slots",
+			text: "Your order has shipped.",
+			want: "Your order has shipped.",
+		},
+
+		// Resolved slots
+		{
+			name:  "resolved inline slot",
+			slots: map[string]string{"Order Number": "12345"},
+			text:  "Your order {{Order Number}} has shipped.",
+			want:  "Your order 12345 has shipped.",
+		},
+		{
+			name:  "resolved company slot",
+			slots: map[string]string{"Customer Support Phone Number": "555-0100"},
+			text:  "Call us at {{Customer Support Phone Number}}.",
+			want:  "Call us at 555-0100.",
+		},
+		{
+			name:  "hash prefix is kept",
+			slots: map[string]string{"invoice_number": "INV-9876"},
+			text:  "Invoice #{{Invoice Number}} is paid.",
+			want:  "Invoice #INV-9876 is paid.",
+		},
+		{
+			name:  "slot names match case-insensitively with spaces or underscores",
+			slots: map[string]string{"WEBSITE URL": "https://example.com"},
+			text:  "Visit {{ website_url }} for details.",
+			want:  "Visit https://example.com for details.",
+		},
+
+		// Unresolved inline slots are removed from their sentence
+		{
+			name: "inline slot removed",
+			text: "Your order {{Order Number}} has shipped.",
+			want: "Your order has shipped.",
+		},
+		{
+			name: "hash prefix removed with the slot",
+			text: "Your invoice #{{Invoice Number}} is ready.",
+			want: "Your invoice is ready.",
+		},
+		{
+			name: "empty quotes and brackets removed",
+			text: "Dear {{Salutation}} {{Client Last Name}}, switch to the '{{Account Type}}' plan ({{Account Category}}).",
+			want: "Dear, switch to the plan.",
+		},
+
+		// Unresolved company slots remove their sentence
+		{
+			name: "sentence dropped",
+			text: "Your order has shipped. Call us at {{Customer Support Phone Number}}. Thanks!",
+			want: "Your order has shipped. Thanks!",
+		},
+		{
+			name: "line dropped",
+			text: "Refunds take 5 days.\nSee {{Website URL}} for details\nThanks!",
+			want: "Refunds take 5 days.\nThanks!",
+		},
+		{
+			name: "list item dropped with its number",
+			text: "1. Visit {{Website URL}}.\n2. Log in.",
+			want: "2. Log in.",
+		},
+		{
+			name:  "resolved and dropped slots in one answer",
+			slots: map[string]string{"Order Number": "12345"},
+			text:  "Order {{Order Number}} has shipped. Track it on {{Website URL}}.",
+			want:  "Order 12345 has shipped. ",
+		},
+
+		// Every sentence dropped
+		{
+			name: "everything dropped falls back to removing the slots",
+			text: "Call {{Customer Support Phone Number}}. Or email {{Customer Support Email}}.",
+			want: "Call. Or email.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSlots(tt.slots).Resolve(tt.text); got != tt.want {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlotsResolveStream(t *testing.T) {
+	tests := []struct {
+		name   string
+		slots  map[string]string
+		chunks []string
+		want   string
+	}{
+		{
+			name:   "no slots",
+			chunks: []string{"Your order ", "has shipped. ", "Thanks!"},
+			want:   "Your order has shipped. Thanks!",
+		},
+		{
+			name:   "slot split across chunks",
+			slots:  map[string]string{"Order Number": "12345"},
+			chunks: []string{"Your order {", "{Order Num", "ber}} has shipped. ", "Thanks!"},
+			want:   "Your order 12345 has shipped. Thanks!",
+		},
+		{
+			name:   "inline slot split across chunks removed",
+			chunks: []string{"Your order {{Order", " Number}", "} has shipped."},
+			want:   "Your order has shipped.",
+		},
+		{
+			name:   "sentence split across chunks dropped",
+			chunks: []string{"Your order has shipped. Call {{Customer Support", " Phone Number}}. ", "Thanks!"},
+			want:   "Your order has shipped. Thanks!",
+		},
+		{
+			name:   "first sentence dropped",
+			chunks: []string{"Call {{Customer Support Phone Number}}. ", "Your order ", "{{Order Number}} shipped. ", "Bye."},
+			want:   "Your order shipped. Bye.",
+		},
+		{
+			name:   "everything dropped falls back to removing the slots",
+			chunks: []string{"Call {{Customer Support Phone Number}}. ", "Or email {{Customer Support Email}}."},
+			want:   "Call. Or email.",
+		},
+		{
+			name:   "no chunks",
+			chunks: nil,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slots := NewSlots(tt.slots)
+			var b strings.Builder
+			emit, flush := slots.ResolveStream(func(chunk string) error {
+				if strings.Contains(chunk, "{{") {
+					t.Errorf("emitted unresolved chunk %q", chunk)
+				}
+				b.WriteString(chunk)
+				return nil
+			})
+			for _, chunk := range tt.chunks {
+				if err := emit(chunk); err != nil {
+					t.Fatalf("emit(%q) error = %v", chunk, err)
+				}
+			}
+			if err := flush(); err != nil {
+				t.Fatalf("flush() error = %v", err)
+			}
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("ResolveStream(%q) = %q, want %q", tt.chunks, got, tt.want)
+			}
+			// Streaming must not change the answer
+			if resolved := slots.Resolve(strings.Join(tt.chunks, "")); b.String() != resolved {
+				t.Errorf("ResolveStream(%q) = %q, Resolve() = %q", tt.chunks, b.String(), resolved)
+			}
+		})
+	}
+}
+
+func TestIsCustomerSlot(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Order Number", true},
+		{"order_number", true},
+		{"INVOICE  NUMBER", true},
+		{"Person Name", true},
+		{"Client Last Name", true},
+		{"Salutation", true},
+		{"Account Type", true},
+		{"Account Category", true},
+		{"Customer Support Phone Number", false},
+		{"Website URL", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCustomerSlot(tt.name); got != tt.want {
+				t.Errorf("IsCustomerSlot(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractSlots(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[string]string
+	}{
+		{name: "no slots", text: "Where is my order?"},
+		{name: "short number is not an order number", text: "Can I order 2 items?"},
+
+		// Order numbers
+		{
+			name: "order number with hash",
+			text: "Where is my order #12345?",
+			want: map[string]string{SlotOrderNumber: "12345"},
+		},
+		{
+			name: "order number after is",
+			text: "My order number is AB-1234, it is late",
+			want: map[string]string{SlotOrderNumber: "AB-1234"},
+		},
+		{
+			name: "order id after colon",
+			text: "order id: 77881",
+			want: map[string]string{SlotOrderNumber: "77881"},
+		},
+
+		// Invoice numbers
+		{
+			name: "invoice number",
+			text: "I need a copy of invoice no. 98765",
+			want: map[string]string{SlotInvoiceNumber: "98765"},
+		},
+		{
+			name: "bill number",
+			text: "Bill 2024-001 is wrong",
+			want: map[string]string{SlotInvoiceNumber: "2024-001"},
+		},
+
+		// Names
+		{
+			name: "first and last name",
+			text: "Hi, my name is Jane Doe.",
+			want: map[string]string{SlotPersonName: "Jane Doe", SlotClientLastName: "Doe"},
+		},
+		{
+			name: "first name only",
+			text: "My name is Jane and I need help",
+			want: map[string]string{SlotPersonName: "Jane"},
+		},
+		{name: "lowercase name", text: "my name is jane"},
+
+		// Several slots
+		{
+			name: "order, invoice and name",
+			text: "My name is Jane Doe, order 12345 was billed on invoice INV-2024-7.",
+			want: map[string]string{
+				SlotOrderNumber:    "12345",
+				SlotInvoiceNumber:  "INV-2024-7",
+				SlotPersonName:     "Jane Doe",
+				SlotClientLastName: "Doe",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewSlots(tt.want)
+			if got := ExtractSlots(tt.text); !maps.Equal(got, want) {
+				t.Errorf("ExtractSlots(%q) = %v, want %v", tt.text, got, want)
+			}
+		})
+	}
+}
+
+func TestSlotsMerge(t *testing.T) {
+	company := NewSlots(map[string]string{"Website URL": "https://example.com", "Order Number": "0000"})
+	question := NewSlots(map[string]string{"Order Number": "12345"})
+	request := NewSlots(map[string]string{"order_number": "67890", "Person Name": "Jane"})
+
+	got := company.Merge(question, request)
+	want := NewSlots(map[string]string{
+		"Website URL":  "https://example.com",
+		"Order Number": "67890",
+		"Person Name":  "Jane",
+	})
+	if !maps.Equal(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+	if value, _ := company.Get(SlotOrderNumber); value != "0000" {
+		t.Errorf("Merge() modified the receiver: order number = %q", value)
+	}
+}
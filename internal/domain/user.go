@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// IdentityClaims holds the verified identity claims of an end-user token
+type IdentityClaims struct {
+	Issuer            string
+	Subject           string
+	Name              string
+	PreferredUsername string
+	Email             string
+}
+
+// User is an end user known through an identity provider
+type User struct {
+	ID        int
+	Issuer    string
+	Subject   string
+	Name      string
+	Email     string // Empty when the identity provider does not share it
+	CreatedAt time.Time
+}
+
+// NewUserFromClaims creates a new User from verified identity claims.
+// The display name falls back to the preferred username, the email and finally the subject.
+func NewUserFromClaims(claims *IdentityClaims) (*User, error) {
+	if claims == nil ||
+		utils.IsEmptyOrWhitespace(claims.Issuer) ||
+		utils.IsEmptyOrWhitespace(claims.Subject) {
+		return nil, errors.New(constants.Unauthorized, "token has no issuer or subject", nil)
+	}
+
+	return &User{
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Name:      claims.DisplayName(),
+		Email:     utils.NormalizeEmail(claims.Email),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// DisplayName returns the best available human-readable name of the identity
+func (c *IdentityClaims) DisplayName() string {
+	for _, name := range []string{c.Name, c.PreferredUsername, c.Email, c.Subject} {
+		if name = strings.TrimSpace(name); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// ProfileChanged reports whether the claims carry a different name or email than the user
+func (u *User) ProfileChanged(claims *IdentityClaims) bool {
+	return u.Name != claims.DisplayName() || u.Email != utils.NormalizeEmail(claims.Email)
+}
+
+// HasScope reports whether the user is granted the scope; end users may only ask
+func (u *User) HasScope(scope APIKeyScope) bool {
+	return scope == APIKeyScopeAsk
+}
+
+// ConversationTurn is one question and answer exchanged by a user
+type ConversationTurn struct {
+	ID        int
+	UserID    int
+	TrID      string
+	Question  string
+	Answer    string
+	CreatedAt time.Time
+}
+
+// ConversationTurns is a collection of ConversationTurn
+type ConversationTurns []*ConversationTurn
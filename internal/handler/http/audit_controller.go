@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// AuditController handles audit trail administration requests
+type AuditController struct {
+	svc usecase.AuditService
+}
+
+// NewAuditController creates a new audit controller
+func NewAuditController(svc usecase.AuditService) *AuditController {
+	return &AuditController{svc: svc}
+}
+
+// SearchAuditRecords handles searching the audit trail by time range, intent and error code
+func (c *AuditController) SearchAuditRecords(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "SearchAuditRecords request received")
+
+	offset, limit := utils.ParsePagination(r)
+	filter, err := dto.ToDomainAuditFilter(r.URL.Query(), offset, limit)
+	if err != nil {
+		logger.LogWarn(ctx, "SearchAuditRecords invalid query")
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	records, total, err := c.svc.SearchAuditRecords(ctx, filter)
+	if err != nil {
+		logger.LogError(ctx, "SearchAuditRecords failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "SearchAuditRecords success response received")
+	utils.WriteStandardJSON(
+		w,
+		r,
+		http.StatusOK,
+		dto.ToAuditListResponse(records, total, offset, limit),
+	)
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
 	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
 	"github.com/wonjinsin/simple-chatbot/internal/usecase"
-	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 	"github.com/wonjinsin/simple-chatbot/pkg/logger"
 	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
@@ -21,7 +20,7 @@ func NewBasicChatController(svc usecase.BasicChatService) *BasicChatController {
 	return &BasicChatController{svc: svc}
 }
 
-// Ask handles basic chat request
+// AskBasicChat handles basic chat request
 func (c *BasicChatController) AskBasicChat(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger.LogInfo(ctx, "ask request received")
@@ -38,14 +37,12 @@ func (c *BasicChatController) AskBasicChat(w http.ResponseWriter, r *http.Reques
 	answer, err := c.svc.AskBasicChat(ctx, req.Msg)
 	if err != nil {
 		logger.LogError(ctx, "internal error in ask", err)
-		utils.WriteStandardJSON(w, r, http.StatusInternalServerError, dto.ErrorResult{
-			Msg: err.Error(),
-		}, string(errors.GetCode(err)))
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
 		return
 	}
 
 	logger.LogInfo(ctx, "answer retrieved successfully")
-	utils.WriteStandardJSON(w, r, http.StatusOK, answer)
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToAnswerResponse(answer))
 }
 
 // AskBasicPromptTemplateChat handles basic prompt template chat request
@@ -53,7 +50,7 @@ func (c *BasicChatController) AskBasicPromptTemplateChat(w http.ResponseWriter,
 	ctx := r.Context()
 	logger.LogInfo(ctx, "ask basic prompt template chat request received")
 
-	var req dto.AskRequest
+	var req dto.PromptTemplateChatRequest
 	if err := utils.ParseJSONBody(r, &req); err != nil {
 		logger.LogWarn(ctx, "invalid json in request body")
 		utils.WriteStandardJSON(w, r, http.StatusBadRequest, dto.ErrorResult{
@@ -62,15 +59,13 @@ func (c *BasicChatController) AskBasicPromptTemplateChat(w http.ResponseWriter,
 		return
 	}
 
-	answer, err := c.svc.AskBasicPromptTemplateChat(ctx, req.Msg)
+	answer, err := c.svc.AskBasicPromptTemplateChat(ctx, req.Template, req.Variables)
 	if err != nil {
 		logger.LogError(ctx, "internal error in ask basic prompt template chat", err)
-		utils.WriteStandardJSON(w, r, http.StatusInternalServerError, dto.ErrorResult{
-			Msg: err.Error(),
-		}, string(errors.GetCode(err)))
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
 		return
 	}
 
 	logger.LogInfo(ctx, "answer basic prompt template chat retrieved successfully")
-	utils.WriteStandardJSON(w, r, http.StatusOK, answer)
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToAnswerResponse(answer))
 }
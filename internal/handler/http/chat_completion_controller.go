@@ -0,0 +1,252 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	custommiddleware "github.com/wonjinsin/simple-chatbot/internal/handler/http/middleware"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	pkgConstants "github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// ChatCompletionController exposes the RAG pipeline through the OpenAI Chat Completions protocol
+type ChatCompletionController struct {
+	svc         usecase.InquiryService
+	model       string
+	authEnabled bool
+	created     int64
+}
+
+// NewChatCompletionController creates a new chat completion controller serving the given model.
+// When authEnabled, only principals with the admin scope may request the rendered prompt.
+func NewChatCompletionController(
+	svc usecase.InquiryService,
+	model string,
+	authEnabled bool,
+) *ChatCompletionController {
+	return &ChatCompletionController{
+		svc:         svc,
+		model:       model,
+		authEnabled: authEnabled,
+		created:     time.Now().Unix(),
+	}
+}
+
+// ListModels handles the OpenAI-compatible model list request
+func (c *ChatCompletionController) ListModels(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJSON(w, http.StatusOK, dto.ToModelListResponse(c.model, c.created))
+}
+
+// CreateChatCompletion handles the OpenAI-compatible chat completion request
+func (c *ChatCompletionController) CreateChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "CreateChatCompletion request received")
+
+	// Step 1: Parse and validate request body
+	var req dto.ChatCompletionRequest
+	if err := utils.ParseJSONBody(r, &req); err != nil {
+		logger.LogWarn(ctx, "invalid json in request body")
+		writeOpenAIError(w, http.StatusBadRequest, "invalid json", constants.InvalidParameter)
+		return
+	}
+
+	if req.Model != "" && req.Model != c.model {
+		logger.LogWarn(ctx, "unknown model requested: "+req.Model)
+		writeOpenAIError(
+			w,
+			http.StatusNotFound,
+			fmt.Sprintf("model %q does not exist", req.Model),
+			constants.NotFound,
+		)
+		return
+	}
+
+	messages, err := dto.ToDomainChatMessages(req.Messages)
+	if err != nil {
+		logger.LogWarn(ctx, "invalid chat messages: "+err.Error())
+		code := errors.GetCode(err)
+		writeOpenAIError(w, errors.CodeHTTPStatus(code), errors.PublicMessage(err), code)
+		return
+	}
+
+	if req.Debug && c.authEnabled && !custommiddleware.HasScope(ctx, domain.APIKeyScopeAdmin) {
+		logger.LogWarn(ctx, "debug requested without the admin scope")
+		writeOpenAIError(
+			w,
+			http.StatusForbidden,
+			"debug requires the admin scope",
+			constants.Forbidden,
+		)
+		return
+	}
+
+	id := "chatcmpl-" + utils.GetTrID(ctx)
+	created := time.Now().Unix()
+	filter := dto.ToDomainKnowledgeFilter(req.Filter)
+
+	// Step 2: Stream the answer as server-sent events when requested
+	if req.Stream {
+		c.streamChatCompletion(w, r, id, created, messages, filter, req.Debug)
+		return
+	}
+
+	// Step 3: Answer the conversation in a single response
+	answer, err := c.svc.Chat(ctx, messages, filter)
+	if err != nil {
+		logger.LogError(ctx, "CreateChatCompletion failed", err)
+		code := errors.GetCode(err)
+		writeOpenAIError(w, errors.CodeHTTPStatus(code), errors.PublicMessage(err), code)
+		return
+	}
+
+	for _, warning := range answer.Warnings {
+		logger.LogError(ctx, "CreateChatCompletion completed with warning", warning)
+	}
+
+	logger.WithFields(ctx, map[string]interface{}{
+		"templateName":    answer.Metadata.TemplateName,
+		"templateVersion": answer.Metadata.TemplateVersion,
+		"model":           answer.Metadata.Model,
+	}).Msg("CreateChatCompletion success response received")
+	resp := dto.ToChatCompletionResponse(id, c.model, created, req.Messages, answer.Text)
+	resp.Sources = dto.ToChatCompletionSources(answer.Sources)
+	if req.Debug {
+		resp.Prompt = dto.ToChatCompletionMessages(answer.Prompt)
+	}
+	utils.WriteJSON(w, http.StatusOK, resp)
+}
+
+// streamChatCompletion writes the answer as OpenAI-compatible server-sent events.
+// The last chunk carries the sources and, when debug is set, the rendered prompt.
+func (c *ChatCompletionController) streamChatCompletion(
+	w http.ResponseWriter,
+	r *http.Request,
+	id string,
+	created int64,
+	messages domain.ChatMessages,
+	filter domain.KnowledgeFilter,
+	debug bool,
+) {
+	ctx := r.Context()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		logger.LogError(ctx, "streaming unsupported", nil)
+		writeOpenAIError(
+			w,
+			http.StatusInternalServerError,
+			"streaming unsupported",
+			constants.InternalError,
+		)
+		return
+	}
+
+	started := false
+	startStream := func() {
+		if started {
+			return
+		}
+		started = true
+		w.Header().Set(pkgConstants.HeaderContentType, pkgConstants.ContentTypeEventStream)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+	}
+
+	role := string(domain.ChatRoleAssistant)
+	answer, err := c.svc.ChatStream(ctx, messages, filter, func(chunk string) error {
+		startStream()
+		// Only the first chunk carries the assistant role
+		chunkRole := role
+		role = ""
+		if err := writeSSE(w, dto.ToChatCompletionChunk(
+			id, c.model, created, chunkRole, chunk, false,
+		)); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		logger.LogError(ctx, "CreateChatCompletion stream failed", err)
+		code := errors.GetCode(err)
+		if !started {
+			// Nothing has been sent yet, so a regular error response is still possible
+			writeOpenAIError(w, errors.CodeHTTPStatus(code), errors.PublicMessage(err), code)
+			return
+		}
+		_ = writeSSE(w, dto.OpenAIErrorResponse{Error: dto.OpenAIError{
+			Message: errors.PublicMessage(err),
+			Type:    openAIErrorType(code),
+			Code:    string(code),
+		}})
+		flusher.Flush()
+		return
+	}
+
+	startStream()
+	last := dto.ToChatCompletionChunk(id, c.model, created, "", "", true)
+	last.Sources = dto.ToChatCompletionSources(answer.Sources)
+	if debug {
+		last.Prompt = dto.ToChatCompletionMessages(answer.Prompt)
+	}
+	_ = writeSSE(w, last)
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+
+	for _, warning := range answer.Warnings {
+		logger.LogError(ctx, "CreateChatCompletion stream completed with warning", warning)
+	}
+
+	logger.WithFields(ctx, map[string]interface{}{
+		"templateName":    answer.Metadata.TemplateName,
+		"templateVersion": answer.Metadata.TemplateVersion,
+		"model":           answer.Metadata.Model,
+	}).Msg("CreateChatCompletion stream completed")
+}
+
+// writeSSE writes a single server-sent event with a JSON payload
+func writeSSE(w http.ResponseWriter, v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode stream event")
+	}
+	if _, err := fmt.Fprintf(w, "data: %s\n\n", payload); err != nil {
+		return errors.Wrap(err, "failed to write stream event")
+	}
+	return nil
+}
+
+// writeOpenAIError writes an OpenAI-compatible error response
+func writeOpenAIError(w http.ResponseWriter, status int, msg string, code constants.ErrorCode) {
+	utils.WriteJSON(w, status, dto.OpenAIErrorResponse{Error: dto.OpenAIError{
+		Message: msg,
+		Type:    openAIErrorType(code),
+		Code:    string(code),
+	}})
+}
+
+// openAIErrorType determines the OpenAI error type for an error code
+func openAIErrorType(code constants.ErrorCode) string {
+	status := errors.CodeHTTPStatus(code)
+	switch {
+	case status == http.StatusUnauthorized:
+		return "authentication_error"
+	case status == http.StatusForbidden:
+		return "permission_error"
+	case status == http.StatusTooManyRequests:
+		return "rate_limit_error"
+	case status < http.StatusInternalServerError:
+		return "invalid_request_error"
+	default:
+		return "server_error"
+	}
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// AuditRetrievalResponse represents a knowledge entry retrieved as context for an answer
+type AuditRetrievalResponse struct {
+	KnowledgeID     int     `json:"knowledgeId"`
+	SimilarityScore float64 `json:"similarityScore"`
+}
+
+// AuditLatencyResponse represents how long each stage of answering a question took
+type AuditLatencyResponse struct {
+	EmbeddingMs    int64 `json:"embeddingMs"`
+	VectorSearchMs int64 `json:"vectorSearchMs"`
+	LLMMs          int64 `json:"llmMs"`
+	TotalMs        int64 `json:"totalMs"`
+}
+
+// AuditRecordResponse represents how a single question was answered, or why it failed
+type AuditRecordResponse struct {
+	ID              int                      `json:"id"`
+	TrID            string                   `json:"trid"`
+	UserID          *int                     `json:"userId,omitempty"`
+	APIKeyID        string                   `json:"apiKeyId,omitempty"`
+	Question        string                   `json:"question"`
+	Intent          string                   `json:"intent,omitempty"`
+	Retrieved       []AuditRetrievalResponse `json:"retrieved"`
+	TemplateName    string                   `json:"templateName,omitempty"`
+	TemplateVersion int                      `json:"templateVersion,omitempty"`
+	Model           string                   `json:"model,omitempty"`
+	Experiment      string                   `json:"experiment,omitempty"`
+	Variant         string                   `json:"variant,omitempty"`
+	RawOutput       string                   `json:"rawOutput,omitempty"`
+	Answer          string                   `json:"answer,omitempty"`
+	Handoff         bool                     `json:"handoff,omitempty"`
+	Latency         AuditLatencyResponse     `json:"latency"`
+	ErrorCode       string                   `json:"errorCode,omitempty"`
+	Error           string                   `json:"error,omitempty"`
+	CreatedAt       time.Time                `json:"createdAt"`
+}
+
+// AuditListResponse represents a page of audit records matching a search
+type AuditListResponse struct {
+	Items  []AuditRecordResponse `json:"items"`
+	Total  int                   `json:"total"`
+	Offset int                   `json:"offset"`
+	Limit  int                   `json:"limit"`
+}
@@ -0,0 +1,105 @@
+package dto
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// ToDomainAuditFilter converts audit search query parameters to domain.AuditFilter.
+// from and to are RFC 3339 timestamps; to is exclusive.
+func ToDomainAuditFilter(query url.Values, offset, limit int) (*domain.AuditFilter, error) {
+	filter := &domain.AuditFilter{
+		Intent:    strings.TrimSpace(query.Get("intent")),
+		ErrorCode: strings.TrimSpace(query.Get("code")),
+		Offset:    offset,
+		Limit:     limit,
+	}
+
+	var err error
+	if filter.From, err = parseTimeQuery(query, "from"); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseTimeQuery(query, "to"); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// parseTimeQuery parses an optional RFC 3339 query parameter
+func parseTimeQuery(query url.Values, name string) (time.Time, error) {
+	value := strings.TrimSpace(query.Get(name))
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New(
+			constants.InvalidParameter,
+			name+" must be an RFC 3339 timestamp",
+			err,
+		)
+	}
+	return t, nil
+}
+
+// ToAuditListResponse converts a page of domain.AuditRecords to AuditListResponse
+func ToAuditListResponse(
+	records domain.AuditRecords,
+	total, offset, limit int,
+) *AuditListResponse {
+	items := make([]AuditRecordResponse, 0, len(records))
+	for _, record := range records {
+		items = append(items, toAuditRecordResponse(record))
+	}
+
+	return &AuditListResponse{
+		Items:  items,
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	}
+}
+
+// toAuditRecordResponse converts domain.AuditRecord to AuditRecordResponse
+func toAuditRecordResponse(record *domain.AuditRecord) AuditRecordResponse {
+	retrieved := make([]AuditRetrievalResponse, 0, len(record.Retrieved))
+	for _, item := range record.Retrieved {
+		retrieved = append(retrieved, AuditRetrievalResponse{
+			KnowledgeID:     item.KnowledgeID,
+			SimilarityScore: item.SimilarityScore,
+		})
+	}
+
+	return AuditRecordResponse{
+		ID:              record.ID,
+		TrID:            record.TrID,
+		UserID:          record.UserID,
+		APIKeyID:        record.APIKeyID,
+		Question:        record.Question,
+		Intent:          record.Intent,
+		Retrieved:       retrieved,
+		TemplateName:    record.Metadata.TemplateName,
+		TemplateVersion: record.Metadata.TemplateVersion,
+		Model:           record.Metadata.Model,
+		Experiment:      record.Metadata.Experiment,
+		Variant:         record.Metadata.Variant,
+		RawOutput:       record.RawOutput,
+		Answer:          record.Answer,
+		Handoff:         record.Handoff,
+		Latency: AuditLatencyResponse{
+			EmbeddingMs:    record.Latencies.Embedding.Milliseconds(),
+			VectorSearchMs: record.Latencies.VectorSearch.Milliseconds(),
+			LLMMs:          record.Latencies.LLM.Milliseconds(),
+			TotalMs:        record.Latencies.Total.Milliseconds(),
+		},
+		ErrorCode: record.ErrorCode,
+		Error:     record.Error,
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -0,0 +1,7 @@
+package dto
+
+// PromptTemplateChatRequest represents the request payload for a prompt template chat
+type PromptTemplateChatRequest struct {
+	Template  string         `json:"template"`
+	Variables map[string]any `json:"variables"`
+}
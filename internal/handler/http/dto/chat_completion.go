@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// ChatCompletionRequest represents an OpenAI-compatible chat completion request
+type ChatCompletionRequest struct {
+	Model    string                  `json:"model"`
+	Messages []ChatCompletionMessage `json:"messages"`
+	Stream   bool                    `json:"stream"`
+	// Filter restricts the retrieved knowledge (extension, ignored by OpenAI clients)
+	Filter *ChatCompletionFilter `json:"filter,omitempty"`
+	// Debug returns the rendered prompt with the answer (extension, requires the admin scope)
+	Debug bool `json:"debug,omitempty"`
+}
+
+// ChatCompletionFilter restricts which knowledge entries are retrieved as context
+type ChatCompletionFilter struct {
+	Category string `json:"category,omitempty"`
+}
+
+// ChatCompletionMessage represents a single message in an OpenAI-compatible conversation
+type ChatCompletionMessage struct {
+	Role    string                `json:"role,omitempty"`
+	Content ChatCompletionContent `json:"content"`
+}
+
+// ChatCompletionContent is message content that accepts both a plain string and an array of
+// text content parts, as sent by newer OpenAI SDKs
+type ChatCompletionContent string
+
+// UnmarshalJSON decodes either a string or an array of text content parts
+func (c *ChatCompletionContent) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*c = ChatCompletionContent(text)
+		return nil
+	}
+
+	var parts []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return errors.New(constants.InvalidParameter, "content must be a string or text parts", err)
+	}
+
+	var builder strings.Builder
+	for _, part := range parts {
+		if part.Type == "text" {
+			builder.WriteString(part.Text)
+		}
+	}
+	*c = ChatCompletionContent(builder.String())
+	return nil
+}
+
+// ChatCompletionResponse represents an OpenAI-compatible chat completion (or chunk) response
+type ChatCompletionResponse struct {
+	ID      string                 `json:"id"`
+	Object  string                 `json:"object"`
+	Created int64                  `json:"created"`
+	Model   string                 `json:"model"`
+	Choices []ChatCompletionChoice `json:"choices"`
+	Usage   *ChatCompletionUsage   `json:"usage,omitempty"`
+	// Sources is the knowledge retrieved as context (extension; on the last chunk when streaming)
+	Sources []ChatCompletionSource `json:"sources,omitempty"`
+	// Prompt is the rendered prompt, returned when debug was requested (extension)
+	Prompt []ChatCompletionMessage `json:"prompt,omitempty"`
+}
+
+// ChatCompletionSource represents a knowledge entry retrieved as context for a completion
+type ChatCompletionSource struct {
+	KnowledgeID     int     `json:"knowledge_id"`
+	Instruction     string  `json:"instruction"`
+	Category        string  `json:"category"`
+	Intent          string  `json:"intent"`
+	SimilarityScore float64 `json:"similarity_score"`
+}
+
+// ChatCompletionChoice represents a single completion choice
+type ChatCompletionChoice struct {
+	Index        int                    `json:"index"`
+	Message      *ChatCompletionMessage `json:"message,omitempty"`
+	Delta        *ChatCompletionMessage `json:"delta,omitempty"`
+	FinishReason *string                `json:"finish_reason"`
+}
+
+// ChatCompletionUsage represents estimated token usage of a completion
+type ChatCompletionUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ModelListResponse represents an OpenAI-compatible model list response
+type ModelListResponse struct {
+	Object string          `json:"object"`
+	Data   []ModelResponse `json:"data"`
+}
+
+// ModelResponse represents an OpenAI-compatible model description
+type ModelResponse struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// OpenAIErrorResponse represents an OpenAI-compatible error response
+type OpenAIErrorResponse struct {
+	Error OpenAIError `json:"error"`
+}
+
+// OpenAIError represents the error detail of an OpenAI-compatible error response
+type OpenAIError struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    string `json:"code"`
+}
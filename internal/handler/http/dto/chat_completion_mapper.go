@@ -0,0 +1,143 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+const (
+	chatCompletionObject      = "chat.completion"
+	chatCompletionChunkObject = "chat.completion.chunk"
+	finishReasonStop          = "stop"
+)
+
+// ToDomainChatMessages converts OpenAI-compatible messages to domain.ChatMessages
+func ToDomainChatMessages(messages []ChatCompletionMessage) (domain.ChatMessages, error) {
+	result := make(domain.ChatMessages, 0, len(messages))
+	for _, msg := range messages {
+		chatMessage, err := domain.NewChatMessage(msg.Role, string(msg.Content))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, chatMessage)
+	}
+	return result, nil
+}
+
+// ToDomainKnowledgeFilter converts the request filter to domain.KnowledgeFilter
+func ToDomainKnowledgeFilter(filter *ChatCompletionFilter) domain.KnowledgeFilter {
+	if filter == nil {
+		return domain.KnowledgeFilter{}
+	}
+	return domain.KnowledgeFilter{Category: strings.TrimSpace(filter.Category)}
+}
+
+// ToChatCompletionSources converts the knowledge retrieved for an answer to response sources
+func ToChatCompletionSources(results domain.InquirySimilarityResults) []ChatCompletionSource {
+	sources := make([]ChatCompletionSource, 0, len(results))
+	for _, result := range results {
+		sources = append(sources, ChatCompletionSource{
+			KnowledgeID:     result.Knowledge.ID,
+			Instruction:     result.Knowledge.Instruction,
+			Category:        result.Knowledge.Category,
+			Intent:          result.Knowledge.Intent,
+			SimilarityScore: result.SimilarityScore,
+		})
+	}
+	return sources
+}
+
+// ToChatCompletionMessages converts domain.ChatMessages to OpenAI-compatible messages
+func ToChatCompletionMessages(messages domain.ChatMessages) []ChatCompletionMessage {
+	result := make([]ChatCompletionMessage, 0, len(messages))
+	for _, msg := range messages {
+		result = append(result, ChatCompletionMessage{
+			Role:    string(msg.Role),
+			Content: ChatCompletionContent(msg.Content),
+		})
+	}
+	return result
+}
+
+// ToChatCompletionResponse builds a non-streaming chat completion response
+func ToChatCompletionResponse(
+	id, model string,
+	created int64,
+	messages []ChatCompletionMessage,
+	answer string,
+) *ChatCompletionResponse {
+	promptTokens := 0
+	for _, msg := range messages {
+		promptTokens += utils.EstimateTokens(string(msg.Content))
+	}
+	completionTokens := utils.EstimateTokens(answer)
+	finishReason := finishReasonStop
+
+	return &ChatCompletionResponse{
+		ID:      id,
+		Object:  chatCompletionObject,
+		Created: created,
+		Model:   model,
+		Choices: []ChatCompletionChoice{
+			{
+				Index: 0,
+				Message: &ChatCompletionMessage{
+					Role:    string(domain.ChatRoleAssistant),
+					Content: ChatCompletionContent(answer),
+				},
+				FinishReason: &finishReason,
+			},
+		},
+		Usage: &ChatCompletionUsage{
+			PromptTokens:     promptTokens,
+			CompletionTokens: completionTokens,
+			TotalTokens:      promptTokens + completionTokens,
+		},
+	}
+}
+
+// ToChatCompletionChunk builds a streaming chunk. The first chunk carries the assistant
+// role and the last chunk carries the finish reason with an empty delta.
+func ToChatCompletionChunk(
+	id, model string,
+	created int64,
+	role, content string,
+	finished bool,
+) *ChatCompletionResponse {
+	choice := ChatCompletionChoice{
+		Index: 0,
+		Delta: &ChatCompletionMessage{
+			Role:    role,
+			Content: ChatCompletionContent(content),
+		},
+	}
+	if finished {
+		finishReason := finishReasonStop
+		choice.FinishReason = &finishReason
+	}
+
+	return &ChatCompletionResponse{
+		ID:      id,
+		Object:  chatCompletionChunkObject,
+		Created: created,
+		Model:   model,
+		Choices: []ChatCompletionChoice{choice},
+	}
+}
+
+// ToModelListResponse builds the model list exposing the RAG-backed chatbot model
+func ToModelListResponse(model string, created int64) *ModelListResponse {
+	return &ModelListResponse{
+		Object: "list",
+		Data: []ModelResponse{
+			{
+				ID:      model,
+				Object:  "model",
+				Created: created,
+				OwnedBy: "simple-chatbot",
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package dto
+
+// ExperimentResponse represents a configured experiment
+type ExperimentResponse struct {
+	Name     string            `json:"name"`
+	Enabled  bool              `json:"enabled"`
+	Variants []VariantResponse `json:"variants"`
+}
+
+// VariantResponse represents an experiment variant and its overrides
+type VariantResponse struct {
+	Name            string `json:"name"`
+	Weight          int    `json:"weight"`
+	SimilarityLimit int    `json:"similarityLimit,omitempty"`
+	TemplateName    string `json:"templateName,omitempty"`
+	TemplateVersion int    `json:"templateVersion,omitempty"`
+	Model           string `json:"model,omitempty"`
+}
+
+// VariantStatResponse represents the outcome statistics of a variant
+type VariantStatResponse struct {
+	Variant          string   `json:"variant"`
+	Exposures        int      `json:"exposures"`
+	RatedExposures   int      `json:"ratedExposures"`
+	AvgFeedbackScore *float64 `json:"avgFeedbackScore"`
+	AvgLatencyMs     int64    `json:"avgLatencyMs"`
+	P95LatencyMs     int64    `json:"p95LatencyMs"`
+}
+
+// ExperimentResultsResponse represents the variant comparison of an experiment
+type ExperimentResultsResponse struct {
+	Experiment string                `json:"experiment"`
+	Variants   []VariantStatResponse `json:"variants"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "github.com/wonjinsin/simple-chatbot/internal/domain"
+
+// ToExperimentListResponse converts Experiments domain collection to ExperimentResponse DTOs
+func ToExperimentListResponse(experiments domain.Experiments) []ExperimentResponse {
+	result := make([]ExperimentResponse, 0, len(experiments))
+	for _, experiment := range experiments {
+		variants := make([]VariantResponse, 0, len(experiment.Variants))
+		for _, variant := range experiment.Variants {
+			variants = append(variants, VariantResponse{
+				Name:            variant.Name,
+				Weight:          variant.Weight,
+				SimilarityLimit: variant.Overrides.SimilarityLimit,
+				TemplateName:    variant.Overrides.TemplateName,
+				TemplateVersion: variant.Overrides.TemplateVersion,
+				Model:           variant.Overrides.Model,
+			})
+		}
+
+		result = append(result, ExperimentResponse{
+			Name:     experiment.Name,
+			Enabled:  experiment.Enabled,
+			Variants: variants,
+		})
+	}
+	return result
+}
+
+// ToExperimentResultsResponse converts VariantStats domain collection to ExperimentResultsResponse
+func ToExperimentResultsResponse(name string, stats domain.VariantStats) *ExperimentResultsResponse {
+	variants := make([]VariantStatResponse, 0, len(stats))
+	for _, stat := range stats {
+		variants = append(variants, VariantStatResponse{
+			Variant:          stat.Variant,
+			Exposures:        stat.Exposures,
+			RatedExposures:   stat.RatedExposures,
+			AvgFeedbackScore: stat.AvgFeedbackScore,
+			AvgLatencyMs:     stat.AvgLatency.Milliseconds(),
+			P95LatencyMs:     stat.P95Latency.Milliseconds(),
+		})
+	}
+
+	return &ExperimentResultsResponse{
+		Experiment: name,
+		Variants:   variants,
+	}
+}
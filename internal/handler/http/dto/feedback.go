@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// FeedbackRequest represents the request payload for rating an answer
+type FeedbackRequest struct {
+	Rating     string `json:"rating"`
+	Reason     string `json:"reason"`
+	Correction string `json:"correction"`
+}
+
+// FeedbackResponse represents stored feedback on an answer
+type FeedbackResponse struct {
+	ID           int       `json:"id"`
+	AnswerID     int       `json:"answerId"`
+	Rating       string    `json:"rating"`
+	Reason       string    `json:"reason,omitempty"`
+	Correction   string    `json:"correction,omitempty"`
+	KnowledgeIDs []int     `json:"knowledgeIds"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// KnowledgeFeedbackResponse represents the feedback on answers generated from a knowledge entry
+type KnowledgeFeedbackResponse struct {
+	KnowledgeID   int    `json:"knowledgeId"`
+	Instruction   string `json:"instruction"`
+	Response      string `json:"response"`
+	Category      string `json:"category"`
+	Intent        string `json:"intent"`
+	NegativeCount int    `json:"negativeCount"`
+	PositiveCount int    `json:"positiveCount"`
+}
+
+// KnowledgeFeedbackReportResponse represents knowledge entries ranked by negative feedback
+type KnowledgeFeedbackReportResponse struct {
+	Items []KnowledgeFeedbackResponse `json:"items"`
+}
+
+// KnowledgeGapResponse represents a cluster of questions the knowledge base does not answer well
+type KnowledgeGapResponse struct {
+	Representative   string   `json:"representative"`
+	Size             int      `json:"size"`
+	Questions        []string `json:"questions"`
+	AnswerIDs        []int    `json:"answerIds"`
+	AvgTopSimilarity float64  `json:"avgTopSimilarity"`
+	RatedDownCount   int      `json:"ratedDownCount"`
+}
+
+// KnowledgeGapReportResponse represents knowledge gaps, largest first
+type KnowledgeGapReportResponse struct {
+	Items []KnowledgeGapResponse `json:"items"`
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// ToReportSince converts the optional RFC 3339 "from" query parameter of a report
+func ToReportSince(query url.Values) (time.Time, error) {
+	return parseTimeQuery(query, "from")
+}
+
+// ToFeedbackResponse converts AnswerFeedback domain object to FeedbackResponse DTO
+func ToFeedbackResponse(feedback *domain.AnswerFeedback) *FeedbackResponse {
+	if feedback == nil {
+		return nil
+	}
+
+	return &FeedbackResponse{
+		ID:           feedback.ID,
+		AnswerID:     feedback.AnswerID,
+		Rating:       string(feedback.Rating),
+		Reason:       feedback.Reason,
+		Correction:   feedback.Correction,
+		KnowledgeIDs: feedback.KnowledgeIDs,
+		CreatedAt:    feedback.CreatedAt,
+	}
+}
+
+// ToKnowledgeFeedbackReportResponse converts domain.KnowledgeFeedbackStats to
+// KnowledgeFeedbackReportResponse
+func ToKnowledgeFeedbackReportResponse(
+	stats domain.KnowledgeFeedbackStats,
+) *KnowledgeFeedbackReportResponse {
+	items := make([]KnowledgeFeedbackResponse, 0, len(stats))
+	for _, stat := range stats {
+		items = append(items, KnowledgeFeedbackResponse{
+			KnowledgeID:   stat.Knowledge.ID,
+			Instruction:   stat.Knowledge.Instruction,
+			Response:      stat.Knowledge.Response,
+			Category:      stat.Knowledge.Category,
+			Intent:        stat.Knowledge.Intent,
+			NegativeCount: stat.NegativeCount,
+			PositiveCount: stat.PositiveCount,
+		})
+	}
+	return &KnowledgeFeedbackReportResponse{Items: items}
+}
+
+// ToKnowledgeGapReportResponse converts domain.KnowledgeGaps to KnowledgeGapReportResponse
+func ToKnowledgeGapReportResponse(gaps domain.KnowledgeGaps) *KnowledgeGapReportResponse {
+	items := make([]KnowledgeGapResponse, 0, len(gaps))
+	for _, gap := range gaps {
+		items = append(items, KnowledgeGapResponse{
+			Representative:   gap.Representative,
+			Size:             len(gap.Questions),
+			Questions:        gap.Questions,
+			AnswerIDs:        gap.AnswerIDs,
+			AvgTopSimilarity: gap.AvgTopSimilarity,
+			RatedDownCount:   gap.RatedDownCount,
+		})
+	}
+	return &KnowledgeGapReportResponse{Items: items}
+}
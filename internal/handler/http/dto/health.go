@@ -0,0 +1,15 @@
+package dto
+
+// ReadinessResponse represents the readiness of the service and its dependencies
+type ReadinessResponse struct {
+	Status     string                    `json:"status"`
+	Components []ComponentHealthResponse `json:"components"`
+}
+
+// ComponentHealthResponse represents the health of a single dependency
+type ComponentHealthResponse struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latencyMs"`
+	Detail    string `json:"detail,omitempty"`
+}
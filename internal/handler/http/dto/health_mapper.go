@@ -0,0 +1,23 @@
+package dto
+
+import "github.com/wonjinsin/simple-chatbot/internal/domain"
+
+// ToReadinessResponse converts a HealthReport domain model to ReadinessResponse DTO
+func ToReadinessResponse(report *domain.HealthReport) ReadinessResponse {
+	status := "ready"
+	if !report.Ready() {
+		status = "not_ready"
+	}
+
+	components := make([]ComponentHealthResponse, 0, len(report.Components))
+	for _, component := range report.Components {
+		components = append(components, ComponentHealthResponse{
+			Name:      component.Name,
+			Status:    string(component.Status),
+			LatencyMs: component.Latency.Milliseconds(),
+			Detail:    component.Detail,
+		})
+	}
+
+	return ReadinessResponse{Status: status, Components: components}
+}
@@ -3,9 +3,38 @@ package dto
 // AskRequest represents the request payload for asking a question
 type AskRequest struct {
 	Msg string `json:"msg"`
+	// Slots holds values of the answer's customer slots for this request, e.g.
+	// {"Order Number": "12345"}; company slots (phone numbers, URLs, ...) cannot be set
+	Slots map[string]string `json:"slots,omitempty"`
 }
 
 // AskResponse represents the response payload for a question
 type AskResponse struct {
 	Response string `json:"response"`
 }
+
+// EmbedOriginsResponse represents the response payload for an ingestion run
+type EmbedOriginsResponse struct {
+	Rows            int     `json:"rows"`
+	Batches         int     `json:"batches"`
+	EstimatedTokens int     `json:"estimatedTokens"`
+	DurationMs      int64   `json:"durationMs"`
+	RowsPerSecond   float64 `json:"rowsPerSecond"`
+}
+
+// AnswerResponse represents a generated answer with its generation metadata
+type AnswerResponse struct {
+	ID       int                    `json:"id,omitempty"`
+	Answer   string                 `json:"answer"`
+	Handoff  bool                   `json:"handoff,omitempty"`
+	Metadata AnswerMetadataResponse `json:"metadata"`
+}
+
+// AnswerMetadataResponse represents how an answer was generated
+type AnswerMetadataResponse struct {
+	TemplateName    string `json:"templateName,omitempty"`
+	TemplateVersion int    `json:"templateVersion,omitempty"`
+	Model           string `json:"model,omitempty"`
+	Experiment      string `json:"experiment,omitempty"`
+	Variant         string `json:"variant,omitempty"`
+}
@@ -12,3 +12,43 @@ func ToAskResponse(result *domain.InquirySimilarityResult) *AskResponse {
 		Response: result.Knowledge.Response,
 	}
 }
+
+// ToEmbedOriginsResponse converts IngestionReport domain object to EmbedOriginsResponse DTO
+func ToEmbedOriginsResponse(report *domain.IngestionReport) *EmbedOriginsResponse {
+	if report == nil {
+		return nil
+	}
+
+	return &EmbedOriginsResponse{
+		Rows:            report.Rows,
+		Batches:         report.Batches,
+		EstimatedTokens: report.EstimatedTokens,
+		DurationMs:      report.Duration.Milliseconds(),
+		RowsPerSecond:   report.RowsPerSecond(),
+	}
+}
+
+// ToAnswerResponse converts Answer domain object to AnswerResponse DTO
+func ToAnswerResponse(answer *domain.Answer) *AnswerResponse {
+	if answer == nil {
+		return nil
+	}
+
+	return &AnswerResponse{
+		ID:       answer.ID,
+		Answer:   answer.Text,
+		Handoff:  answer.Handoff,
+		Metadata: ToAnswerMetadataResponse(answer.Metadata),
+	}
+}
+
+// ToAnswerMetadataResponse converts AnswerMetadata domain object to AnswerMetadataResponse DTO
+func ToAnswerMetadataResponse(metadata domain.AnswerMetadata) AnswerMetadataResponse {
+	return AnswerMetadataResponse{
+		TemplateName:    metadata.TemplateName,
+		TemplateVersion: metadata.TemplateVersion,
+		Model:           metadata.Model,
+		Experiment:      metadata.Experiment,
+		Variant:         metadata.Variant,
+	}
+}
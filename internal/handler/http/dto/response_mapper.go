@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/wonjinsin/simple-chatbot/pkg/errors"
+
+// ToErrorResult converts an error to ErrorResult exposing only its client-safe message
+func ToErrorResult(err error) ErrorResult {
+	return ErrorResult{Msg: errors.PublicMessage(err)}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// UserResponse represents the authenticated end user
+type UserResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ConversationTurnResponse represents one question and answer of the user's history
+type ConversationTurnResponse struct {
+	ID        int       `json:"id"`
+	TrID      string    `json:"trid"`
+	Question  string    `json:"question"`
+	Answer    string    `json:"answer"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ConversationListResponse represents a page of the user's conversation history
+type ConversationListResponse struct {
+	Items  []ConversationTurnResponse `json:"items"`
+	Total  int                        `json:"total"`
+	Offset int                        `json:"offset"`
+	Limit  int                        `json:"limit"`
+}
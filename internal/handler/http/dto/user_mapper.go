@@ -0,0 +1,38 @@
+package dto
+
+import "github.com/wonjinsin/simple-chatbot/internal/domain"
+
+// ToUserResponse converts domain.User to UserResponse
+func ToUserResponse(user *domain.User) *UserResponse {
+	return &UserResponse{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+	}
+}
+
+// ToConversationListResponse converts a page of domain.ConversationTurns to
+// ConversationListResponse
+func ToConversationListResponse(
+	turns domain.ConversationTurns,
+	total, offset, limit int,
+) *ConversationListResponse {
+	items := make([]ConversationTurnResponse, 0, len(turns))
+	for _, turn := range turns {
+		items = append(items, ConversationTurnResponse{
+			ID:        turn.ID,
+			TrID:      turn.TrID,
+			Question:  turn.Question,
+			Answer:    turn.Answer,
+			CreatedAt: turn.CreatedAt,
+		})
+	}
+
+	return &ConversationListResponse{
+		Items:  items,
+		Total:  total,
+		Offset: offset,
+		Limit:  limit,
+	}
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// ExperimentController handles prompt experiment administration requests
+type ExperimentController struct {
+	svc usecase.ExperimentService
+}
+
+// NewExperimentController creates a new experiment controller
+func NewExperimentController(svc usecase.ExperimentService) *ExperimentController {
+	return &ExperimentController{svc: svc}
+}
+
+// ListExperiments handles listing configured experiments
+func (c *ExperimentController) ListExperiments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "ListExperiments request received")
+
+	experiments, err := c.svc.ListExperiments(ctx)
+	if err != nil {
+		logger.LogError(ctx, "ListExperiments failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "ListExperiments success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToExperimentListResponse(experiments))
+}
+
+// CompareVariants handles comparing experiment variants by feedback score and latency
+func (c *ExperimentController) CompareVariants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+	logger.LogInfo(ctx, "CompareVariants request received")
+
+	stats, err := c.svc.CompareVariants(ctx, name)
+	if err != nil {
+		logger.LogError(ctx, "CompareVariants failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "CompareVariants success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToExperimentResultsResponse(name, stats))
+}
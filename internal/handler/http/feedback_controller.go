@@ -0,0 +1,115 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// FeedbackController handles answer feedback and knowledge quality report requests
+type FeedbackController struct {
+	svc usecase.FeedbackService
+}
+
+// NewFeedbackController creates a new feedback controller
+func NewFeedbackController(svc usecase.FeedbackService) *FeedbackController {
+	return &FeedbackController{svc: svc}
+}
+
+// SubmitFeedback handles rating an answer with a reason and an optional correction
+func (c *FeedbackController) SubmitFeedback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "SubmitFeedback request received")
+
+	// Step 1: Parse the answer ID and request body
+	answerID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || answerID <= 0 {
+		err := errors.New(constants.InvalidParameter, "answer id must be a positive integer", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	var req dto.FeedbackRequest
+	if err := utils.ParseJSONBody(r, &req); err != nil {
+		logger.LogWarn(ctx, "invalid json in request body")
+		utils.WriteStandardJSON(w, r, http.StatusBadRequest, dto.ErrorResult{
+			Msg: "invalid json",
+		}, string(constants.InvalidParameter))
+		return
+	}
+
+	// Step 2: Call service to store the feedback
+	feedback, err := c.svc.SubmitFeedback(ctx, answerID, req.Rating, req.Reason, req.Correction)
+	if err != nil {
+		logger.LogError(ctx, "SubmitFeedback failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	// Step 3: Return the stored feedback
+	for _, warning := range feedback.Warnings {
+		logger.LogError(ctx, "SubmitFeedback completed with warning", warning)
+	}
+
+	logger.LogInfo(ctx, "SubmitFeedback success response received")
+	utils.WriteStandardJSON(w, r, http.StatusCreated, dto.ToFeedbackResponse(feedback))
+}
+
+// RankKnowledgeByNegativeFeedback handles reporting the knowledge entries rated down most often
+func (c *FeedbackController) RankKnowledgeByNegativeFeedback(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "RankKnowledgeByNegativeFeedback request received")
+
+	since, err := dto.ToReportSince(r.URL.Query())
+	if err != nil {
+		logger.LogWarn(ctx, "RankKnowledgeByNegativeFeedback invalid query")
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+	_, limit := utils.ParsePagination(r)
+
+	stats, err := c.svc.RankKnowledgeByNegativeFeedback(ctx, since, limit)
+	if err != nil {
+		logger.LogError(ctx, "RankKnowledgeByNegativeFeedback failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "RankKnowledgeByNegativeFeedback success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToKnowledgeFeedbackReportResponse(stats))
+}
+
+// FindKnowledgeGaps handles reporting clusters of poorly matched or rated-down questions
+func (c *FeedbackController) FindKnowledgeGaps(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "FindKnowledgeGaps request received")
+
+	since, err := dto.ToReportSince(r.URL.Query())
+	if err != nil {
+		logger.LogWarn(ctx, "FindKnowledgeGaps invalid query")
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+	_, limit := utils.ParsePagination(r)
+
+	gaps, err := c.svc.FindKnowledgeGaps(ctx, since, limit)
+	if err != nil {
+		logger.LogError(ctx, "FindKnowledgeGaps failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "FindKnowledgeGaps success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToKnowledgeGapReportResponse(gaps))
+}
@@ -3,18 +3,48 @@ package http
 import (
 	"net/http"
 
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
 	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
 // HealthController handles health check endpoints
-type HealthController struct{}
+type HealthController struct {
+	svc usecase.HealthService
+}
 
 // NewHealthController creates a new health controller
-func NewHealthController() *HealthController {
-	return &HealthController{}
+func NewHealthController(svc usecase.HealthService) *HealthController {
+	return &HealthController{svc: svc}
 }
 
-// Check handles health check requests
+// Check handles liveness requests; it only reports that the process is serving HTTP
 func (c *HealthController) Check(w http.ResponseWriter, r *http.Request) {
 	utils.WriteStandardJSON(w, r, http.StatusOK, nil)
 }
+
+// Ready handles readiness requests, returning 503 when any dependency is down
+func (c *HealthController) Ready(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report := c.svc.CheckReadiness(ctx)
+	for _, component := range report.Components {
+		if component.Status == domain.ComponentStatusDown {
+			logger.LogError(ctx, "readiness check failed: "+component.Name, component.Err)
+		}
+	}
+
+	if !report.Ready() {
+		utils.WriteStandardJSON(
+			w, r,
+			http.StatusServiceUnavailable,
+			dto.ToReadinessResponse(report),
+			string(constants.ServiceUnavailable),
+		)
+		return
+	}
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToReadinessResponse(report))
+}
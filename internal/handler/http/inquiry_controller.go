@@ -4,9 +4,9 @@ import (
 	"net/http"
 
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
 	"github.com/wonjinsin/simple-chatbot/internal/usecase"
-	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 	"github.com/wonjinsin/simple-chatbot/pkg/logger"
 	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
@@ -26,20 +26,21 @@ func (c *InquiryController) EmbedInquiryOrigins(w http.ResponseWriter, r *http.R
 	ctx := r.Context()
 	logger.LogInfo(ctx, "EmbedInquiryOrigins request received")
 
-	err := c.svc.EmbedInquiryOrigins(ctx)
+	report, err := c.svc.EmbedInquiryOrigins(ctx)
 	if err != nil {
 		logger.LogError(ctx, "EmbedInquiryOrigins failed", err)
-		// Extract error code and determine HTTP status
-		code := errors.GetCode(err)
-
-		utils.WriteStandardJSON(w, r, http.StatusInternalServerError, dto.ErrorResult{
-			Msg: err.Error(),
-		}, string(code))
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
 		return
 	}
 
-	logger.LogInfo(ctx, "EmbedInquiryOrigins success response received")
-	utils.WriteStandardJSON(w, r, http.StatusCreated, "success")
+	logger.WithFields(ctx, map[string]interface{}{
+		"rows":            report.Rows,
+		"batches":         report.Batches,
+		"estimatedTokens": report.EstimatedTokens,
+		"durationMs":      report.Duration.Milliseconds(),
+		"rowsPerSecond":   report.RowsPerSecond(),
+	}).Msg("EmbedInquiryOrigins success response received")
+	utils.WriteStandardJSON(w, r, http.StatusCreated, dto.ToEmbedOriginsResponse(report))
 }
 
 // Ask handles inquiry request and returns the refined answer
@@ -58,23 +59,25 @@ func (c *InquiryController) Ask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Step 2: Call service to get refined answer
-	answer, err := c.svc.Ask(ctx, req.Msg)
+	answer, err := c.svc.Ask(ctx, req.Msg, domain.NewSlots(req.Slots))
 	if err != nil {
 		logger.LogError(ctx, "Ask failed", err)
-		// Extract error code and determine HTTP status
-		code := errors.GetCode(err)
-
-		utils.WriteStandardJSON(w, r, http.StatusInternalServerError, dto.ErrorResult{
-			Msg: err.Error(),
-		}, string(code))
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
 		return
 	}
 
 	// Step 3: Return the refined answer
-	response := map[string]string{
-		"answer": answer,
+	for _, warning := range answer.Warnings {
+		logger.LogError(ctx, "Ask completed with warning", warning)
 	}
 
-	logger.LogInfo(ctx, "Ask success response received")
-	utils.WriteStandardJSON(w, r, http.StatusOK, response)
+	logger.WithFields(ctx, map[string]interface{}{
+		"templateName":    answer.Metadata.TemplateName,
+		"templateVersion": answer.Metadata.TemplateVersion,
+		"model":           answer.Metadata.Model,
+		"experiment":      answer.Metadata.Experiment,
+		"variant":         answer.Metadata.Variant,
+		"handoff":         answer.Handoff,
+	}).Msg("Ask success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToAnswerResponse(answer))
 }
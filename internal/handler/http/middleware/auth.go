@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	pkgConstants "github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+type (
+	apiKeyContextKey struct{}
+	userContextKey   struct{}
+)
+
+// Authenticate returns a middleware that authenticates the "Authorization: Bearer" token.
+// API keys (sk_...) are checked against the key store; any other token is verified as an
+// end-user identity token when userSvc is set. The principal and its ID are stored in the
+// request context.
+func Authenticate(
+	apiKeySvc usecase.APIKeyService,
+	userSvc usecase.UserService,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			token, ok := bearerToken(r)
+			if !ok {
+				writeUnauthorized(w, r, errors.New(
+					constants.Unauthorized,
+					"missing bearer token",
+					nil,
+				))
+				return
+			}
+
+			var err error
+			switch {
+			case domain.IsAPIKeyToken(token):
+				var key *domain.APIKey
+				if key, err = apiKeySvc.AuthenticateAPIKey(ctx, token); err == nil {
+					ctx = context.WithValue(ctx, apiKeyContextKey{}, key)
+					ctx = context.WithValue(ctx, pkgConstants.ContextKeyAPIKeyID, key.KeyID)
+				}
+			case userSvc != nil:
+				var user *domain.User
+				if user, err = userSvc.AuthenticateUser(ctx, token); err == nil {
+					ctx = context.WithValue(ctx, userContextKey{}, user)
+					ctx = context.WithValue(ctx, pkgConstants.ContextKeyUserID, user.ID)
+				}
+			default:
+				err = errors.New(constants.Unauthorized, "invalid api key", nil)
+			}
+
+			if err != nil {
+				logger.LogWarn(ctx, "authentication failed: "+err.Error())
+				if errors.HasCode(err, constants.Unauthorized) {
+					writeUnauthorized(w, r, err)
+				} else {
+					utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+				}
+				return
+			}
+
+			logger.LogDebug(ctx, "request authenticated")
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScope returns a middleware that rejects requests whose principal lacks the scope
+func RequireScope(scope domain.APIKeyScope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var allowed bool
+			if key := APIKeyFromContext(r.Context()); key != nil {
+				allowed = key.HasScope(scope)
+			} else if user := UserFromContext(r.Context()); user != nil {
+				allowed = user.HasScope(scope)
+			} else {
+				writeUnauthorized(w, r, errors.New(
+					constants.Unauthorized,
+					"authentication required",
+					nil,
+				))
+				return
+			}
+
+			if !allowed {
+				err := errors.New(
+					constants.Forbidden,
+					"missing the "+string(scope)+" scope",
+					nil,
+				)
+				logger.LogWarn(r.Context(), err.Error())
+				utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// APIKeyFromContext returns the authenticated API key, or nil when the request has none
+func APIKeyFromContext(ctx context.Context) *domain.APIKey {
+	key, _ := ctx.Value(apiKeyContextKey{}).(*domain.APIKey)
+	return key
+}
+
+// UserFromContext returns the authenticated end user, or nil when the request has none
+func UserFromContext(ctx context.Context) *domain.User {
+	user, _ := ctx.Value(userContextKey{}).(*domain.User)
+	return user
+}
+
+// HasScope reports whether the authenticated principal of the request has the scope
+func HasScope(ctx context.Context, scope domain.APIKeyScope) bool {
+	if key := APIKeyFromContext(ctx); key != nil {
+		return key.HasScope(scope)
+	}
+	if user := UserFromContext(ctx); user != nil {
+		return user.HasScope(scope)
+	}
+	return false
+}
+
+// bearerToken extracts the token from the Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get(pkgConstants.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// writeUnauthorized writes a 401 response with a Bearer challenge
+func writeUnauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set(pkgConstants.HeaderWWWAuthenticate, "Bearer")
+	utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/zerolog/log"
 
-	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
 // HTTPLogger logs HTTP requests with TrID
@@ -20,12 +20,7 @@ func HTTPLogger() func(http.Handler) http.Handler {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 
 			// Get TrID from context
-			trID := ""
-			if ctx := r.Context(); ctx != nil {
-				if id, ok := ctx.Value(constants.ContextKeyTrID).(string); ok {
-					trID = id
-				}
-			}
+			trID := utils.GetTrID(r.Context())
 
 			// Process request
 			next.ServeHTTP(ww, r)
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	pkgConstants "github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// RateLimit returns a middleware that limits each client's request rate and in-flight
+// requests under the policy. Clients are keyed by API key, then end user, then remote IP
+// (run after Authenticate and chi's RealIP). Backend failures are logged and let through.
+func RateLimit(
+	rateLimitSvc usecase.RateLimitService,
+	policy *domain.RateLimitPolicy,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			clientKey := rateLimitClientKey(r)
+
+			decision, err := rateLimitSvc.Allow(ctx, policy, clientKey)
+			if err != nil {
+				logger.LogError(ctx, "rate limit check failed, allowing request", err)
+			} else if decision.Limit > 0 {
+				w.Header().Set(pkgConstants.HeaderRateLimitLimit, strconv.Itoa(decision.Limit))
+				w.Header().Set(pkgConstants.HeaderRateLimitRemain, strconv.Itoa(decision.Remaining))
+			}
+
+			if decision != nil && !decision.Allowed {
+				retryAfter := int(math.Ceil(decision.RetryAfter.Seconds()))
+				writeRateLimited(w, r, max(retryAfter, 1), errors.New(
+					constants.RateLimited,
+					"rate limit exceeded",
+					nil,
+				))
+				return
+			}
+
+			release, ok := rateLimitSvc.Acquire(policy, clientKey)
+			if !ok {
+				writeRateLimited(w, r, 1, errors.New(
+					constants.RateLimited,
+					"too many concurrent requests",
+					nil,
+				))
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// rateLimitClientKey identifies the client a request is limited as
+func rateLimitClientKey(r *http.Request) string {
+	if key := APIKeyFromContext(r.Context()); key != nil {
+		return "key:" + key.KeyID
+	}
+	if user := UserFromContext(r.Context()); user != nil {
+		return "user:" + strconv.Itoa(user.ID)
+	}
+
+	// RealIP rewrites RemoteAddr to a bare IP; fall back to host:port parsing otherwise
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// writeRateLimited writes a 429 response with a Retry-After hint in seconds
+func writeRateLimited(w http.ResponseWriter, r *http.Request, retryAfter int, err error) {
+	logger.LogWarn(r.Context(), err.Error())
+	w.Header().Set(pkgConstants.HeaderRetryAfter, strconv.Itoa(retryAfter))
+	utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+}
@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/mock"
+	pkgConstants "github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+func TestRateLimit(t *testing.T) {
+	policy := &domain.RateLimitPolicy{Name: "ask", RequestsPerMinute: 60, Burst: 10, Concurrency: 2}
+
+	tests := []struct {
+		name           string
+		decision       *domain.RateLimitDecision
+		allowErr       error
+		acquireOK      bool
+		wantStatus     int
+		wantCalled     bool
+		wantLimit      string
+		wantRemaining  string
+		wantRetryAfter string
+		wantMsg        string
+	}{
+		{
+			name:          "allowed",
+			decision:      &domain.RateLimitDecision{Allowed: true, Limit: 10, Remaining: 7},
+			acquireOK:     true,
+			wantStatus:    http.StatusOK,
+			wantCalled:    true,
+			wantLimit:     "10",
+			wantRemaining: "7",
+		},
+		{
+			name:       "no rate limit sets no headers",
+			decision:   &domain.RateLimitDecision{Allowed: true},
+			acquireOK:  true,
+			wantStatus: http.StatusOK,
+			wantCalled: true,
+		},
+		{
+			name:           "rate limited rounds Retry-After up",
+			decision:       &domain.RateLimitDecision{Limit: 10, RetryAfter: 1500 * time.Millisecond},
+			wantStatus:     http.StatusTooManyRequests,
+			wantLimit:      "10",
+			wantRemaining:  "0",
+			wantRetryAfter: "2",
+			wantMsg:        "rate limit exceeded",
+		},
+		{
+			name:           "rate limited retries after at least a second",
+			decision:       &domain.RateLimitDecision{Limit: 10, RetryAfter: 100 * time.Millisecond},
+			wantStatus:     http.StatusTooManyRequests,
+			wantLimit:      "10",
+			wantRemaining:  "0",
+			wantRetryAfter: "1",
+			wantMsg:        "rate limit exceeded",
+		},
+		{
+			name:           "too many concurrent requests",
+			decision:       &domain.RateLimitDecision{Allowed: true, Limit: 10, Remaining: 9},
+			acquireOK:      false,
+			wantStatus:     http.StatusTooManyRequests,
+			wantLimit:      "10",
+			wantRemaining:  "9",
+			wantRetryAfter: "1",
+			wantMsg:        "too many concurrent requests",
+		},
+		{
+			name:       "store failure lets the request through",
+			allowErr:   errors.New(constants.InternalError, "connection refused", nil),
+			acquireOK:  true,
+			wantStatus: http.StatusOK,
+			wantCalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mock.NewMockRateLimitService(gomock.NewController(t))
+			svc.EXPECT().Allow(gomock.Any(), policy, "ip:192.0.2.1").Return(tt.decision, tt.allowErr)
+			released := 0
+			if tt.decision == nil || tt.decision.Allowed {
+				var release func()
+				if tt.acquireOK {
+					release = func() { released++ }
+				}
+				svc.EXPECT().Acquire(policy, "ip:192.0.2.1").Return(release, tt.acquireOK)
+			}
+
+			called := false
+			handler := RateLimit(svc, policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if released != 0 {
+					t.Error("concurrency slot released before the handler ran")
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/inquiry/ask", nil)
+			req.RemoteAddr = "192.0.2.1:54321"
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if tt.wantCalled && released != 1 {
+				t.Errorf("concurrency slot released %d times, want once", released)
+			}
+			for header, want := range map[string]string{
+				pkgConstants.HeaderRateLimitLimit:  tt.wantLimit,
+				pkgConstants.HeaderRateLimitRemain: tt.wantRemaining,
+				pkgConstants.HeaderRetryAfter:      tt.wantRetryAfter,
+			} {
+				if got := rec.Header().Get(header); got != want {
+					t.Errorf("%s = %q, want %q", header, got, want)
+				}
+			}
+
+			if tt.wantStatus != http.StatusTooManyRequests {
+				return
+			}
+			var body utils.StandardResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid 429 body %q: %v", rec.Body.String(), err)
+			}
+			result, _ := body.Result.(map[string]any)
+			if body.Code != string(constants.RateLimited) || result["msg"] != tt.wantMsg {
+				t.Errorf("429 body = %s, want code %s and msg %q", rec.Body.String(), constants.RateLimited, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestRateLimitClientKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        func(ctx context.Context) context.Context
+		remoteAddr string
+		want       string
+	}{
+		{
+			name: "api key",
+			ctx: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, userContextKey{}, &domain.User{ID: 7})
+				return context.WithValue(ctx, apiKeyContextKey{}, &domain.APIKey{KeyID: "abc"})
+			},
+			remoteAddr: "192.0.2.1:54321",
+			want:       "key:abc",
+		},
+		{
+			name: "end user",
+			ctx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, userContextKey{}, &domain.User{ID: 7})
+			},
+			remoteAddr: "192.0.2.1:54321",
+			want:       "user:7",
+		},
+		{name: "remote address", remoteAddr: "192.0.2.1:54321", want: "ip:192.0.2.1"},
+		{name: "real ip", remoteAddr: "198.51.100.7", want: "ip:198.51.100.7"},
+		{name: "ipv6", remoteAddr: "[2001:db8::1]:443", want: "ip:2001:db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.ctx != nil {
+				req = req.WithContext(tt.ctx(req.Context()))
+			}
+			if got := rateLimitClientKey(req); got != tt.want {
+				t.Errorf("rateLimitClientKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
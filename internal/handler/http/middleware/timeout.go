@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// timeoutGrace is how long a handler whose context expired may take to write its own response
+// before it is cut off (a variable so tests can shorten it)
+var timeoutGrace = time.Second
+
+// Timeout returns a middleware that cancels the request context after d and, unlike a
+// context-only timeout, also cuts off handlers that ignore the cancellation: shortly after d the
+// client gets a 503 (or a started stream is ended) and the handler's later writes fail with
+// http.ErrHandlerTimeout. Unlike http.TimeoutHandler writes are not buffered, so http.Flusher
+// keeps working for streamed responses. A request cancelled by the client is not answered; the
+// handler is left to notice the cancellation. A panic of the handler is re-raised with its stack.
+func Timeout(d time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			r = r.WithContext(ctx)
+
+			tw := &timeoutWriter{w: w, header: make(http.Header)}
+			done := make(chan struct{})
+			panicked := make(chan *handlerPanic, 1)
+			go func() {
+				defer func() {
+					if p := recover(); p != nil {
+						panicked <- &handlerPanic{value: p, stack: debug.Stack()}
+					}
+				}()
+				next.ServeHTTP(tw, r)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				return
+			case p := <-panicked:
+				p.raise()
+			case <-ctx.Done():
+			}
+
+			// Give a handler that honours the cancellation the chance to answer itself; a request
+			// the client cancelled gets no response, so its handler is only waited for
+			var cutOff <-chan time.Time
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				cutOff = time.After(timeoutGrace)
+			}
+			select {
+			case <-done:
+			case p := <-panicked:
+				p.raise()
+			case <-cutOff:
+				tw.timeOut(r)
+			}
+		})
+	}
+}
+
+// handlerPanic is a panic of the handler goroutine, carried to the request goroutine with the
+// handler's stack, which re-panicking there would otherwise lose
+type handlerPanic struct {
+	value any
+	stack []byte
+}
+
+func (p *handlerPanic) Error() string {
+	return fmt.Sprintf("%v\n\nhandler goroutine stack:\n%s", p.value, p.stack)
+}
+
+// raise re-panics in the calling goroutine. http.ErrAbortHandler is re-raised as is, so the
+// server still aborts the response without logging it.
+func (p *handlerPanic) raise() {
+	if err, ok := p.value.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+		panic(http.ErrAbortHandler)
+	}
+	panic(p)
+}
+
+// timeoutWriter passes writes through to the client until the request times out, after which
+// they fail. Headers are kept apart until they are written, so a timed-out handler cannot
+// touch the response of the timeout.
+type timeoutWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	mu          sync.Mutex
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writeHeaderLocked(code)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	tw.writeHeaderLocked(http.StatusOK)
+	return tw.w.Write(b)
+}
+
+// Flush sends buffered data to the client, keeping streamed responses flowing
+func (tw *timeoutWriter) Flush() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return
+	}
+	tw.writeHeaderLocked(http.StatusOK)
+	if flusher, ok := tw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// writeHeaderLocked copies the handler's headers and writes the status once; tw.mu must be held
+func (tw *timeoutWriter) writeHeaderLocked(code int) {
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	for key, values := range tw.header {
+		tw.w.Header()[key] = values
+	}
+	tw.w.WriteHeader(code)
+}
+
+// timeOut stops the handler's writes and answers with a 503 if it has not started a response
+func (tw *timeoutWriter) timeOut(r *http.Request) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.timedOut = true
+
+	err := errors.New(constants.ServiceUnavailable, "request timed out", nil)
+	logger.LogWarn(r.Context(), err.Error())
+	if !tw.wroteHeader {
+		utils.WriteErrorJSON(tw.w, r, err, dto.ToErrorResult(err))
+	}
+}
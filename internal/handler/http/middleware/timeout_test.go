@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+func TestTimeout(t *testing.T) {
+	grace := timeoutGrace
+	timeoutGrace = 50 * time.Millisecond
+	defer func() { timeoutGrace = grace }()
+
+	const deadline = 20 * time.Millisecond
+	tests := []struct {
+		name string
+		// handler serves the request; release is closed once the middleware returned
+		handler      func(w http.ResponseWriter, r *http.Request, release <-chan struct{})
+		cancelClient bool // Cancel the request as a client going away would
+		wantStatus   int
+		wantBody     string
+		// wantLateWriteErr is the error of a write the handler makes after the middleware returned
+		wantLateWriteErr error
+	}{
+		{
+			name: "handler answers in time",
+			handler: func(w http.ResponseWriter, r *http.Request, _ <-chan struct{}) {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte("done"))
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   "done",
+		},
+		{
+			name: "handler honours the deadline",
+			handler: func(w http.ResponseWriter, r *http.Request, _ <-chan struct{}) {
+				<-r.Context().Done()
+				w.WriteHeader(http.StatusGatewayTimeout)
+			},
+			wantStatus: http.StatusGatewayTimeout,
+		},
+		{
+			name: "handler outlives the deadline",
+			handler: func(w http.ResponseWriter, r *http.Request, release <-chan struct{}) {
+				<-release
+			},
+			wantStatus:       http.StatusServiceUnavailable,
+			wantBody:         `"code":"` + string(constants.ServiceUnavailable) + `"`,
+			wantLateWriteErr: http.ErrHandlerTimeout,
+		},
+		{
+			name: "handler outlives the deadline after starting a stream",
+			handler: func(w http.ResponseWriter, r *http.Request, release <-chan struct{}) {
+				_, _ = w.Write([]byte("data: partial\n\n"))
+				w.(http.Flusher).Flush()
+				<-release
+			},
+			wantStatus:       http.StatusOK,
+			wantBody:         "data: partial\n\n",
+			wantLateWriteErr: http.ErrHandlerTimeout,
+		},
+		{
+			name: "client cancellation is not answered",
+			handler: func(w http.ResponseWriter, r *http.Request, _ <-chan struct{}) {
+				<-r.Context().Done()
+				// Take longer than the grace period to notice
+				time.Sleep(2 * timeoutGrace)
+				w.WriteHeader(http.StatusTeapot)
+			},
+			cancelClient: true,
+			wantStatus:   http.StatusTeapot,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := make(chan struct{})
+			lateWrite := make(chan error, 1)
+			handler := Timeout(deadline)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.handler(w, r, release)
+				if tt.wantLateWriteErr != nil {
+					_, err := w.Write([]byte("late"))
+					lateWrite <- err
+				}
+			}))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelClient {
+				cancel()
+			}
+			req := httptest.NewRequest(http.MethodGet, "/v1/chat/completions", nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			close(release)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %q, want it to contain %q", rec.Body.String(), tt.wantBody)
+			}
+			if tt.wantLateWriteErr != nil {
+				if err := <-lateWrite; !errors.Is(err, tt.wantLateWriteErr) {
+					t.Errorf("late write error = %v, want %v", err, tt.wantLateWriteErr)
+				}
+				if strings.Contains(rec.Body.String(), "late") {
+					t.Errorf("late write reached the client: %q", rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestTimeoutPanic(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     any
+		wantValue func(p any) bool
+	}{
+		{
+			name:  "re-raised with the handler's stack",
+			value: "boom",
+			wantValue: func(p any) bool {
+				hp, ok := p.(*handlerPanic)
+				return ok && hp.value == "boom" &&
+					strings.Contains(hp.Error(), "boom") &&
+					strings.Contains(hp.Error(), "timeout_test.go")
+			},
+		},
+		{
+			name:      "abort handler re-raised as is",
+			value:     http.ErrAbortHandler,
+			wantValue: func(p any) bool { return p == http.ErrAbortHandler },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Timeout(time.Second)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				panic(tt.value)
+			}))
+
+			defer func() {
+				if p := recover(); !tt.wantValue(p) {
+					t.Errorf("panic = %v", p)
+				}
+			}()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+			t.Error("Timeout did not re-panic")
+		})
+	}
+}
@@ -5,19 +5,30 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
-// TrID returns a middleware that generates transaction IDs
+// TrID returns a middleware that assigns each request a transaction ID.
+// A valid inbound X-Request-ID (or X-Trid) from a gateway is kept so logs can be correlated;
+// otherwise a new ID is generated. The TrID is echoed in both response headers.
 // Format: YYYYMMDDHHMMSSmmm (date+time+milliseconds) + 5-digit random number
 // Example: 2025010101010199912345 (23 digits total)
 func TrID() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Generate TrID
-			trID := GenerateTrID()
+			// Reuse the inbound ID when it is safe to log and forward
+			trID := inboundTrID(r)
+			if trID == "" {
+				trID = GenerateTrID()
+			}
+
+			// Echo to the caller
+			w.Header().Set(constants.HeaderRequestID, trID)
+			w.Header().Set(constants.HeaderTrID, trID)
 
 			// Add to context
 			ctx := context.WithValue(r.Context(), constants.ContextKeyTrID, trID)
@@ -28,6 +39,16 @@ func TrID() func(http.Handler) http.Handler {
 	}
 }
 
+// inboundTrID returns the first valid inbound request ID header, or "" when there is none
+func inboundTrID(r *http.Request) string {
+	for _, header := range []string{constants.HeaderRequestID, constants.HeaderTrID} {
+		if id := strings.TrimSpace(r.Header.Get(header)); id != "" && utils.IsValidTrID(id) {
+			return id
+		}
+	}
+	return ""
+}
+
 // GenerateTrID generates a transaction ID
 // Format: YYYYMMDDHHMMSSmmm + 5-digit random number
 func GenerateTrID() string {
@@ -68,11 +89,3 @@ func powerOf10(n int) int64 {
 	}
 	return result
 }
-
-// GetTrID extracts TrID from context
-func GetTrID(ctx context.Context) string {
-	if trID, ok := ctx.Value(constants.ContextKeyTrID).(string); ok {
-		return trID
-	}
-	return ""
-}
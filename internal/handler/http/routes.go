@@ -1,37 +1,142 @@
 package http
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	custommiddleware "github.com/wonjinsin/simple-chatbot/internal/handler/http/middleware"
 	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
 )
 
+// RouterConfig holds the services and settings the router is built from
+type RouterConfig struct {
+	InquirySvc    usecase.InquiryService
+	BasicChatSvc  usecase.BasicChatService
+	ExperimentSvc usecase.ExperimentService
+	AuditSvc      usecase.AuditService
+	FeedbackSvc   usecase.FeedbackService
+	HealthSvc     usecase.HealthService
+	APIKeySvc     usecase.APIKeyService
+	// UserSvc authenticates end-user identity tokens; nil disables end-user authentication
+	UserSvc usecase.UserService
+
+	// ChatCompletionModel is the model ID exposed by the OpenAI-compatible API
+	ChatCompletionModel string
+	// AuthEnabled requires an API key or end-user token with the route's scope on every
+	// non-health endpoint
+	AuthEnabled bool
+	// RateLimitSvc enforces RateLimitPolicies; nil disables rate limiting
+	RateLimitSvc usecase.RateLimitService
+	// RateLimitPolicies holds the limits of each route or group of routes, keyed by policy name
+	RateLimitPolicies map[string]*domain.RateLimitPolicy
+}
+
 // NewRouter creates and configures a new chi router
-func NewRouter(
-	inquirySvc usecase.InquiryService,
-) *chi.Mux {
+func NewRouter(cfg RouterConfig) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Middleware
 	r.Use(custommiddleware.TrID())
+	r.Use(custommiddleware.Tracing())
 	r.Use(custommiddleware.CORS())
 	r.Use(middleware.RealIP)
+	r.Use(custommiddleware.Metrics())
 	r.Use(custommiddleware.HTTPLogger())
 	r.Use(middleware.Recoverer)
+	// Hard request timeout that keeps http.Flusher available for streaming responses
+	r.Use(custommiddleware.Timeout(59 * time.Second))
 
 	// Controllers
-	healthCtrl := NewHealthController()
-	inquiryCtrl := NewInquiryController(inquirySvc)
+	healthCtrl := NewHealthController(cfg.HealthSvc)
+	inquiryCtrl := NewInquiryController(cfg.InquirySvc)
+	basicChatCtrl := NewBasicChatController(cfg.BasicChatSvc)
+	experimentCtrl := NewExperimentController(cfg.ExperimentSvc)
+	auditCtrl := NewAuditController(cfg.AuditSvc)
+	feedbackCtrl := NewFeedbackController(cfg.FeedbackSvc)
+	chatCompletionCtrl := NewChatCompletionController(
+		cfg.InquirySvc,
+		cfg.ChatCompletionModel,
+		cfg.AuthEnabled,
+	)
+	userCtrl := NewUserController(cfg.UserSvc)
+
+	// Scope enforcement (no-op when auth is disabled)
+	scope := func(s domain.APIKeyScope) func(http.Handler) http.Handler {
+		if !cfg.AuthEnabled {
+			return func(next http.Handler) http.Handler { return next }
+		}
+		return custommiddleware.RequireScope(s)
+	}
+
+	// Per-client rate limiting (no-op when disabled or the route has no policy)
+	limit := func(name string) func(http.Handler) http.Handler {
+		policy, ok := cfg.RateLimitPolicies[name]
+		if cfg.RateLimitSvc == nil || !ok {
+			return func(next http.Handler) http.Handler { return next }
+		}
+		return custommiddleware.RateLimit(cfg.RateLimitSvc, policy)
+	}
 
-	// Routes
+	// Public routes
 	r.Get("/healthz", healthCtrl.Check)
+	r.Get("/livez", healthCtrl.Check)
+	r.Get("/readyz", healthCtrl.Ready)
+
+	// Authenticated routes
+	r.Group(func(r chi.Router) {
+		if cfg.AuthEnabled {
+			r.Use(custommiddleware.Authenticate(cfg.APIKeySvc, cfg.UserSvc))
+		}
+
+		// Inquiry routes
+		r.Route("/inquiry", func(r chi.Router) {
+			r.With(scope(domain.APIKeyScopeAsk), limit(domain.RateLimitPolicyAsk)).
+				Post("/ask", inquiryCtrl.Ask)
+			r.With(scope(domain.APIKeyScopeAsk), limit(domain.RateLimitPolicyFeedback)).
+				Post("/answers/{id}/feedback", feedbackCtrl.SubmitFeedback)
+			r.With(scope(domain.APIKeyScopeIngest), limit(domain.RateLimitPolicyIngest)).
+				Post("/embed/origins", inquiryCtrl.EmbedInquiryOrigins)
+		})
+
+		// Basic chat routes (direct LLM chat without retrieval)
+		r.Route("/chat", func(r chi.Router) {
+			r.Use(scope(domain.APIKeyScopeAsk), limit(domain.RateLimitPolicyAsk))
+			r.Post("/basic", basicChatCtrl.AskBasicChat)
+			r.Post("/prompt-template", basicChatCtrl.AskBasicPromptTemplateChat)
+		})
+
+		// End-user routes (require an end-user token)
+		r.Route("/me", func(r chi.Router) {
+			r.Use(limit(domain.RateLimitPolicyMe))
+			r.Get("/", userCtrl.GetMe)
+			r.Get("/conversations", userCtrl.ListMyConversations)
+		})
+
+		// Prometheus metrics (not rate limited, so scrapes are never dropped)
+		r.With(scope(domain.APIKeyScopeAdmin)).
+			Method(http.MethodGet, "/metrics", metrics.Handler())
+
+		// Admin routes
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(scope(domain.APIKeyScopeAdmin), limit(domain.RateLimitPolicyAdmin))
+			r.Get("/experiments", experimentCtrl.ListExperiments)
+			r.Get("/experiments/{name}/results", experimentCtrl.CompareVariants)
+			r.Get("/audit", auditCtrl.SearchAuditRecords)
+			r.Get("/reports/knowledge-feedback", feedbackCtrl.RankKnowledgeByNegativeFeedback)
+			r.Get("/reports/knowledge-gaps", feedbackCtrl.FindKnowledgeGaps)
+		})
 
-	// Inquiry routes
-	r.Route("/inquiry", func(r chi.Router) {
-		r.Post("/ask", inquiryCtrl.Ask)
-		r.Post("/embed/origins", inquiryCtrl.EmbedInquiryOrigins)
+		// OpenAI-compatible routes
+		r.Route("/v1", func(r chi.Router) {
+			r.Use(scope(domain.APIKeyScopeAsk), limit(domain.RateLimitPolicyAsk))
+			r.Get("/models", chatCompletionCtrl.ListModels)
+			r.Post("/chat/completions", chatCompletionCtrl.CreateChatCompletion)
+		})
 	})
 
 	return r
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/handler/http/dto"
+	"github.com/wonjinsin/simple-chatbot/internal/usecase"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/logger"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// UserController handles requests about the authenticated end user
+type UserController struct {
+	svc usecase.UserService
+}
+
+// NewUserController creates a new user controller
+func NewUserController(svc usecase.UserService) *UserController {
+	return &UserController{svc: svc}
+}
+
+// GetMe handles returning the authenticated end user
+func (c *UserController) GetMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "GetMe request received")
+
+	userID, ok := utils.GetUserID(ctx)
+	if !ok {
+		err := errors.New(constants.Unauthorized, "an end-user token is required", nil)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	user, err := c.svc.GetUser(ctx, userID)
+	if err != nil {
+		logger.LogError(ctx, "GetMe failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "GetMe success response received")
+	utils.WriteStandardJSON(w, r, http.StatusOK, dto.ToUserResponse(user))
+}
+
+// ListMyConversations handles listing the authenticated end user's conversation history
+func (c *UserController) ListMyConversations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger.LogInfo(ctx, "ListMyConversations request received")
+
+	userID, ok := utils.GetUserID(ctx)
+	if !ok {
+		err := errors.New(constants.Unauthorized, "an end-user token is required", nil)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	offset, limit := utils.ParsePagination(r)
+	turns, total, err := c.svc.ListConversationTurns(ctx, userID, offset, limit)
+	if err != nil {
+		logger.LogError(ctx, "ListMyConversations failed", err)
+		utils.WriteErrorJSON(w, r, err, dto.ToErrorResult(err))
+		return
+	}
+
+	logger.LogInfo(ctx, "ListMyConversations success response received")
+	utils.WriteStandardJSON(
+		w,
+		r,
+		http.StatusOK,
+		dto.ToConversationListResponse(turns, total, offset, limit),
+	)
+}
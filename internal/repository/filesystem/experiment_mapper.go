@@ -0,0 +1,46 @@
+package filesystem
+
+import (
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// experimentsFile is the on-disk YAML representation of the experiment configuration
+type experimentsFile struct {
+	Experiments []experimentFile `yaml:"experiments"`
+}
+
+// experimentFile is the on-disk YAML representation of an experiment
+type experimentFile struct {
+	Name     string        `yaml:"name"`
+	Enabled  bool          `yaml:"enabled"`
+	Variants []variantFile `yaml:"variants"`
+}
+
+// variantFile is the on-disk YAML representation of an experiment variant
+type variantFile struct {
+	Name            string `yaml:"name"`
+	Weight          int    `yaml:"weight"`
+	SimilarityLimit int    `yaml:"similarityLimit"`
+	TemplateName    string `yaml:"templateName"`
+	TemplateVersion int    `yaml:"templateVersion"`
+	Model           string `yaml:"model"`
+}
+
+// toDomainExperiment converts an experiment file entry to a validated domain.Experiment
+func toDomainExperiment(f *experimentFile) (*domain.Experiment, error) {
+	variants := make(domain.ExperimentVariants, 0, len(f.Variants))
+	for _, v := range f.Variants {
+		variants = append(variants, &domain.ExperimentVariant{
+			Name:   v.Name,
+			Weight: v.Weight,
+			Overrides: domain.VariantOverrides{
+				SimilarityLimit: v.SimilarityLimit,
+				TemplateName:    v.TemplateName,
+				TemplateVersion: v.TemplateVersion,
+				Model:           v.Model,
+			},
+		})
+	}
+
+	return domain.NewExperiment(f.Name, f.Enabled, variants)
+}
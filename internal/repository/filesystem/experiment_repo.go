@@ -0,0 +1,86 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type experimentRepo struct {
+	experiments domain.Experiments
+	active      *domain.Experiment
+}
+
+// NewExperimentRepository loads and validates the experiment configuration file.
+// An empty path disables experiments. At most one experiment may be enabled at a time.
+func NewExperimentRepository(path string) (repository.ExperimentRepository, error) {
+	if path == "" {
+		return &experimentRepo{}, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to read experiments file %s", path))
+	}
+
+	var f experimentsFile
+	if err := yaml.Unmarshal(content, &f); err != nil {
+		return nil, errors.Wrap(
+			err,
+			fmt.Sprintf("failed to parse experiments file %s", path),
+			constants.InvalidParameter,
+		)
+	}
+
+	repo := &experimentRepo{}
+	seen := make(map[string]bool, len(f.Experiments))
+	for i := range f.Experiments {
+		experiment, err := toDomainExperiment(&f.Experiments[i])
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid experiments file %s", path))
+		}
+
+		if seen[experiment.Name] {
+			return nil, errors.New(
+				constants.ConstraintError,
+				fmt.Sprintf("experiment %q is declared twice", experiment.Name),
+				nil,
+			)
+		}
+		seen[experiment.Name] = true
+
+		if experiment.Enabled {
+			if repo.active != nil {
+				return nil, errors.New(
+					constants.ConstraintError,
+					fmt.Sprintf(
+						"only one experiment can be enabled, found %q and %q",
+						repo.active.Name, experiment.Name,
+					),
+					nil,
+				)
+			}
+			repo.active = experiment
+		}
+		repo.experiments = append(repo.experiments, experiment)
+	}
+
+	return repo, nil
+}
+
+// GetActiveExperiment returns the enabled experiment, or nil when none is running
+func (r *experimentRepo) GetActiveExperiment(_ context.Context) (*domain.Experiment, error) {
+	return r.active, nil
+}
+
+// ListExperiments returns every configured experiment
+func (r *experimentRepo) ListExperiments(_ context.Context) (domain.Experiments, error) {
+	return r.experiments, nil
+}
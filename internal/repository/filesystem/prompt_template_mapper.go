@@ -0,0 +1,33 @@
+package filesystem
+
+import (
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// promptTemplateFile is the on-disk YAML representation of a prompt template
+type promptTemplateFile struct {
+	Name        string              `yaml:"name"`
+	Version     int                 `yaml:"version"`
+	Description string              `yaml:"description"`
+	Variables   []string            `yaml:"variables"`
+	Messages    []promptMessageFile `yaml:"messages"`
+}
+
+// promptMessageFile is the on-disk YAML representation of a prompt message
+type promptMessageFile struct {
+	Role     string `yaml:"role"`
+	Template string `yaml:"template"`
+}
+
+// toDomainPromptTemplate converts a prompt template file to a validated domain.PromptTemplate
+func toDomainPromptTemplate(f *promptTemplateFile) (*domain.PromptTemplate, error) {
+	messages := make(domain.PromptMessages, 0, len(f.Messages))
+	for _, msg := range f.Messages {
+		messages = append(messages, &domain.PromptMessage{
+			Role:     domain.ChatRole(msg.Role),
+			Template: msg.Template,
+		})
+	}
+
+	return domain.NewPromptTemplate(f.Name, f.Version, f.Description, f.Variables, messages)
+}
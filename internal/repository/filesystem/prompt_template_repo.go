@@ -0,0 +1,140 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type promptTemplateRepo struct {
+	// templates holds every version of each template, sorted by ascending version
+	templates map[string]domain.PromptTemplates
+}
+
+// NewPromptTemplateRepository loads and validates every *.yaml prompt template in dir.
+// Loading fails if any template is invalid or a name/version pair is declared twice.
+func NewPromptTemplateRepository(dir string) (repository.PromptTemplateRepository, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list prompt template files")
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New(
+			constants.InternalError,
+			fmt.Sprintf("no prompt templates found in %s", dir),
+			nil,
+		)
+	}
+
+	templates := make(map[string]domain.PromptTemplates)
+	for _, path := range paths {
+		tmpl, err := loadPromptTemplate(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, existing := range templates[tmpl.Name] {
+			if existing.Version == tmpl.Version {
+				return nil, errors.New(
+					constants.ConstraintError,
+					fmt.Sprintf(
+						"prompt template %q version %d is declared twice (%s)",
+						tmpl.Name, tmpl.Version, path,
+					),
+					nil,
+				)
+			}
+		}
+		templates[tmpl.Name] = append(templates[tmpl.Name], tmpl)
+	}
+
+	for _, versions := range templates {
+		slices.SortFunc(versions, func(a, b *domain.PromptTemplate) int {
+			return a.Version - b.Version
+		})
+	}
+
+	return &promptTemplateRepo{templates: templates}, nil
+}
+
+// GetPromptTemplate returns the named prompt template at the given version (0 = latest)
+func (r *promptTemplateRepo) GetPromptTemplate(
+	_ context.Context,
+	name string,
+	version int,
+) (*domain.PromptTemplate, error) {
+	versions, ok := r.templates[name]
+	if !ok || len(versions) == 0 {
+		return nil, errors.New(
+			constants.NotFound,
+			fmt.Sprintf("prompt template %q not found", name),
+			nil,
+		)
+	}
+
+	if version <= 0 {
+		return versions[len(versions)-1], nil
+	}
+
+	for _, tmpl := range versions {
+		if tmpl.Version == version {
+			return tmpl, nil
+		}
+	}
+
+	return nil, errors.New(
+		constants.NotFound,
+		fmt.Sprintf("prompt template %q version %d not found", name, version),
+		nil,
+	)
+}
+
+// ListPromptTemplates returns every loaded prompt template version
+func (r *promptTemplateRepo) ListPromptTemplates(
+	_ context.Context,
+) (domain.PromptTemplates, error) {
+	names := make([]string, 0, len(r.templates))
+	for name := range r.templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var result domain.PromptTemplates
+	for _, name := range names {
+		result = append(result, r.templates[name]...)
+	}
+	return result, nil
+}
+
+// loadPromptTemplate reads and validates a single prompt template file
+func loadPromptTemplate(path string) (*domain.PromptTemplate, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to read prompt template %s", path))
+	}
+
+	var f promptTemplateFile
+	if err := yaml.Unmarshal(content, &f); err != nil {
+		return nil, errors.Wrap(
+			err,
+			fmt.Sprintf("failed to parse prompt template %s", path),
+			constants.InvalidParameter,
+		)
+	}
+
+	tmpl, err := toDomainPromptTemplate(&f)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid prompt template %s", path))
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,87 @@
+package langchain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type basicChatRepo struct {
+	llm       model.BaseChatModel
+	model     string
+	templates repository.PromptTemplateRepository
+}
+
+// NewBasicChatRepository creates a new basic chat repository
+func NewBasicChatRepository(
+	llm model.BaseChatModel,
+	model string,
+	templates repository.PromptTemplateRepository,
+) repository.BasicChatRepository {
+	return &basicChatRepo{
+		llm:       llm,
+		model:     model,
+		templates: templates,
+	}
+}
+
+// Chat sends the message directly to the LLM and returns its reply
+func (r *basicChatRepo) Chat(ctx context.Context, msg string) (*domain.Answer, error) {
+	result, err := r.llm.Generate(ctx, []*schema.Message{schema.UserMessage(msg)})
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to generate chat reply")
+	}
+	return &domain.Answer{
+		Text:     result.Content,
+		Metadata: toAnswerMetadata(nil, r.model),
+	}, nil
+}
+
+// ChatWithTemplate renders the named prompt template with variables and returns the LLM reply
+func (r *basicChatRepo) ChatWithTemplate(
+	ctx context.Context,
+	templateName string,
+	variables map[string]any,
+) (*domain.Answer, error) {
+	tmpl, err := r.templates.GetPromptTemplate(ctx, templateName, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get prompt template")
+	}
+
+	if missing := tmpl.MissingVariables(variables); len(missing) > 0 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf(
+				"prompt template %q requires variables: %s",
+				templateName, strings.Join(missing, ", "),
+			),
+			nil,
+		)
+	}
+
+	chain, err := compose.NewChain[map[string]any, *schema.Message]().
+		AppendChatTemplate(prompt.FromMessages(schema.GoTemplate, toSchemaMessagesTemplates(tmpl)...)).
+		AppendChatModel(r.llm).
+		Compile(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile chain")
+	}
+
+	result, err := chain.Invoke(ctx, variables)
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to invoke chain")
+	}
+	return &domain.Answer{
+		Text:     result.Content,
+		Metadata: toAnswerMetadata(tmpl, r.model),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package langchain
+
+import (
+	"github.com/cloudwego/eino/schema"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// toSchemaMessages converts domain.ChatMessages to eino schema messages
+func toSchemaMessages(messages domain.ChatMessages) []*schema.Message {
+	result := make([]*schema.Message, 0, len(messages))
+	for _, msg := range messages {
+		switch msg.Role {
+		case domain.ChatRoleSystem:
+			result = append(result, schema.SystemMessage(msg.Content))
+		case domain.ChatRoleAssistant:
+			result = append(result, schema.AssistantMessage(msg.Content, nil))
+		default:
+			result = append(result, schema.UserMessage(msg.Content))
+		}
+	}
+	return result
+}
+
+// toDomainChatMessages converts eino schema messages to domain.ChatMessages
+func toDomainChatMessages(messages []*schema.Message) domain.ChatMessages {
+	result := make(domain.ChatMessages, 0, len(messages))
+	for _, msg := range messages {
+		role := domain.ChatRoleUser
+		switch msg.Role {
+		case schema.System:
+			role = domain.ChatRoleSystem
+		case schema.Assistant:
+			role = domain.ChatRoleAssistant
+		}
+		result = append(result, &domain.ChatMessage{Role: role, Content: msg.Content})
+	}
+	return result
+}
+
+// toSchemaMessagesTemplates converts a domain.PromptTemplate to eino Go-template messages
+func toSchemaMessagesTemplates(tmpl *domain.PromptTemplate) []schema.MessagesTemplate {
+	result := make([]schema.MessagesTemplate, 0, len(tmpl.Messages))
+	for _, msg := range tmpl.Messages {
+		switch msg.Role {
+		case domain.ChatRoleSystem:
+			result = append(result, schema.SystemMessage(msg.Template))
+		case domain.ChatRoleAssistant:
+			result = append(result, schema.AssistantMessage(msg.Template, nil))
+		default:
+			result = append(result, schema.UserMessage(msg.Template))
+		}
+	}
+	return result
+}
+
+// toAnswerMetadata builds the metadata recording which template and model produced an answer
+func toAnswerMetadata(tmpl *domain.PromptTemplate, model string) domain.AnswerMetadata {
+	metadata := domain.AnswerMetadata{Model: model}
+	if tmpl != nil {
+		metadata.TemplateName = tmpl.Name
+		metadata.TemplateVersion = tmpl.Version
+	}
+	return metadata
+}
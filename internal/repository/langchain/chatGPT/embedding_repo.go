@@ -3,19 +3,20 @@ package langchain
 import (
 	"context"
 
-	"github.com/cloudwego/eino-ext/components/embedding/openai"
+	"github.com/cloudwego/eino/components/embedding"
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
 	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	"github.com/wonjinsin/simple-chatbot/internal/repository"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
 )
 
 type embeddingRepo struct {
-	embedder *openai.Embedder
+	embedder embedding.Embedder
 }
 
 // NewEmbeddingRepository creates a new embedding repository
-func NewEmbeddingRepository(embedder *openai.Embedder) repository.EmbeddingRepository {
+func NewEmbeddingRepository(embedder embedding.Embedder) repository.EmbeddingRepository {
 	return &embeddingRepo{embedder: embedder}
 }
 
@@ -23,10 +24,13 @@ func NewEmbeddingRepository(embedder *openai.Embedder) repository.EmbeddingRepos
 func (r *embeddingRepo) EmbedString(
 	ctx context.Context,
 	text string,
-) (domain.Embedding, error) {
+) (_ domain.Embedding, err error) {
+	ctx, span := tracing.Start(ctx, "EmbedString")
+	defer func() { tracing.End(span, err) }()
+
 	embeddings, err := r.embedder.EmbedStrings(ctx, []string{text})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to embed string")
+		return nil, wrapUpstreamError(err, "failed to embed string")
 	}
 	if len(embeddings) == 0 {
 		return nil, errors.New(
@@ -45,7 +49,7 @@ func (r *embeddingRepo) EmbedStrings(
 ) (domain.Embeddings, error) {
 	embeddings, err := r.embedder.EmbedStrings(ctx, texts)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to embed strings")
+		return nil, wrapUpstreamError(err, "failed to embed strings")
 	}
 	return domain.NewEmbeddings(embeddings), nil
 }
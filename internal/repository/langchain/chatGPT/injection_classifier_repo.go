@@ -0,0 +1,67 @@
+package langchain
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/langchain/shared"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+const injectionClassifierTemplateName = "injection_classifier"
+
+type injectionClassifierRepo struct {
+	llm       model.BaseChatModel
+	templates repository.PromptTemplateRepository
+}
+
+// NewInjectionClassifierRepository creates a new prompt-injection classifier backed by llm
+func NewInjectionClassifierRepository(
+	llm model.BaseChatModel,
+	templates repository.PromptTemplateRepository,
+) repository.InjectionClassifierRepository {
+	return &injectionClassifierRepo{
+		llm:       llm,
+		templates: templates,
+	}
+}
+
+// ClassifyInjection reports whether text tries to override the assistant's instructions
+func (r *injectionClassifierRepo) ClassifyInjection(
+	ctx context.Context,
+	text string,
+) (*domain.InjectionClassification, error) {
+	tmpl, err := r.templates.GetPromptTemplate(ctx, injectionClassifierTemplateName, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get prompt template")
+	}
+
+	type JSONResponse struct {
+		Injection bool   `json:"injection"`
+		Reason    string `json:"reason"`
+	}
+
+	chain, err := compose.NewChain[map[string]any, *JSONResponse]().
+		AppendChatTemplate(prompt.FromMessages(schema.GoTemplate, toSchemaMessagesTemplates(tmpl)...)).
+		AppendChatModel(r.llm).
+		AppendLambda(shared.NewJSONParserLambda[*JSONResponse]()).
+		Compile(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile chain")
+	}
+
+	result, err := chain.Invoke(ctx, map[string]any{"text": text})
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to invoke chain")
+	}
+
+	return &domain.InjectionClassification{
+		Injection: result.Injection,
+		Reason:    result.Reason,
+	}, nil
+}
@@ -2,48 +2,66 @@ package langchain
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
 
-	"github.com/cloudwego/eino-ext/components/model/openai"
+	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/components/prompt"
 	"github.com/cloudwego/eino/compose"
 	"github.com/cloudwego/eino/schema"
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/langchain/shared"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 )
 
+const (
+	answerRefineTemplateName = "inquiry_answer_refine"
+	conversationTemplateName = "inquiry_conversation"
+)
+
 type AnswerRefineRepo struct {
-	llm *openai.ChatModel
+	llms         map[string]model.BaseChatModel
+	defaultModel string
+	templates    repository.PromptTemplateRepository
 }
 
-// NewAnswerRefineRepo creates a new answer refine repository
-func NewAnswerRefineRepo(llm *openai.ChatModel) *AnswerRefineRepo {
-	return &AnswerRefineRepo{llm: llm}
+// NewAnswerRefineRepo creates a new answer refine repository.
+// llms holds every chat model that can be selected by name; defaultModel must be one of them.
+func NewAnswerRefineRepo(
+	llms map[string]model.BaseChatModel,
+	defaultModel string,
+	templates repository.PromptTemplateRepository,
+) *AnswerRefineRepo {
+	return &AnswerRefineRepo{
+		llms:         llms,
+		defaultModel: defaultModel,
+		templates:    templates,
+	}
 }
 
 func (r *AnswerRefineRepo) RefineAnswer(
 	ctx context.Context,
 	contextStr string,
-) (string, error) {
-	// Create a prompt template
-	template := prompt.FromMessages(
-		schema.GoTemplate,
-		schema.SystemMessage(
-			"You are a JSON-only response assistant. You MUST respond with ONLY valid JSON. The response must be a single JSON object with an 'answer' field containing a plain string value. Do NOT use markdown code blocks, backticks, or any formatting. Do NOT nest JSON objects. Return ONLY the raw JSON object.",
-		),
-		schema.SystemMessage(
-			`You are a helpful assistant that answers questions based on the provided context.
-			Use the context information to provide accurate and relevant answers.
-			If the context doesn't contain enough information to answer the question, say so honestly.`,
-		),
-		schema.UserMessage(
-			`Context information:
-			{{.context}}
-
-			Please answer the question based on the context provided above.
-			Return your response as a JSON object with this exact structure: {"answer": "your answer here"}.
-			The answer field must contain a plain string, not nested JSON.`,
-		),
-	)
+	opts domain.GenerationOptions,
+) (*domain.Answer, error) {
+	// Look up the prompt template and chat model
+	templateName := answerRefineTemplateName
+	if opts.TemplateName != "" {
+		templateName = opts.TemplateName
+	}
+	tmpl, err := r.templates.GetPromptTemplate(ctx, templateName, opts.TemplateVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get prompt template")
+	}
+
+	llm, model, err := r.chatModel(opts.Model)
+	if err != nil {
+		return nil, err
+	}
+	template := prompt.FromMessages(schema.GoTemplate, toSchemaMessagesTemplates(tmpl)...)
 
 	// Render the template with data
 	variables := map[string]any{
@@ -58,19 +76,176 @@ func (r *AnswerRefineRepo) RefineAnswer(
 	// JSON parser that cleans markdown before parsing
 	jsonParserLambda := shared.NewJSONParserLambda[*JSONResponse]()
 
+	// Keep the rendered prompt and the raw model output for debugging and the audit trail
+	var rendered domain.ChatMessages
+	var rawOutput string
+	captureLambda := compose.InvokableLambda(
+		func(_ context.Context, msg *schema.Message) (*schema.Message, error) {
+			if msg != nil {
+				rawOutput = msg.Content
+			}
+			return msg, nil
+		},
+	)
+
 	chain, err := compose.NewChain[map[string]any, *JSONResponse]().
 		AppendChatTemplate(template).
-		AppendChatModel(r.llm).
+		AppendLambda(capturePromptLambda(&rendered)).
+		AppendChatModel(llm).
+		AppendLambda(captureLambda).
 		AppendLambda(jsonParserLambda).
 		Compile(ctx)
 
 	if err != nil {
-		return "", errors.Wrap(err, "failed to compile chain")
+		return nil, errors.Wrap(err, "failed to compile chain")
 	}
 
 	result, err := chain.Invoke(ctx, variables)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to invoke chain")
+		return nil, wrapUpstreamError(err, "failed to invoke chain")
+	}
+	return &domain.Answer{
+		Text:      result.Answer,
+		Metadata:  toAnswerMetadata(tmpl, model),
+		RawOutput: rawOutput,
+		Prompt:    rendered,
+	}, nil
+}
+
+// AnswerConversation answers the question using the retrieved context and chat history
+func (r *AnswerRefineRepo) AnswerConversation(
+	ctx context.Context,
+	history domain.ChatMessages,
+	question, contextStr string,
+) (*domain.Answer, error) {
+	var rendered domain.ChatMessages
+	chain, tmpl, err := r.conversationChain(ctx, &rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := chain.Invoke(ctx, conversationVariables(history, question, contextStr))
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to invoke chain")
+	}
+	return &domain.Answer{
+		Text:     result.Content,
+		Metadata: toAnswerMetadata(tmpl, r.defaultModel),
+		Prompt:   rendered,
+	}, nil
+}
+
+// StreamConversation answers like AnswerConversation but emits the answer chunk by chunk.
+// The returned answer has no text; it holds the metadata and rendered prompt.
+func (r *AnswerRefineRepo) StreamConversation(
+	ctx context.Context,
+	history domain.ChatMessages,
+	question, contextStr string,
+	onChunk func(chunk string) error,
+) (*domain.Answer, error) {
+	var rendered domain.ChatMessages
+	chain, tmpl, err := r.conversationChain(ctx, &rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := chain.Stream(ctx, conversationVariables(history, question, contextStr))
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to stream chain")
+	}
+	defer stream.Close()
+
+	for {
+		chunk, err := stream.Recv()
+		if stderrors.Is(err, io.EOF) {
+			return &domain.Answer{
+				Metadata: toAnswerMetadata(tmpl, r.defaultModel),
+				Prompt:   rendered,
+			}, nil
+		}
+		if err != nil {
+			return nil, wrapUpstreamError(err, "failed to receive stream chunk")
+		}
+		if chunk == nil || chunk.Content == "" {
+			continue
+		}
+		if err := onChunk(chunk.Content); err != nil {
+			return nil, errors.Wrap(err, "failed to emit stream chunk")
+		}
+	}
+}
+
+// conversationChain compiles a plain-text chain that answers with chat history and context.
+// The history is inserted right before the last message of the conversation template, and the
+// rendered prompt is stored in rendered when the chain runs.
+func (r *AnswerRefineRepo) conversationChain(
+	ctx context.Context,
+	rendered *domain.ChatMessages,
+) (compose.Runnable[map[string]any, *schema.Message], *domain.PromptTemplate, error) {
+	tmpl, err := r.templates.GetPromptTemplate(ctx, conversationTemplateName, 0)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get prompt template")
+	}
+
+	messages := toSchemaMessagesTemplates(tmpl)
+	last := len(messages) - 1
+	messages = append(
+		messages[:last:last],
+		schema.MessagesPlaceholder("history", true),
+		messages[last],
+	)
+
+	llm, _, err := r.chatModel("")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chain, err := compose.NewChain[map[string]any, *schema.Message]().
+		AppendChatTemplate(prompt.FromMessages(schema.GoTemplate, messages...)).
+		AppendLambda(capturePromptLambda(rendered)).
+		AppendChatModel(llm).
+		Compile(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to compile chain")
+	}
+	return chain, tmpl, nil
+}
+
+// capturePromptLambda passes the rendered prompt through unchanged and stores it in rendered
+func capturePromptLambda(rendered *domain.ChatMessages) *compose.Lambda {
+	return compose.InvokableLambda(
+		func(_ context.Context, messages []*schema.Message) ([]*schema.Message, error) {
+			*rendered = toDomainChatMessages(messages)
+			return messages, nil
+		},
+	)
+}
+
+// chatModel returns the named chat model, or the default model when name is empty
+func (r *AnswerRefineRepo) chatModel(name string) (model.BaseChatModel, string, error) {
+	if name == "" {
+		name = r.defaultModel
+	}
+
+	llm, ok := r.llms[name]
+	if !ok {
+		return nil, "", errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("chat model %q is not configured", name),
+			nil,
+		)
+	}
+	return llm, name, nil
+}
+
+// conversationVariables builds the template variables for the conversation chain
+func conversationVariables(
+	history domain.ChatMessages,
+	question, contextStr string,
+) map[string]any {
+	return map[string]any{
+		"context":  contextStr,
+		"history":  toSchemaMessages(history),
+		"question": question,
 	}
-	return result.Answer, nil
 }
@@ -0,0 +1,81 @@
+package langchain
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/prompt"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/langchain/shared"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+const answerJudgeTemplateName = "answer_judge"
+
+type answerJudgeRepo struct {
+	llm       model.BaseChatModel
+	templates repository.PromptTemplateRepository
+}
+
+// NewAnswerJudgeRepository creates a new answer judge repository that scores answers with llm
+func NewAnswerJudgeRepository(
+	llm model.BaseChatModel,
+	templates repository.PromptTemplateRepository,
+) repository.AnswerJudgeRepository {
+	return &answerJudgeRepo{
+		llm:       llm,
+		templates: templates,
+	}
+}
+
+// JudgeAnswer scores the answer to the question against the retrieved context it was generated
+// from and a reference answer
+func (r *answerJudgeRepo) JudgeAnswer(
+	ctx context.Context,
+	question, contextStr, reference, answer string,
+) (*domain.AnswerJudgement, error) {
+	tmpl, err := r.templates.GetPromptTemplate(ctx, answerJudgeTemplateName, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get prompt template")
+	}
+
+	type JSONResponse struct {
+		Faithfulness int    `json:"faithfulness"`
+		Relevance    int    `json:"relevance"`
+		Completeness int    `json:"completeness"`
+		Reasoning    string `json:"reasoning"`
+	}
+
+	chain, err := compose.NewChain[map[string]any, *JSONResponse]().
+		AppendChatTemplate(prompt.FromMessages(schema.GoTemplate, toSchemaMessagesTemplates(tmpl)...)).
+		AppendChatModel(r.llm).
+		AppendLambda(shared.NewJSONParserLambda[*JSONResponse]()).
+		Compile(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compile chain")
+	}
+
+	result, err := chain.Invoke(ctx, map[string]any{
+		"question":  question,
+		"context":   contextStr,
+		"reference": reference,
+		"answer":    answer,
+	})
+	if err != nil {
+		return nil, wrapUpstreamError(err, "failed to invoke chain")
+	}
+
+	judgement := &domain.AnswerJudgement{
+		Faithfulness: result.Faithfulness,
+		Relevance:    result.Relevance,
+		Completeness: result.Completeness,
+		Reasoning:    result.Reasoning,
+	}
+	if err := judgement.Validate(); err != nil {
+		return nil, err
+	}
+	return judgement, nil
+}
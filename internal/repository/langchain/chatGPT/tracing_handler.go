@@ -0,0 +1,91 @@
+package langchain
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
+)
+
+// NewTracingHandler returns an eino callback handler that records a span for every chain
+// and component run (prompt render, model call, output parsing). Register it once at boot
+// with callbacks.AppendGlobalHandlers.
+func NewTracingHandler() callbacks.Handler {
+	return callbacks.NewHandlerBuilder().
+		OnStartFn(func(
+			ctx context.Context,
+			info *callbacks.RunInfo,
+			input callbacks.CallbackInput,
+		) context.Context {
+			ctx, span := startComponentSpan(ctx, info)
+			if in := model.ConvCallbackInput(input); in != nil && in.Config != nil {
+				span.SetAttributes(attribute.String("gen_ai.request.model", in.Config.Model))
+			}
+			return ctx
+		}).
+		OnEndFn(func(
+			ctx context.Context,
+			_ *callbacks.RunInfo,
+			output callbacks.CallbackOutput,
+		) context.Context {
+			span := trace.SpanFromContext(ctx)
+			if out := model.ConvCallbackOutput(output); out != nil && out.TokenUsage != nil {
+				span.SetAttributes(
+					attribute.Int("gen_ai.usage.input_tokens", out.TokenUsage.PromptTokens),
+					attribute.Int("gen_ai.usage.output_tokens", out.TokenUsage.CompletionTokens),
+				)
+			}
+			span.End()
+			return ctx
+		}).
+		OnErrorFn(func(ctx context.Context, _ *callbacks.RunInfo, err error) context.Context {
+			tracing.End(trace.SpanFromContext(ctx), err)
+			return ctx
+		}).
+		OnStartWithStreamInputFn(func(
+			ctx context.Context,
+			info *callbacks.RunInfo,
+			input *schema.StreamReader[callbacks.CallbackInput],
+		) context.Context {
+			// The handler gets its own copy of the stream and must close it
+			input.Close()
+			ctx, _ = startComponentSpan(ctx, info)
+			return ctx
+		}).
+		OnEndWithStreamOutputFn(func(
+			ctx context.Context,
+			_ *callbacks.RunInfo,
+			output *schema.StreamReader[callbacks.CallbackOutput],
+		) context.Context {
+			// End the span once the stream is fully consumed
+			span := trace.SpanFromContext(ctx)
+			go func() {
+				defer output.Close()
+				for {
+					if _, err := output.Recv(); err != nil {
+						break
+					}
+				}
+				span.End()
+			}()
+			return ctx
+		}).
+		Build()
+}
+
+// startComponentSpan starts a span named after the eino component (e.g. "eino ChatModel")
+func startComponentSpan(ctx context.Context, info *callbacks.RunInfo) (context.Context, trace.Span) {
+	if info == nil {
+		return tracing.Start(ctx, "eino")
+	}
+	return tracing.Start(ctx, "eino "+string(info.Component),
+		attribute.String("eino.component", string(info.Component)),
+		attribute.String("eino.type", info.Type),
+		attribute.String("eino.name", info.Name),
+	)
+}
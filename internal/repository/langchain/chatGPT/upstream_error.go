@@ -0,0 +1,20 @@
+package langchain
+
+import (
+	"context"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// wrapUpstreamError wraps an error returned by the LLM or embedding provider, reporting
+// timeouts as UpstreamTimeout and any other provider failure as UpstreamError
+func wrapUpstreamError(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errors.Wrap(err, message, constants.UpstreamTimeout)
+	}
+	return errors.Wrap(err, message, constants.UpstreamError)
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type auditRepo struct {
+	mu      sync.RWMutex
+	records domain.AuditRecords
+	nextID  int
+}
+
+// NewAuditRepository creates an in-process audit trail. It suits runs whose audit trail is
+// thrown away, such as offline evaluation runs.
+func NewAuditRepository() repository.AuditRepository {
+	return &auditRepo{nextID: 1}
+}
+
+// SaveAuditRecord stores the audit record of a single question and sets its ID
+func (r *auditRepo) SaveAuditRecord(_ context.Context, record *domain.AuditRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *record
+	saved.ID = r.nextID
+	record.ID = r.nextID
+	r.nextID++
+	r.records = append(r.records, &saved)
+	return nil
+}
+
+// GetAuditRecord returns the audit record with the given ID
+func (r *auditRepo) GetAuditRecord(_ context.Context, id int) (*domain.AuditRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, record := range r.records {
+		if record.ID == id {
+			found := *record
+			return &found, nil
+		}
+	}
+	return nil, errors.New(constants.NotFound, fmt.Sprintf("answer %d not found", id), nil)
+}
+
+// ListAuditRecords returns a page of records matching the filter, newest first, and the total count
+func (r *auditRepo) ListAuditRecords(
+	_ context.Context,
+	filter *domain.AuditFilter,
+) (domain.AuditRecords, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched domain.AuditRecords
+	for _, record := range slices.Backward(r.records) {
+		switch {
+		case !filter.From.IsZero() && record.CreatedAt.Before(filter.From),
+			!filter.To.IsZero() && !record.CreatedAt.Before(filter.To),
+			filter.Intent != "" && record.Intent != filter.Intent,
+			filter.ErrorCode != "" && record.ErrorCode != filter.ErrorCode:
+			continue
+		}
+		found := *record
+		matched = append(matched, &found)
+	}
+
+	start := min(filter.Offset, len(matched))
+	end := len(matched)
+	if filter.Limit > 0 {
+		end = min(start+filter.Limit, end)
+	}
+	return matched[start:end], len(matched), nil
+}
+
+// DeleteAuditRecordsBefore deletes records created before cutoff and returns how many were deleted
+func (r *auditRepo) DeleteAuditRecordsBefore(_ context.Context, cutoff time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	before := len(r.records)
+	r.records = slices.DeleteFunc(r.records, func(record *domain.AuditRecord) bool {
+		return record.CreatedAt.Before(cutoff)
+	})
+	return before - len(r.records), nil
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+type inquiryKnowledgeRepo struct {
+	mu     sync.RWMutex
+	items  domain.InquiryKnowledges
+	nextID int
+}
+
+// NewInquiryKnowledgeRepository creates an in-process inquiry knowledge index searched by brute
+// force. It suits small, throwaway knowledge bases such as offline evaluation runs.
+func NewInquiryKnowledgeRepository() repository.InquiryKnowledgeRepository {
+	return &inquiryKnowledgeRepo{nextID: 1}
+}
+
+// BatchSaveInquiryKnowledge saves multiple inquiry knowledge entries and assigns their IDs
+func (r *inquiryKnowledgeRepo) BatchSaveInquiryKnowledge(
+	_ context.Context,
+	items domain.InquiryKnowledges,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, item := range items {
+		saved := *item
+		saved.ID = r.nextID
+		item.ID = r.nextID
+		r.nextID++
+		r.items = append(r.items, &saved)
+	}
+	return nil
+}
+
+// FindSimilars finds inquiry knowledge entries matching the filter that are similar to the
+// given embedding vector with similarity scores
+func (r *inquiryKnowledgeRepo) FindSimilars(
+	_ context.Context,
+	embedding domain.Embedding,
+	limit int,
+	filter domain.KnowledgeFilter,
+) (domain.InquirySimilarityResults, error) {
+	if limit <= 0 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"limit must be greater than 0",
+			nil,
+		)
+	}
+
+	r.mu.RLock()
+	results := make(domain.InquirySimilarityResults, 0, len(r.items))
+	for _, item := range r.items {
+		if item.InstructionEmbedding.IsEmpty() || !filter.Matches(item) {
+			continue
+		}
+		results = append(results, &domain.InquirySimilarityResult{
+			Knowledge:       item,
+			SimilarityScore: utils.CalculateVectorSimilarity(embedding, item.InstructionEmbedding),
+		})
+	}
+	r.mu.RUnlock()
+
+	if len(results) == 0 {
+		return nil, errors.New(
+			constants.NotFound,
+			"no similar inquiry knowledge found",
+			nil,
+		)
+	}
+
+	slices.SortStableFunc(results, func(a, b *domain.InquirySimilarityResult) int {
+		return cmp.Compare(b.SimilarityScore, a.SimilarityScore)
+	})
+	return results[:min(limit, len(results))], nil
+}
+
+// CountEmbeddedInquiryKnowledge returns the number of entries that have an embedding
+func (r *inquiryKnowledgeRepo) CountEmbeddedInquiryKnowledge(_ context.Context) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, item := range r.items {
+		if !item.InstructionEmbedding.IsEmpty() {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/ratelimit"
+)
+
+type rateLimitBucket struct {
+	bucket   *ratelimit.TokenBucket
+	lastSeen time.Time
+}
+
+type rateLimitRepo struct {
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+// NewRateLimitRepository creates an in-process token bucket store.
+// Limits are per instance; use the Postgres store to share them across instances.
+func NewRateLimitRepository() repository.RateLimitRepository {
+	return &rateLimitRepo{buckets: make(map[string]*rateLimitBucket)}
+}
+
+// TakeRateLimitToken takes one token from the client's bucket under the policy
+func (r *rateLimitRepo) TakeRateLimitToken(
+	_ context.Context,
+	policy *domain.RateLimitPolicy,
+	clientKey string,
+) (*domain.RateLimitDecision, error) {
+	key := policy.BucketKey(clientKey)
+
+	r.mu.Lock()
+	b, ok := r.buckets[key]
+	if !ok {
+		b = &rateLimitBucket{
+			bucket: ratelimit.NewTokenBucket(policy.Capacity(), policy.RefillPerSecond()),
+		}
+		r.buckets[key] = b
+	}
+	b.lastSeen = time.Now()
+	r.mu.Unlock()
+
+	allowed, remaining, retryAfter := b.bucket.TryTake(1)
+	return &domain.RateLimitDecision{
+		Allowed:    allowed,
+		Limit:      policy.Capacity(),
+		Remaining:  remaining,
+		RetryAfter: retryAfter,
+	}, nil
+}
+
+// PruneRateLimitBuckets deletes buckets that have been idle for longer than idleFor
+func (r *rateLimitRepo) PruneRateLimitBuckets(_ context.Context, idleFor time.Duration) error {
+	cutoff := time.Now().Add(-idleFor)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, b := range r.buckets {
+		if b.lastSeen.Before(cutoff) {
+			delete(r.buckets, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
+)
+
+const (
+	// jwksRefreshInterval is how long a key set fetched from a URL is used before refetching
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefreshInterval limits refetches triggered by unknown key IDs
+	jwksMinRefreshInterval = time.Minute
+	// clockLeeway tolerates clock skew between us and the identity provider
+	clockLeeway = 30 * time.Second
+	// jwksCacheName labels signing key cache lookups in metrics
+	jwksCacheName = "jwks"
+)
+
+// signingMethods are the accepted JWT algorithms; symmetric and "none" algorithms are rejected
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Config holds the identity token verification settings
+type Config struct {
+	JWKSFile string // Path of a local JWKS document (takes precedence over JWKSURL)
+	JWKSURL  string // URL of the identity provider's JWKS document
+	Issuer   string // Expected "iss" claim
+	Audience string // Expected "aud" claim
+}
+
+type identityTokenRepo struct {
+	cfg        Config
+	httpClient *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewIdentityTokenRepository creates a JWT verifier backed by a JWKS file or URL.
+// The key set is loaded immediately so misconfiguration fails at startup.
+func NewIdentityTokenRepository(cfg Config) (repository.IdentityTokenRepository, error) {
+	if cfg.JWKSFile == "" && cfg.JWKSURL == "" {
+		return nil, errors.New(constants.InvalidParameter, "jwks file or url is required", nil)
+	}
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"jwt issuer and audience are required",
+			nil,
+		)
+	}
+
+	r := &identityTokenRepo{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	if err := r.loadKeys(context.Background()); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// VerifyIdentityToken validates the token signature, issuer, audience and expiry and returns
+// its identity claims
+func (r *identityTokenRepo) VerifyIdentityToken(
+	ctx context.Context,
+	token string,
+) (*domain.IdentityClaims, error) {
+	claims := &identityTokenClaims{}
+	_, err := jwt.ParseWithClaims(
+		token,
+		claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string)
+			return r.key(ctx, kid)
+		},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(r.cfg.Issuer),
+		jwt.WithAudience(r.cfg.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockLeeway),
+	)
+	if err != nil {
+		// Key set outages are not the caller's fault
+		if errors.HasCode(err, constants.UpstreamError) {
+			return nil, errors.Wrap(err, "failed to verify token")
+		}
+		return nil, errors.New(constants.Unauthorized, "invalid token", err)
+	}
+
+	return &domain.IdentityClaims{
+		Issuer:            claims.Issuer,
+		Subject:           claims.Subject,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+		Email:             claims.Email,
+	}, nil
+}
+
+// key returns the public key for a key ID, refetching a URL key set when the ID is unknown
+// or the set is stale
+func (r *identityTokenRepo) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, age := r.lookup(kid)
+	refetch := r.cfg.JWKSFile == "" &&
+		((!ok && age >= jwksMinRefreshInterval) || age >= jwksRefreshInterval)
+	if ok && !refetch {
+		metrics.ObserveCache(jwksCacheName, metrics.CacheHit)
+	} else {
+		metrics.ObserveCache(jwksCacheName, metrics.CacheMiss)
+	}
+	if refetch {
+		if err := r.loadKeys(ctx); err != nil {
+			// Keep serving the cached keys if the provider is briefly unavailable
+			if !ok {
+				return nil, err
+			}
+		} else {
+			key, ok, _ = r.lookup(kid)
+		}
+	}
+
+	if !ok {
+		return nil, errors.New(
+			constants.Unauthorized,
+			fmt.Sprintf("unknown signing key %q", kid),
+			nil,
+		)
+	}
+	return key, nil
+}
+
+// lookup finds a key by ID; an empty ID matches when the set holds a single key
+func (r *identityTokenRepo) lookup(kid string) (crypto.PublicKey, bool, time.Duration) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	age := time.Since(r.fetchedAt)
+	if kid == "" && len(r.keys) == 1 {
+		for _, key := range r.keys {
+			return key, true, age
+		}
+	}
+	key, ok := r.keys[kid]
+	return key, ok, age
+}
+
+// loadKeys reads the key set from the configured file or URL and replaces the cached keys
+func (r *identityTokenRepo) loadKeys(ctx context.Context) error {
+	var (
+		content []byte
+		err     error
+	)
+	if r.cfg.JWKSFile != "" {
+		content, err = os.ReadFile(r.cfg.JWKSFile)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to read jwks file %s", r.cfg.JWKSFile))
+		}
+	} else {
+		content, err = r.fetch(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	var set jwkSet
+	if err := json.Unmarshal(content, &set); err != nil {
+		return errors.Wrap(err, "failed to parse jwks", constants.UpstreamError)
+	}
+	keys, err := toPublicKeys(&set)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse jwks", constants.UpstreamError)
+	}
+	if len(keys) == 0 {
+		return errors.New(constants.UpstreamError, "jwks has no signing keys", nil)
+	}
+
+	r.mu.Lock()
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// fetch downloads the key set document from the configured URL
+func (r *identityTokenRepo) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.cfg.JWKSURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build jwks request", constants.InvalidParameter)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch jwks", constants.UpstreamError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(
+			constants.UpstreamError,
+			fmt.Sprintf("failed to fetch jwks: status %d", resp.StatusCode),
+			nil,
+		)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read jwks", constants.UpstreamError)
+	}
+	return content, nil
+}
@@ -0,0 +1,125 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// jwkSet is the JSON Web Key Set document (RFC 7517)
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk is a single JSON Web Key; only the members of RSA and EC public keys are read
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// identityTokenClaims are the JWT claims read from an end-user token
+type identityTokenClaims struct {
+	jwt.RegisteredClaims
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+}
+
+// toPublicKeys converts the signing keys of a key set to public keys by key ID.
+// Encryption keys and unsupported key types are skipped.
+func toPublicKeys(set *jwkSet) (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = toRSAPublicKey(k)
+		case "EC":
+			key, err = toECDSAPublicKey(k)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid jwk %q", k.Kid))
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func toRSAPublicKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() {
+		return nil, errors.New(constants.InvalidParameter, "rsa exponent is too large", nil)
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func toECDSAPublicKey(k jwk) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, errors.New(
+			constants.InvalidParameter,
+			fmt.Sprintf("unsupported curve %q", k.Crv),
+			nil,
+		)
+	}
+
+	x, err := decodeBigInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// decodeBigInt decodes a base64url-encoded big-endian integer
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New(constants.InvalidParameter, "invalid base64url value", err)
+	}
+	if len(b) == 0 {
+		return nil, errors.New(constants.InvalidParameter, "empty key parameter", nil)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// rankKnowledgeByNegativeFeedbackQuery counts feedback per knowledge entry an answer was
+// generated from. $1 = since, $2 = limit.
+const rankKnowledgeByNegativeFeedbackQuery = `
+SELECT k.id, k.instruction, k.response, k.category, k.intent,
+	count(*) FILTER (WHERE f.rating = 'down') AS negative,
+	count(*) FILTER (WHERE f.rating = 'up') AS positive
+FROM answer_feedbacks f
+CROSS JOIN LATERAL jsonb_array_elements_text(f.knowledge_ids) AS fk(id)
+JOIN inquiry_knowledges k ON k.id = fk.id::int
+WHERE f.created_at >= $1
+GROUP BY k.id, k.instruction, k.response, k.category, k.intent
+HAVING count(*) FILTER (WHERE f.rating = 'down') > 0
+ORDER BY negative DESC, positive ASC, k.id
+LIMIT $2`
+
+// listKnowledgeGapCandidatesQuery finds answered questions with a weak best match or a
+// negative rating, with the embedding of the question when it was stored.
+// $1 = since, $2 = max similarity, $3 = limit.
+const listKnowledgeGapCandidatesQuery = `
+SELECT a.id, a.question,
+	COALESCE((a.similarity_scores->>0)::float8, 0) AS top_similarity,
+	f.id IS NOT NULL AS rated_down,
+	a.question_embedding
+FROM audit_records a
+LEFT JOIN answer_feedbacks f ON f.answer_id = a.id AND f.rating = 'down'
+WHERE a.created_at >= $1
+	AND a.question <> ''
+	AND (COALESCE((a.similarity_scores->>0)::float8, 0) < $2 OR f.id IS NOT NULL)
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT $3`
+
+type answerFeedbackRepo struct {
+	client *ent.Client
+}
+
+// NewAnswerFeedbackRepository creates a new EntGo-based answer feedback repository
+func NewAnswerFeedbackRepository(client *ent.Client) repository.AnswerFeedbackRepository {
+	return &answerFeedbackRepo{client: client}
+}
+
+// SaveAnswerFeedback stores the feedback on an answer and sets its ID
+func (r *answerFeedbackRepo) SaveAnswerFeedback(
+	ctx context.Context,
+	feedback *domain.AnswerFeedback,
+) error {
+	entFeedback, err := r.client.AnswerFeedback.Create().
+		SetAnswerID(feedback.AnswerID).
+		SetRating(string(feedback.Rating)).
+		SetReason(feedback.Reason).
+		SetCorrection(feedback.Correction).
+		SetKnowledgeIds(feedback.KnowledgeIDs).
+		SetNillableUserID(feedback.UserID).
+		SetAPIKeyID(feedback.APIKeyID).
+		SetCreatedAt(feedback.CreatedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return errors.New(
+			constants.ConstraintError,
+			"feedback was already given for this answer",
+			err,
+		)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to save answer feedback")
+	}
+	feedback.ID = entFeedback.ID
+	return nil
+}
+
+// RankKnowledgeByNegativeFeedback returns knowledge entries with negative feedback since the
+// given time, most rated down first
+func (r *answerFeedbackRepo) RankKnowledgeByNegativeFeedback(
+	ctx context.Context,
+	since time.Time,
+	limit int,
+) (domain.KnowledgeFeedbackStats, error) {
+	rows, err := r.client.QueryContext(ctx, rankKnowledgeByNegativeFeedbackQuery, since, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to rank knowledge by negative feedback")
+	}
+	defer rows.Close()
+
+	var stats domain.KnowledgeFeedbackStats
+	for rows.Next() {
+		stat := &domain.KnowledgeFeedbackStat{Knowledge: &domain.InquiryKnowledge{}}
+		if err := rows.Scan(
+			&stat.Knowledge.ID,
+			&stat.Knowledge.Instruction,
+			&stat.Knowledge.Response,
+			&stat.Knowledge.Category,
+			&stat.Knowledge.Intent,
+			&stat.NegativeCount,
+			&stat.PositiveCount,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan knowledge feedback")
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to rank knowledge by negative feedback")
+	}
+	return stats, nil
+}
+
+// ListKnowledgeGapCandidates returns recent questions whose best retrieved entry was less
+// similar than maxSimilarity or whose answer was rated down, newest first
+func (r *answerFeedbackRepo) ListKnowledgeGapCandidates(
+	ctx context.Context,
+	since time.Time,
+	maxSimilarity float64,
+	limit int,
+) (domain.KnowledgeGapCandidates, error) {
+	rows, err := r.client.QueryContext(
+		ctx,
+		listKnowledgeGapCandidatesQuery,
+		since,
+		maxSimilarity,
+		limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list knowledge gap candidates")
+	}
+	defer rows.Close()
+
+	var candidates domain.KnowledgeGapCandidates
+	for rows.Next() {
+		candidate := &domain.KnowledgeGapCandidate{}
+		var embedding sql.Null[pgvector.Vector]
+		if err := rows.Scan(
+			&candidate.AnswerID,
+			&candidate.Question,
+			&candidate.TopSimilarity,
+			&candidate.RatedDown,
+			&embedding,
+		); err != nil {
+			return nil, errors.Wrap(err, "failed to scan knowledge gap candidate")
+		}
+		if embedding.Valid {
+			candidate.QuestionEmbedding = toDomainEmbedding(embedding.V)
+		}
+		candidates = append(candidates, candidate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list knowledge gap candidates")
+	}
+	return candidates, nil
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+)
+
+// toDomainAPIKey converts ent.APIKey to domain.APIKey
+func toDomainAPIKey(entKey *ent.APIKey) *domain.APIKey {
+	scopes := make(domain.APIKeyScopes, len(entKey.Scopes))
+	for i, scope := range entKey.Scopes {
+		scopes[i] = domain.APIKeyScope(scope)
+	}
+
+	return &domain.APIKey{
+		ID:         entKey.ID,
+		KeyID:      entKey.KeyID,
+		Name:       entKey.Name,
+		SecretHash: entKey.SecretHash,
+		Scopes:     scopes,
+		CreatedAt:  entKey.CreatedAt,
+		LastUsedAt: entKey.LastUsedAt,
+		RevokedAt:  entKey.RevokedAt,
+	}
+}
+
+// toDomainAPIKeys converts ent.APIKey slice to domain.APIKeys
+func toDomainAPIKeys(entKeys []*ent.APIKey) domain.APIKeys {
+	keys := make(domain.APIKeys, len(entKeys))
+	for i, entKey := range entKeys {
+		keys[i] = toDomainAPIKey(entKey)
+	}
+	return keys
+}
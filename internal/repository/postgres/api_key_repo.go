@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/apikey"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type apiKeyRepo struct {
+	client *ent.Client
+}
+
+// NewAPIKeyRepository creates a new EntGo-based API key repository
+func NewAPIKeyRepository(client *ent.Client) repository.APIKeyRepository {
+	return &apiKeyRepo{client: client}
+}
+
+// CreateAPIKey stores a new API key
+func (r *apiKeyRepo) CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	return createAPIKey(ctx, r.client.APIKey, key)
+}
+
+// GetAPIKeyByKeyID returns the API key with the given public key ID
+func (r *apiKeyRepo) GetAPIKeyByKeyID(ctx context.Context, keyID string) (*domain.APIKey, error) {
+	entKey, err := r.client.APIKey.Query().
+		Where(apikey.KeyID(keyID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New(
+			constants.NotFound,
+			fmt.Sprintf("api key %s not found", keyID),
+			nil,
+		)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get api key")
+	}
+	return toDomainAPIKey(entKey), nil
+}
+
+// ListAPIKeys returns every API key, newest first
+func (r *apiKeyRepo) ListAPIKeys(ctx context.Context) (domain.APIKeys, error) {
+	entKeys, err := r.client.APIKey.Query().
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list api keys")
+	}
+	return toDomainAPIKeys(entKeys), nil
+}
+
+// RevokeAPIKey marks the API key as revoked
+func (r *apiKeyRepo) RevokeAPIKey(ctx context.Context, keyID string, revokedAt time.Time) error {
+	return revokeAPIKey(ctx, r.client.APIKey, keyID, revokedAt)
+}
+
+// ReplaceAPIKey atomically revokes the old API key and stores its replacement
+func (r *apiKeyRepo) ReplaceAPIKey(
+	ctx context.Context,
+	oldKeyID string,
+	newKey *domain.APIKey,
+) (*domain.APIKey, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start transaction")
+	}
+
+	if err := revokeAPIKey(ctx, tx.APIKey, oldKeyID, newKey.CreatedAt); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	created, err := createAPIKey(ctx, tx.APIKey, newKey)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit api key rotation")
+	}
+	return created, nil
+}
+
+// TouchAPIKey records when the API key was last used
+func (r *apiKeyRepo) TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	_, err := r.client.APIKey.Update().
+		Where(apikey.KeyID(keyID)).
+		SetLastUsedAt(usedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to update api key last used time")
+	}
+	return nil
+}
+
+// createAPIKey stores a new API key using the given (transactional or plain) client
+func createAPIKey(
+	ctx context.Context,
+	client *ent.APIKeyClient,
+	key *domain.APIKey,
+) (*domain.APIKey, error) {
+	entKey, err := client.Create().
+		SetKeyID(key.KeyID).
+		SetName(key.Name).
+		SetSecretHash(key.SecretHash).
+		SetScopes(key.Scopes.Strings()).
+		SetCreatedAt(key.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create api key")
+	}
+	return toDomainAPIKey(entKey), nil
+}
+
+// revokeAPIKey revokes an active API key using the given (transactional or plain) client
+func revokeAPIKey(
+	ctx context.Context,
+	client *ent.APIKeyClient,
+	keyID string,
+	revokedAt time.Time,
+) error {
+	n, err := client.Update().
+		Where(apikey.KeyID(keyID), apikey.RevokedAtIsNil()).
+		SetRevokedAt(revokedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke api key")
+	}
+	if n == 0 {
+		return errors.New(
+			constants.NotFound,
+			fmt.Sprintf("active api key %s not found", keyID),
+			nil,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+)
+
+// toEntAuditRetrievals splits retrieved knowledge entries into their IDs and similarity scores
+func toEntAuditRetrievals(retrieved []domain.AuditRetrieval) ([]int, []float64) {
+	knowledgeIDs := make([]int, len(retrieved))
+	scores := make([]float64, len(retrieved))
+	for i, item := range retrieved {
+		knowledgeIDs[i] = item.KnowledgeID
+		scores[i] = item.SimilarityScore
+	}
+	return knowledgeIDs, scores
+}
+
+// toEntVector converts a domain.Embedding to a pgvector.Vector
+func toEntVector(embedding domain.Embedding) pgvector.Vector {
+	vec := make([]float32, len(embedding))
+	for i, v := range embedding {
+		vec[i] = float32(v)
+	}
+	return pgvector.NewVector(vec)
+}
+
+// toDomainEmbedding converts a pgvector.Vector to a domain.Embedding
+func toDomainEmbedding(vector pgvector.Vector) domain.Embedding {
+	vec := vector.Slice()
+	if vec == nil {
+		return nil
+	}
+	embedding := make(domain.Embedding, len(vec))
+	for i, v := range vec {
+		embedding[i] = float64(v)
+	}
+	return embedding
+}
+
+// toDomainAuditRecords converts ent.AuditRecord slice to domain.AuditRecords
+func toDomainAuditRecords(entRecords []*ent.AuditRecord) domain.AuditRecords {
+	records := make(domain.AuditRecords, len(entRecords))
+	for i, entRecord := range entRecords {
+		records[i] = toDomainAuditRecord(entRecord)
+	}
+	return records
+}
+
+// toDomainAuditRecord converts ent.AuditRecord to domain.AuditRecord
+func toDomainAuditRecord(entRecord *ent.AuditRecord) *domain.AuditRecord {
+	retrieved := make([]domain.AuditRetrieval, len(entRecord.KnowledgeIds))
+	for i, knowledgeID := range entRecord.KnowledgeIds {
+		retrieved[i].KnowledgeID = knowledgeID
+		if i < len(entRecord.SimilarityScores) {
+			retrieved[i].SimilarityScore = entRecord.SimilarityScores[i]
+		}
+	}
+
+	return &domain.AuditRecord{
+		ID:        entRecord.ID,
+		TrID:      entRecord.Trid,
+		UserID:    entRecord.UserID,
+		APIKeyID:  entRecord.APIKeyID,
+		Question:  entRecord.Question,
+		Intent:    entRecord.Intent,
+		Retrieved: retrieved,
+		Metadata: domain.AnswerMetadata{
+			TemplateName:    entRecord.TemplateName,
+			TemplateVersion: entRecord.TemplateVersion,
+			Model:           entRecord.Model,
+			Experiment:      entRecord.Experiment,
+			Variant:         entRecord.Variant,
+		},
+		RawOutput: entRecord.RawOutput,
+		Answer:    entRecord.Answer,
+		Handoff:   entRecord.Handoff,
+		Latencies: domain.AuditLatencies{
+			Embedding:    time.Duration(entRecord.EmbeddingLatencyMs) * time.Millisecond,
+			VectorSearch: time.Duration(entRecord.VectorSearchLatencyMs) * time.Millisecond,
+			LLM:          time.Duration(entRecord.LlmLatencyMs) * time.Millisecond,
+			Total:        time.Duration(entRecord.TotalLatencyMs) * time.Millisecond,
+		},
+		ErrorCode: entRecord.ErrorCode,
+		Error:     entRecord.Error,
+		CreatedAt: entRecord.CreatedAt,
+	}
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type auditRepo struct {
+	client *ent.Client
+}
+
+// NewAuditRepository creates a new EntGo-based audit trail repository
+func NewAuditRepository(client *ent.Client) repository.AuditRepository {
+	return &auditRepo{client: client}
+}
+
+// SaveAuditRecord stores the audit record of a single question and sets its ID
+func (r *auditRepo) SaveAuditRecord(ctx context.Context, record *domain.AuditRecord) error {
+	knowledgeIDs, scores := toEntAuditRetrievals(record.Retrieved)
+
+	create := r.client.AuditRecord.Create().
+		SetTrid(record.TrID).
+		SetNillableUserID(record.UserID).
+		SetAPIKeyID(record.APIKeyID).
+		SetQuestion(record.Question).
+		SetIntent(record.Intent).
+		SetKnowledgeIds(knowledgeIDs).
+		SetSimilarityScores(scores).
+		SetTemplateName(record.Metadata.TemplateName).
+		SetTemplateVersion(record.Metadata.TemplateVersion).
+		SetModel(record.Metadata.Model).
+		SetExperiment(record.Metadata.Experiment).
+		SetVariant(record.Metadata.Variant).
+		SetRawOutput(record.RawOutput).
+		SetAnswer(record.Answer).
+		SetHandoff(record.Handoff).
+		SetEmbeddingLatencyMs(record.Latencies.Embedding.Milliseconds()).
+		SetVectorSearchLatencyMs(record.Latencies.VectorSearch.Milliseconds()).
+		SetLlmLatencyMs(record.Latencies.LLM.Milliseconds()).
+		SetTotalLatencyMs(record.Latencies.Total.Milliseconds()).
+		SetErrorCode(record.ErrorCode).
+		SetError(record.Error).
+		SetCreatedAt(record.CreatedAt)
+	if !record.QuestionEmbedding.IsEmpty() {
+		create.SetQuestionEmbedding(toEntVector(record.QuestionEmbedding))
+	}
+
+	entRecord, err := create.Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to save audit record")
+	}
+	record.ID = entRecord.ID
+	return nil
+}
+
+// GetAuditRecord returns the audit record with the given ID
+func (r *auditRepo) GetAuditRecord(ctx context.Context, id int) (*domain.AuditRecord, error) {
+	entRecord, err := r.client.AuditRecord.Query().
+		Where(auditrecord.ID(id)).
+		Select(auditRecordColumns()...).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New(constants.NotFound, fmt.Sprintf("answer %d not found", id), nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get audit record")
+	}
+	return toDomainAuditRecord(entRecord), nil
+}
+
+// ListAuditRecords returns a page of records matching the filter, newest first, and the total count
+func (r *auditRepo) ListAuditRecords(
+	ctx context.Context,
+	filter *domain.AuditFilter,
+) (domain.AuditRecords, int, error) {
+	query := r.client.AuditRecord.Query().
+		Where(auditFilterPredicates(filter)...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count audit records")
+	}
+
+	entRecords, err := query.
+		Order(ent.Desc(auditrecord.FieldCreatedAt), ent.Desc(auditrecord.FieldID)).
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Select(auditRecordColumns()...).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list audit records")
+	}
+
+	return toDomainAuditRecords(entRecords), total, nil
+}
+
+// DeleteAuditRecordsBefore deletes records created before cutoff and returns how many were deleted
+func (r *auditRepo) DeleteAuditRecordsBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	deleted, err := r.client.AuditRecord.Delete().
+		Where(auditrecord.CreatedAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete expired audit records")
+	}
+	return deleted, nil
+}
+
+// auditRecordColumns returns the columns read back from audit records. The question embedding
+// is left out: it is only read by the knowledge gap report, and is NULL on failed questions.
+func auditRecordColumns() []string {
+	return slices.DeleteFunc(slices.Clone(auditrecord.Columns), func(column string) bool {
+		return column == auditrecord.FieldQuestionEmbedding
+	})
+}
+
+// auditFilterPredicates converts the set fields of an audit filter to query predicates
+func auditFilterPredicates(filter *domain.AuditFilter) []predicate.AuditRecord {
+	var predicates []predicate.AuditRecord
+	if !filter.From.IsZero() {
+		predicates = append(predicates, auditrecord.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, auditrecord.CreatedAtLT(filter.To))
+	}
+	if filter.Intent != "" {
+		predicates = append(predicates, auditrecord.Intent(filter.Intent))
+	}
+	if filter.ErrorCode != "" {
+		predicates = append(predicates, auditrecord.ErrorCode(filter.ErrorCode))
+	}
+	return predicates
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+)
+
+// toDomainConversationTurns converts ent.ConversationTurn slice to domain.ConversationTurns
+func toDomainConversationTurns(entTurns []*ent.ConversationTurn) domain.ConversationTurns {
+	turns := make(domain.ConversationTurns, len(entTurns))
+	for i, entTurn := range entTurns {
+		turns[i] = &domain.ConversationTurn{
+			ID:        entTurn.ID,
+			UserID:    entTurn.UserID,
+			TrID:      entTurn.Trid,
+			Question:  entTurn.Question,
+			Answer:    entTurn.Answer,
+			CreatedAt: entTurn.CreatedAt,
+		}
+	}
+	return turns
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type conversationRepo struct {
+	client *ent.Client
+}
+
+// NewConversationRepository creates a new EntGo-based conversation history repository
+func NewConversationRepository(client *ent.Client) repository.ConversationRepository {
+	return &conversationRepo{client: client}
+}
+
+// SaveConversationTurn stores a question and its answer for a user
+func (r *conversationRepo) SaveConversationTurn(
+	ctx context.Context,
+	turn *domain.ConversationTurn,
+) error {
+	_, err := r.client.ConversationTurn.Create().
+		SetUserID(turn.UserID).
+		SetTrid(turn.TrID).
+		SetQuestion(turn.Question).
+		SetAnswer(turn.Answer).
+		SetCreatedAt(turn.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to save conversation turn")
+	}
+	return nil
+}
+
+// ListConversationTurns returns a page of the user's turns, newest first, and the total count
+func (r *conversationRepo) ListConversationTurns(
+	ctx context.Context,
+	userID, offset, limit int,
+) (domain.ConversationTurns, int, error) {
+	query := r.client.ConversationTurn.Query().
+		Where(conversationturn.UserID(userID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count conversation turns")
+	}
+
+	entTurns, err := query.
+		Order(ent.Desc(conversationturn.FieldCreatedAt), ent.Desc(conversationturn.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list conversation turns")
+	}
+
+	return toDomainConversationTurns(entTurns), total, nil
+}
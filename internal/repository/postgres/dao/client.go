@@ -1,340 +0,0 @@
-// Code generated by ent, DO NOT EDIT.
-
-package dao
-
-import (
-	"context"
-	"errors"
-	"fmt"
-	"log"
-	"reflect"
-
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/migrate"
-
-	"entgo.io/ent"
-	"entgo.io/ent/dialect"
-	"entgo.io/ent/dialect/sql"
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/inquiryknowledge"
-)
-
-// Client is the client that holds all ent builders.
-type Client struct {
-	config
-	// Schema is the client for creating, migrating and dropping schema.
-	Schema *migrate.Schema
-	// InquiryKnowledge is the client for interacting with the InquiryKnowledge builders.
-	InquiryKnowledge *InquiryKnowledgeClient
-}
-
-// NewClient creates a new client configured with the given options.
-func NewClient(opts ...Option) *Client {
-	client := &Client{config: newConfig(opts...)}
-	client.init()
-	return client
-}
-
-func (c *Client) init() {
-	c.Schema = migrate.NewSchema(c.driver)
-	c.InquiryKnowledge = NewInquiryKnowledgeClient(c.config)
-}
-
-type (
-	// config is the configuration for the client and its builder.
-	config struct {
-		// driver used for executing database requests.
-		driver dialect.Driver
-		// debug enable a debug logging.
-		debug bool
-		// log used for logging on debug mode.
-		log func(...any)
-		// hooks to execute on mutations.
-		hooks *hooks
-		// interceptors to execute on queries.
-		inters *inters
-	}
-	// Option function to configure the client.
-	Option func(*config)
-)
-
-// newConfig creates a new config for the client.
-func newConfig(opts ...Option) config {
-	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
-	cfg.options(opts...)
-	return cfg
-}
-
-// options applies the options on the config object.
-func (c *config) options(opts ...Option) {
-	for _, opt := range opts {
-		opt(c)
-	}
-	if c.debug {
-		c.driver = dialect.Debug(c.driver, c.log)
-	}
-}
-
-// Debug enables debug logging on the ent.Driver.
-func Debug() Option {
-	return func(c *config) {
-		c.debug = true
-	}
-}
-
-// Log sets the logging function for debug mode.
-func Log(fn func(...any)) Option {
-	return func(c *config) {
-		c.log = fn
-	}
-}
-
-// Driver configures the client driver.
-func Driver(driver dialect.Driver) Option {
-	return func(c *config) {
-		c.driver = driver
-	}
-}
-
-// Open opens a database/sql.DB specified by the driver name and
-// the data source name, and returns a new client attached to it.
-// Optional parameters can be added for configuring the client.
-func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
-	switch driverName {
-	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
-		drv, err := sql.Open(driverName, dataSourceName)
-		if err != nil {
-			return nil, err
-		}
-		return NewClient(append(options, Driver(drv))...), nil
-	default:
-		return nil, fmt.Errorf("unsupported driver: %q", driverName)
-	}
-}
-
-// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
-var ErrTxStarted = errors.New("dao: cannot start a transaction within a transaction")
-
-// Tx returns a new transactional client. The provided context
-// is used until the transaction is committed or rolled back.
-func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, ErrTxStarted
-	}
-	tx, err := newTx(ctx, c.driver)
-	if err != nil {
-		return nil, fmt.Errorf("dao: starting a transaction: %w", err)
-	}
-	cfg := c.config
-	cfg.driver = tx
-	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		InquiryKnowledge: NewInquiryKnowledgeClient(cfg),
-	}, nil
-}
-
-// BeginTx returns a transactional client with specified options.
-func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, errors.New("ent: cannot start a transaction within a transaction")
-	}
-	tx, err := c.driver.(interface {
-		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
-	}).BeginTx(ctx, opts)
-	if err != nil {
-		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
-	}
-	cfg := c.config
-	cfg.driver = &txDriver{tx: tx, drv: c.driver}
-	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		InquiryKnowledge: NewInquiryKnowledgeClient(cfg),
-	}, nil
-}
-
-// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
-//
-//	client.Debug().
-//		InquiryKnowledge.
-//		Query().
-//		Count(ctx)
-func (c *Client) Debug() *Client {
-	if c.debug {
-		return c
-	}
-	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
-	client := &Client{config: cfg}
-	client.init()
-	return client
-}
-
-// Close closes the database connection and prevents new queries from starting.
-func (c *Client) Close() error {
-	return c.driver.Close()
-}
-
-// Use adds the mutation hooks to all the entity clients.
-// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
-func (c *Client) Use(hooks ...Hook) {
-	c.InquiryKnowledge.Use(hooks...)
-}
-
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.InquiryKnowledge.Intercept(interceptors...)
-}
-
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *InquiryKnowledgeMutation:
-		return c.InquiryKnowledge.mutate(ctx, m)
-	default:
-		return nil, fmt.Errorf("dao: unknown mutation type %T", m)
-	}
-}
-
-// InquiryKnowledgeClient is a client for the InquiryKnowledge schema.
-type InquiryKnowledgeClient struct {
-	config
-}
-
-// NewInquiryKnowledgeClient returns a client for the InquiryKnowledge from the given config.
-func NewInquiryKnowledgeClient(c config) *InquiryKnowledgeClient {
-	return &InquiryKnowledgeClient{config: c}
-}
-
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `inquiryknowledge.Hooks(f(g(h())))`.
-func (c *InquiryKnowledgeClient) Use(hooks ...Hook) {
-	c.hooks.InquiryKnowledge = append(c.hooks.InquiryKnowledge, hooks...)
-}
-
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `inquiryknowledge.Intercept(f(g(h())))`.
-func (c *InquiryKnowledgeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InquiryKnowledge = append(c.inters.InquiryKnowledge, interceptors...)
-}
-
-// Create returns a builder for creating a InquiryKnowledge entity.
-func (c *InquiryKnowledgeClient) Create() *InquiryKnowledgeCreate {
-	mutation := newInquiryKnowledgeMutation(c.config, OpCreate)
-	return &InquiryKnowledgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// CreateBulk returns a builder for creating a bulk of InquiryKnowledge entities.
-func (c *InquiryKnowledgeClient) CreateBulk(builders ...*InquiryKnowledgeCreate) *InquiryKnowledgeCreateBulk {
-	return &InquiryKnowledgeCreateBulk{config: c.config, builders: builders}
-}
-
-// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
-// a builder and applies setFunc on it.
-func (c *InquiryKnowledgeClient) MapCreateBulk(slice any, setFunc func(*InquiryKnowledgeCreate, int)) *InquiryKnowledgeCreateBulk {
-	rv := reflect.ValueOf(slice)
-	if rv.Kind() != reflect.Slice {
-		return &InquiryKnowledgeCreateBulk{err: fmt.Errorf("calling to InquiryKnowledgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
-	}
-	builders := make([]*InquiryKnowledgeCreate, rv.Len())
-	for i := 0; i < rv.Len(); i++ {
-		builders[i] = c.Create()
-		setFunc(builders[i], i)
-	}
-	return &InquiryKnowledgeCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for InquiryKnowledge.
-func (c *InquiryKnowledgeClient) Update() *InquiryKnowledgeUpdate {
-	mutation := newInquiryKnowledgeMutation(c.config, OpUpdate)
-	return &InquiryKnowledgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOne returns an update builder for the given entity.
-func (c *InquiryKnowledgeClient) UpdateOne(_m *InquiryKnowledge) *InquiryKnowledgeUpdateOne {
-	mutation := newInquiryKnowledgeMutation(c.config, OpUpdateOne, withInquiryKnowledge(_m))
-	return &InquiryKnowledgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// UpdateOneID returns an update builder for the given id.
-func (c *InquiryKnowledgeClient) UpdateOneID(id int) *InquiryKnowledgeUpdateOne {
-	mutation := newInquiryKnowledgeMutation(c.config, OpUpdateOne, withInquiryKnowledgeID(id))
-	return &InquiryKnowledgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for InquiryKnowledge.
-func (c *InquiryKnowledgeClient) Delete() *InquiryKnowledgeDelete {
-	mutation := newInquiryKnowledgeMutation(c.config, OpDelete)
-	return &InquiryKnowledgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *InquiryKnowledgeClient) DeleteOne(_m *InquiryKnowledge) *InquiryKnowledgeDeleteOne {
-	return c.DeleteOneID(_m.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InquiryKnowledgeClient) DeleteOneID(id int) *InquiryKnowledgeDeleteOne {
-	builder := c.Delete().Where(inquiryknowledge.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &InquiryKnowledgeDeleteOne{builder}
-}
-
-// Query returns a query builder for InquiryKnowledge.
-func (c *InquiryKnowledgeClient) Query() *InquiryKnowledgeQuery {
-	return &InquiryKnowledgeQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeInquiryKnowledge},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a InquiryKnowledge entity by its id.
-func (c *InquiryKnowledgeClient) Get(ctx context.Context, id int) (*InquiryKnowledge, error) {
-	return c.Query().Where(inquiryknowledge.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *InquiryKnowledgeClient) GetX(ctx context.Context, id int) *InquiryKnowledge {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// Hooks returns the client hooks.
-func (c *InquiryKnowledgeClient) Hooks() []Hook {
-	return c.hooks.InquiryKnowledge
-}
-
-// Interceptors returns the client interceptors.
-func (c *InquiryKnowledgeClient) Interceptors() []Interceptor {
-	return c.inters.InquiryKnowledge
-}
-
-func (c *InquiryKnowledgeClient) mutate(ctx context.Context, m *InquiryKnowledgeMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&InquiryKnowledgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&InquiryKnowledgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&InquiryKnowledgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&InquiryKnowledgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("dao: unknown InquiryKnowledge mutation op: %q", m.Op())
-	}
-}
-
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		InquiryKnowledge []ent.Hook
-	}
-	inters struct {
-		InquiryKnowledge []ent.Interceptor
-	}
-)
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+)
+
+// AnswerFeedback is the model entity for the AnswerFeedback schema.
+type AnswerFeedback struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AnswerID holds the value of the "answer_id" field.
+	AnswerID int `json:"answer_id,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating string `json:"rating,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Correction holds the value of the "correction" field.
+	Correction string `json:"correction,omitempty"`
+	// KnowledgeIds holds the value of the "knowledge_ids" field.
+	KnowledgeIds []int `json:"knowledge_ids,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// APIKeyID holds the value of the "api_key_id" field.
+	APIKeyID string `json:"api_key_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnswerFeedback) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case answerfeedback.FieldKnowledgeIds:
+			values[i] = new([]byte)
+		case answerfeedback.FieldID, answerfeedback.FieldAnswerID, answerfeedback.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case answerfeedback.FieldRating, answerfeedback.FieldReason, answerfeedback.FieldCorrection, answerfeedback.FieldAPIKeyID:
+			values[i] = new(sql.NullString)
+		case answerfeedback.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnswerFeedback fields.
+func (_m *AnswerFeedback) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case answerfeedback.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case answerfeedback.FieldAnswerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_id", values[i])
+			} else if value.Valid {
+				_m.AnswerID = int(value.Int64)
+			}
+		case answerfeedback.FieldRating:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				_m.Rating = value.String
+			}
+		case answerfeedback.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case answerfeedback.FieldCorrection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correction", values[i])
+			} else if value.Valid {
+				_m.Correction = value.String
+			}
+		case answerfeedback.FieldKnowledgeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knowledge_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.KnowledgeIds); err != nil {
+					return fmt.Errorf("unmarshal field knowledge_ids: %w", err)
+				}
+			}
+		case answerfeedback.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case answerfeedback.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = value.String
+			}
+		case answerfeedback.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnswerFeedback.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnswerFeedback) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnswerFeedback.
+// Note that you need to call AnswerFeedback.Unwrap() before calling this method if this AnswerFeedback
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnswerFeedback) Update() *AnswerFeedbackUpdateOne {
+	return NewAnswerFeedbackClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnswerFeedback entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnswerFeedback) Unwrap() *AnswerFeedback {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnswerFeedback is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnswerFeedback) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnswerFeedback(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("answer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnswerID))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(_m.Rating)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("correction=")
+	builder.WriteString(_m.Correction)
+	builder.WriteString(", ")
+	builder.WriteString("knowledge_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KnowledgeIds))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("api_key_id=")
+	builder.WriteString(_m.APIKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnswerFeedbacks is a parsable slice of AnswerFeedback.
+type AnswerFeedbacks []*AnswerFeedback
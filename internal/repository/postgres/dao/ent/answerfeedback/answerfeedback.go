@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package answerfeedback
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the answerfeedback type in the database.
+	Label = "answer_feedback"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAnswerID holds the string denoting the answer_id field in the database.
+	FieldAnswerID = "answer_id"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCorrection holds the string denoting the correction field in the database.
+	FieldCorrection = "correction"
+	// FieldKnowledgeIds holds the string denoting the knowledge_ids field in the database.
+	FieldKnowledgeIds = "knowledge_ids"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the answerfeedback in the database.
+	Table = "answer_feedbacks"
+)
+
+// Columns holds all SQL columns for answerfeedback fields.
+var Columns = []string{
+	FieldID,
+	FieldAnswerID,
+	FieldRating,
+	FieldReason,
+	FieldCorrection,
+	FieldKnowledgeIds,
+	FieldUserID,
+	FieldAPIKeyID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	RatingValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AnswerFeedback queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAnswerID orders the results by the answer_id field.
+func ByAnswerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerID, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCorrection orders the results by the correction field.
+func ByCorrection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrection, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAPIKeyID orders the results by the api_key_id field.
+func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
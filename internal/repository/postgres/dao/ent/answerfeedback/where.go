@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package answerfeedback
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldID, id))
+}
+
+// AnswerID applies equality check predicate on the "answer_id" field. It's identical to AnswerIDEQ.
+func AnswerID(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldAnswerID, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldRating, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldReason, v))
+}
+
+// Correction applies equality check predicate on the "correction" field. It's identical to CorrectionEQ.
+func Correction(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldCorrection, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AnswerIDEQ applies the EQ predicate on the "answer_id" field.
+func AnswerIDEQ(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldAnswerID, v))
+}
+
+// AnswerIDNEQ applies the NEQ predicate on the "answer_id" field.
+func AnswerIDNEQ(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldAnswerID, v))
+}
+
+// AnswerIDIn applies the In predicate on the "answer_id" field.
+func AnswerIDIn(vs ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldAnswerID, vs...))
+}
+
+// AnswerIDNotIn applies the NotIn predicate on the "answer_id" field.
+func AnswerIDNotIn(vs ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldAnswerID, vs...))
+}
+
+// AnswerIDGT applies the GT predicate on the "answer_id" field.
+func AnswerIDGT(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldAnswerID, v))
+}
+
+// AnswerIDGTE applies the GTE predicate on the "answer_id" field.
+func AnswerIDGTE(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldAnswerID, v))
+}
+
+// AnswerIDLT applies the LT predicate on the "answer_id" field.
+func AnswerIDLT(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldAnswerID, v))
+}
+
+// AnswerIDLTE applies the LTE predicate on the "answer_id" field.
+func AnswerIDLTE(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldAnswerID, v))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldRating, v))
+}
+
+// RatingContains applies the Contains predicate on the "rating" field.
+func RatingContains(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContains(FieldRating, v))
+}
+
+// RatingHasPrefix applies the HasPrefix predicate on the "rating" field.
+func RatingHasPrefix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasPrefix(FieldRating, v))
+}
+
+// RatingHasSuffix applies the HasSuffix predicate on the "rating" field.
+func RatingHasSuffix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasSuffix(FieldRating, v))
+}
+
+// RatingEqualFold applies the EqualFold predicate on the "rating" field.
+func RatingEqualFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEqualFold(FieldRating, v))
+}
+
+// RatingContainsFold applies the ContainsFold predicate on the "rating" field.
+func RatingContainsFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContainsFold(FieldRating, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CorrectionEQ applies the EQ predicate on the "correction" field.
+func CorrectionEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldCorrection, v))
+}
+
+// CorrectionNEQ applies the NEQ predicate on the "correction" field.
+func CorrectionNEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldCorrection, v))
+}
+
+// CorrectionIn applies the In predicate on the "correction" field.
+func CorrectionIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldCorrection, vs...))
+}
+
+// CorrectionNotIn applies the NotIn predicate on the "correction" field.
+func CorrectionNotIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldCorrection, vs...))
+}
+
+// CorrectionGT applies the GT predicate on the "correction" field.
+func CorrectionGT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldCorrection, v))
+}
+
+// CorrectionGTE applies the GTE predicate on the "correction" field.
+func CorrectionGTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldCorrection, v))
+}
+
+// CorrectionLT applies the LT predicate on the "correction" field.
+func CorrectionLT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldCorrection, v))
+}
+
+// CorrectionLTE applies the LTE predicate on the "correction" field.
+func CorrectionLTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldCorrection, v))
+}
+
+// CorrectionContains applies the Contains predicate on the "correction" field.
+func CorrectionContains(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContains(FieldCorrection, v))
+}
+
+// CorrectionHasPrefix applies the HasPrefix predicate on the "correction" field.
+func CorrectionHasPrefix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasPrefix(FieldCorrection, v))
+}
+
+// CorrectionHasSuffix applies the HasSuffix predicate on the "correction" field.
+func CorrectionHasSuffix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasSuffix(FieldCorrection, v))
+}
+
+// CorrectionIsNil applies the IsNil predicate on the "correction" field.
+func CorrectionIsNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIsNull(FieldCorrection))
+}
+
+// CorrectionNotNil applies the NotNil predicate on the "correction" field.
+func CorrectionNotNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotNull(FieldCorrection))
+}
+
+// CorrectionEqualFold applies the EqualFold predicate on the "correction" field.
+func CorrectionEqualFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEqualFold(FieldCorrection, v))
+}
+
+// CorrectionContainsFold applies the ContainsFold predicate on the "correction" field.
+func CorrectionContainsFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContainsFold(FieldCorrection, v))
+}
+
+// KnowledgeIdsIsNil applies the IsNil predicate on the "knowledge_ids" field.
+func KnowledgeIdsIsNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIsNull(FieldKnowledgeIds))
+}
+
+// KnowledgeIdsNotNil applies the NotNil predicate on the "knowledge_ids" field.
+func KnowledgeIdsNotNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotNull(FieldKnowledgeIds))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotNull(FieldUserID))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDContains applies the Contains predicate on the "api_key_id" field.
+func APIKeyIDContains(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContains(FieldAPIKeyID, v))
+}
+
+// APIKeyIDHasPrefix applies the HasPrefix predicate on the "api_key_id" field.
+func APIKeyIDHasPrefix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasPrefix(FieldAPIKeyID, v))
+}
+
+// APIKeyIDHasSuffix applies the HasSuffix predicate on the "api_key_id" field.
+func APIKeyIDHasSuffix(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldHasSuffix(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIsNil applies the IsNil predicate on the "api_key_id" field.
+func APIKeyIDIsNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIsNull(FieldAPIKeyID))
+}
+
+// APIKeyIDNotNil applies the NotNil predicate on the "api_key_id" field.
+func APIKeyIDNotNil() predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotNull(FieldAPIKeyID))
+}
+
+// APIKeyIDEqualFold applies the EqualFold predicate on the "api_key_id" field.
+func APIKeyIDEqualFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEqualFold(FieldAPIKeyID, v))
+}
+
+// APIKeyIDContainsFold applies the ContainsFold predicate on the "api_key_id" field.
+func APIKeyIDContainsFold(v string) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldContainsFold(FieldAPIKeyID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnswerFeedback) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnswerFeedback) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnswerFeedback) predicate.AnswerFeedback {
+	return predicate.AnswerFeedback(sql.NotPredicates(p))
+}
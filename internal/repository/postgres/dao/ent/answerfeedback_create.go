@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+)
+
+// AnswerFeedbackCreate is the builder for creating a AnswerFeedback entity.
+type AnswerFeedbackCreate struct {
+	config
+	mutation *AnswerFeedbackMutation
+	hooks    []Hook
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (_c *AnswerFeedbackCreate) SetAnswerID(v int) *AnswerFeedbackCreate {
+	_c.mutation.SetAnswerID(v)
+	return _c
+}
+
+// SetRating sets the "rating" field.
+func (_c *AnswerFeedbackCreate) SetRating(v string) *AnswerFeedbackCreate {
+	_c.mutation.SetRating(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AnswerFeedbackCreate) SetReason(v string) *AnswerFeedbackCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AnswerFeedbackCreate) SetNillableReason(v *string) *AnswerFeedbackCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCorrection sets the "correction" field.
+func (_c *AnswerFeedbackCreate) SetCorrection(v string) *AnswerFeedbackCreate {
+	_c.mutation.SetCorrection(v)
+	return _c
+}
+
+// SetNillableCorrection sets the "correction" field if the given value is not nil.
+func (_c *AnswerFeedbackCreate) SetNillableCorrection(v *string) *AnswerFeedbackCreate {
+	if v != nil {
+		_c.SetCorrection(*v)
+	}
+	return _c
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_c *AnswerFeedbackCreate) SetKnowledgeIds(v []int) *AnswerFeedbackCreate {
+	_c.mutation.SetKnowledgeIds(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AnswerFeedbackCreate) SetUserID(v int) *AnswerFeedbackCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *AnswerFeedbackCreate) SetNillableUserID(v *int) *AnswerFeedbackCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *AnswerFeedbackCreate) SetAPIKeyID(v string) *AnswerFeedbackCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *AnswerFeedbackCreate) SetNillableAPIKeyID(v *string) *AnswerFeedbackCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AnswerFeedbackCreate) SetCreatedAt(v time.Time) *AnswerFeedbackCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AnswerFeedbackCreate) SetNillableCreatedAt(v *time.Time) *AnswerFeedbackCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnswerFeedbackCreate) SetID(v int) *AnswerFeedbackCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AnswerFeedbackMutation object of the builder.
+func (_c *AnswerFeedbackCreate) Mutation() *AnswerFeedbackMutation {
+	return _c.mutation
+}
+
+// Save creates the AnswerFeedback in the database.
+func (_c *AnswerFeedbackCreate) Save(ctx context.Context) (*AnswerFeedback, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnswerFeedbackCreate) SaveX(ctx context.Context) *AnswerFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnswerFeedbackCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnswerFeedbackCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnswerFeedbackCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := answerfeedback.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnswerFeedbackCreate) check() error {
+	if _, ok := _c.mutation.AnswerID(); !ok {
+		return &ValidationError{Name: "answer_id", err: errors.New(`ent: missing required field "AnswerFeedback.answer_id"`)}
+	}
+	if _, ok := _c.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "AnswerFeedback.rating"`)}
+	}
+	if v, ok := _c.mutation.Rating(); ok {
+		if err := answerfeedback.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "AnswerFeedback.rating": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AnswerFeedback.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AnswerFeedbackCreate) sqlSave(ctx context.Context) (*AnswerFeedback, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnswerFeedbackCreate) createSpec() (*AnswerFeedback, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnswerFeedback{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(answerfeedback.Table, sqlgraph.NewFieldSpec(answerfeedback.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.AnswerID(); ok {
+		_spec.SetField(answerfeedback.FieldAnswerID, field.TypeInt, value)
+		_node.AnswerID = value
+	}
+	if value, ok := _c.mutation.Rating(); ok {
+		_spec.SetField(answerfeedback.FieldRating, field.TypeString, value)
+		_node.Rating = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(answerfeedback.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Correction(); ok {
+		_spec.SetField(answerfeedback.FieldCorrection, field.TypeString, value)
+		_node.Correction = value
+	}
+	if value, ok := _c.mutation.KnowledgeIds(); ok {
+		_spec.SetField(answerfeedback.FieldKnowledgeIds, field.TypeJSON, value)
+		_node.KnowledgeIds = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(answerfeedback.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(answerfeedback.FieldAPIKeyID, field.TypeString, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(answerfeedback.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AnswerFeedbackCreateBulk is the builder for creating many AnswerFeedback entities in bulk.
+type AnswerFeedbackCreateBulk struct {
+	config
+	err      error
+	builders []*AnswerFeedbackCreate
+}
+
+// Save creates the AnswerFeedback entities in the database.
+func (_c *AnswerFeedbackCreateBulk) Save(ctx context.Context) ([]*AnswerFeedback, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnswerFeedback, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnswerFeedbackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnswerFeedbackCreateBulk) SaveX(ctx context.Context) []*AnswerFeedback {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnswerFeedbackCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnswerFeedbackCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
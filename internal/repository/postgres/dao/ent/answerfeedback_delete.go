@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// AnswerFeedbackDelete is the builder for deleting a AnswerFeedback entity.
+type AnswerFeedbackDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnswerFeedbackMutation
+}
+
+// Where appends a list predicates to the AnswerFeedbackDelete builder.
+func (_d *AnswerFeedbackDelete) Where(ps ...predicate.AnswerFeedback) *AnswerFeedbackDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnswerFeedbackDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnswerFeedbackDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnswerFeedbackDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(answerfeedback.Table, sqlgraph.NewFieldSpec(answerfeedback.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnswerFeedbackDeleteOne is the builder for deleting a single AnswerFeedback entity.
+type AnswerFeedbackDeleteOne struct {
+	_d *AnswerFeedbackDelete
+}
+
+// Where appends a list predicates to the AnswerFeedbackDelete builder.
+func (_d *AnswerFeedbackDeleteOne) Where(ps ...predicate.AnswerFeedback) *AnswerFeedbackDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnswerFeedbackDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{answerfeedback.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnswerFeedbackDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by ent, DO NOT EDIT.
 
-package dao
+package ent
 
 import (
 	"context"
@@ -11,68 +11,68 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/inquiryknowledge"
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/predicate"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
 )
 
-// InquiryKnowledgeQuery is the builder for querying InquiryKnowledge entities.
-type InquiryKnowledgeQuery struct {
+// AnswerFeedbackQuery is the builder for querying AnswerFeedback entities.
+type AnswerFeedbackQuery struct {
 	config
 	ctx        *QueryContext
-	order      []inquiryknowledge.OrderOption
+	order      []answerfeedback.OrderOption
 	inters     []Interceptor
-	predicates []predicate.InquiryKnowledge
+	predicates []predicate.AnswerFeedback
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
 }
 
-// Where adds a new predicate for the InquiryKnowledgeQuery builder.
-func (_q *InquiryKnowledgeQuery) Where(ps ...predicate.InquiryKnowledge) *InquiryKnowledgeQuery {
+// Where adds a new predicate for the AnswerFeedbackQuery builder.
+func (_q *AnswerFeedbackQuery) Where(ps ...predicate.AnswerFeedback) *AnswerFeedbackQuery {
 	_q.predicates = append(_q.predicates, ps...)
 	return _q
 }
 
 // Limit the number of records to be returned by this query.
-func (_q *InquiryKnowledgeQuery) Limit(limit int) *InquiryKnowledgeQuery {
+func (_q *AnswerFeedbackQuery) Limit(limit int) *AnswerFeedbackQuery {
 	_q.ctx.Limit = &limit
 	return _q
 }
 
 // Offset to start from.
-func (_q *InquiryKnowledgeQuery) Offset(offset int) *InquiryKnowledgeQuery {
+func (_q *AnswerFeedbackQuery) Offset(offset int) *AnswerFeedbackQuery {
 	_q.ctx.Offset = &offset
 	return _q
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
-func (_q *InquiryKnowledgeQuery) Unique(unique bool) *InquiryKnowledgeQuery {
+func (_q *AnswerFeedbackQuery) Unique(unique bool) *AnswerFeedbackQuery {
 	_q.ctx.Unique = &unique
 	return _q
 }
 
 // Order specifies how the records should be ordered.
-func (_q *InquiryKnowledgeQuery) Order(o ...inquiryknowledge.OrderOption) *InquiryKnowledgeQuery {
+func (_q *AnswerFeedbackQuery) Order(o ...answerfeedback.OrderOption) *AnswerFeedbackQuery {
 	_q.order = append(_q.order, o...)
 	return _q
 }
 
-// First returns the first InquiryKnowledge entity from the query.
-// Returns a *NotFoundError when no InquiryKnowledge was found.
-func (_q *InquiryKnowledgeQuery) First(ctx context.Context) (*InquiryKnowledge, error) {
+// First returns the first AnswerFeedback entity from the query.
+// Returns a *NotFoundError when no AnswerFeedback was found.
+func (_q *AnswerFeedbackQuery) First(ctx context.Context) (*AnswerFeedback, error) {
 	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
-		return nil, &NotFoundError{inquiryknowledge.Label}
+		return nil, &NotFoundError{answerfeedback.Label}
 	}
 	return nodes[0], nil
 }
 
 // FirstX is like First, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) FirstX(ctx context.Context) *InquiryKnowledge {
+func (_q *AnswerFeedbackQuery) FirstX(ctx context.Context) *AnswerFeedback {
 	node, err := _q.First(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
@@ -80,22 +80,22 @@ func (_q *InquiryKnowledgeQuery) FirstX(ctx context.Context) *InquiryKnowledge {
 	return node
 }
 
-// FirstID returns the first InquiryKnowledge ID from the query.
-// Returns a *NotFoundError when no InquiryKnowledge ID was found.
-func (_q *InquiryKnowledgeQuery) FirstID(ctx context.Context) (id int, err error) {
+// FirstID returns the first AnswerFeedback ID from the query.
+// Returns a *NotFoundError when no AnswerFeedback ID was found.
+func (_q *AnswerFeedbackQuery) FirstID(ctx context.Context) (id int, err error) {
 	var ids []int
 	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
-		err = &NotFoundError{inquiryknowledge.Label}
+		err = &NotFoundError{answerfeedback.Label}
 		return
 	}
 	return ids[0], nil
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) FirstIDX(ctx context.Context) int {
+func (_q *AnswerFeedbackQuery) FirstIDX(ctx context.Context) int {
 	id, err := _q.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
@@ -103,10 +103,10 @@ func (_q *InquiryKnowledgeQuery) FirstIDX(ctx context.Context) int {
 	return id
 }
 
-// Only returns a single InquiryKnowledge entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one InquiryKnowledge entity is found.
-// Returns a *NotFoundError when no InquiryKnowledge entities are found.
-func (_q *InquiryKnowledgeQuery) Only(ctx context.Context) (*InquiryKnowledge, error) {
+// Only returns a single AnswerFeedback entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AnswerFeedback entity is found.
+// Returns a *NotFoundError when no AnswerFeedback entities are found.
+func (_q *AnswerFeedbackQuery) Only(ctx context.Context) (*AnswerFeedback, error) {
 	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
@@ -115,14 +115,14 @@ func (_q *InquiryKnowledgeQuery) Only(ctx context.Context) (*InquiryKnowledge, e
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{inquiryknowledge.Label}
+		return nil, &NotFoundError{answerfeedback.Label}
 	default:
-		return nil, &NotSingularError{inquiryknowledge.Label}
+		return nil, &NotSingularError{answerfeedback.Label}
 	}
 }
 
 // OnlyX is like Only, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) OnlyX(ctx context.Context) *InquiryKnowledge {
+func (_q *AnswerFeedbackQuery) OnlyX(ctx context.Context) *AnswerFeedback {
 	node, err := _q.Only(ctx)
 	if err != nil {
 		panic(err)
@@ -130,10 +130,10 @@ func (_q *InquiryKnowledgeQuery) OnlyX(ctx context.Context) *InquiryKnowledge {
 	return node
 }
 
-// OnlyID is like Only, but returns the only InquiryKnowledge ID in the query.
-// Returns a *NotSingularError when more than one InquiryKnowledge ID is found.
+// OnlyID is like Only, but returns the only AnswerFeedback ID in the query.
+// Returns a *NotSingularError when more than one AnswerFeedback ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (_q *InquiryKnowledgeQuery) OnlyID(ctx context.Context) (id int, err error) {
+func (_q *AnswerFeedbackQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
 	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
@@ -142,15 +142,15 @@ func (_q *InquiryKnowledgeQuery) OnlyID(ctx context.Context) (id int, err error)
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{inquiryknowledge.Label}
+		err = &NotFoundError{answerfeedback.Label}
 	default:
-		err = &NotSingularError{inquiryknowledge.Label}
+		err = &NotSingularError{answerfeedback.Label}
 	}
 	return
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) OnlyIDX(ctx context.Context) int {
+func (_q *AnswerFeedbackQuery) OnlyIDX(ctx context.Context) int {
 	id, err := _q.OnlyID(ctx)
 	if err != nil {
 		panic(err)
@@ -158,18 +158,18 @@ func (_q *InquiryKnowledgeQuery) OnlyIDX(ctx context.Context) int {
 	return id
 }
 
-// All executes the query and returns a list of InquiryKnowledges.
-func (_q *InquiryKnowledgeQuery) All(ctx context.Context) ([]*InquiryKnowledge, error) {
+// All executes the query and returns a list of AnswerFeedbacks.
+func (_q *AnswerFeedbackQuery) All(ctx context.Context) ([]*AnswerFeedback, error) {
 	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
 	if err := _q.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	qr := querierAll[[]*InquiryKnowledge, *InquiryKnowledgeQuery]()
-	return withInterceptors[[]*InquiryKnowledge](ctx, _q, qr, _q.inters)
+	qr := querierAll[[]*AnswerFeedback, *AnswerFeedbackQuery]()
+	return withInterceptors[[]*AnswerFeedback](ctx, _q, qr, _q.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) AllX(ctx context.Context) []*InquiryKnowledge {
+func (_q *AnswerFeedbackQuery) AllX(ctx context.Context) []*AnswerFeedback {
 	nodes, err := _q.All(ctx)
 	if err != nil {
 		panic(err)
@@ -177,20 +177,20 @@ func (_q *InquiryKnowledgeQuery) AllX(ctx context.Context) []*InquiryKnowledge {
 	return nodes
 }
 
-// IDs executes the query and returns a list of InquiryKnowledge IDs.
-func (_q *InquiryKnowledgeQuery) IDs(ctx context.Context) (ids []int, err error) {
+// IDs executes the query and returns a list of AnswerFeedback IDs.
+func (_q *AnswerFeedbackQuery) IDs(ctx context.Context) (ids []int, err error) {
 	if _q.ctx.Unique == nil && _q.path != nil {
 		_q.Unique(true)
 	}
 	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
-	if err = _q.Select(inquiryknowledge.FieldID).Scan(ctx, &ids); err != nil {
+	if err = _q.Select(answerfeedback.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) IDsX(ctx context.Context) []int {
+func (_q *AnswerFeedbackQuery) IDsX(ctx context.Context) []int {
 	ids, err := _q.IDs(ctx)
 	if err != nil {
 		panic(err)
@@ -199,16 +199,16 @@ func (_q *InquiryKnowledgeQuery) IDsX(ctx context.Context) []int {
 }
 
 // Count returns the count of the given query.
-func (_q *InquiryKnowledgeQuery) Count(ctx context.Context) (int, error) {
+func (_q *AnswerFeedbackQuery) Count(ctx context.Context) (int, error) {
 	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
 	if err := _q.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return withInterceptors[int](ctx, _q, querierCount[*InquiryKnowledgeQuery](), _q.inters)
+	return withInterceptors[int](ctx, _q, querierCount[*AnswerFeedbackQuery](), _q.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) CountX(ctx context.Context) int {
+func (_q *AnswerFeedbackQuery) CountX(ctx context.Context) int {
 	count, err := _q.Count(ctx)
 	if err != nil {
 		panic(err)
@@ -217,20 +217,20 @@ func (_q *InquiryKnowledgeQuery) CountX(ctx context.Context) int {
 }
 
 // Exist returns true if the query has elements in the graph.
-func (_q *InquiryKnowledgeQuery) Exist(ctx context.Context) (bool, error) {
+func (_q *AnswerFeedbackQuery) Exist(ctx context.Context) (bool, error) {
 	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
 	switch _, err := _q.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
-		return false, fmt.Errorf("dao: check existence: %w", err)
+		return false, fmt.Errorf("ent: check existence: %w", err)
 	default:
 		return true, nil
 	}
 }
 
 // ExistX is like Exist, but panics if an error occurs.
-func (_q *InquiryKnowledgeQuery) ExistX(ctx context.Context) bool {
+func (_q *AnswerFeedbackQuery) ExistX(ctx context.Context) bool {
 	exist, err := _q.Exist(ctx)
 	if err != nil {
 		panic(err)
@@ -238,18 +238,18 @@ func (_q *InquiryKnowledgeQuery) ExistX(ctx context.Context) bool {
 	return exist
 }
 
-// Clone returns a duplicate of the InquiryKnowledgeQuery builder, including all associated steps. It can be
+// Clone returns a duplicate of the AnswerFeedbackQuery builder, including all associated steps. It can be
 // used to prepare common query builders and use them differently after the clone is made.
-func (_q *InquiryKnowledgeQuery) Clone() *InquiryKnowledgeQuery {
+func (_q *AnswerFeedbackQuery) Clone() *AnswerFeedbackQuery {
 	if _q == nil {
 		return nil
 	}
-	return &InquiryKnowledgeQuery{
+	return &AnswerFeedbackQuery{
 		config:     _q.config,
 		ctx:        _q.ctx.Clone(),
-		order:      append([]inquiryknowledge.OrderOption{}, _q.order...),
+		order:      append([]answerfeedback.OrderOption{}, _q.order...),
 		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.InquiryKnowledge{}, _q.predicates...),
+		predicates: append([]predicate.AnswerFeedback{}, _q.predicates...),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -262,19 +262,19 @@ func (_q *InquiryKnowledgeQuery) Clone() *InquiryKnowledgeQuery {
 // Example:
 //
 //	var v []struct {
-//		Instruction string `json:"instruction,omitempty"`
+//		AnswerID int `json:"answer_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
-//	client.InquiryKnowledge.Query().
-//		GroupBy(inquiryknowledge.FieldInstruction).
-//		Aggregate(dao.Count()).
+//	client.AnswerFeedback.Query().
+//		GroupBy(answerfeedback.FieldAnswerID).
+//		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-func (_q *InquiryKnowledgeQuery) GroupBy(field string, fields ...string) *InquiryKnowledgeGroupBy {
+func (_q *AnswerFeedbackQuery) GroupBy(field string, fields ...string) *AnswerFeedbackGroupBy {
 	_q.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &InquiryKnowledgeGroupBy{build: _q}
+	grbuild := &AnswerFeedbackGroupBy{build: _q}
 	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = inquiryknowledge.Label
+	grbuild.label = answerfeedback.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
 }
@@ -285,29 +285,29 @@ func (_q *InquiryKnowledgeQuery) GroupBy(field string, fields ...string) *Inquir
 // Example:
 //
 //	var v []struct {
-//		Instruction string `json:"instruction,omitempty"`
+//		AnswerID int `json:"answer_id,omitempty"`
 //	}
 //
-//	client.InquiryKnowledge.Query().
-//		Select(inquiryknowledge.FieldInstruction).
+//	client.AnswerFeedback.Query().
+//		Select(answerfeedback.FieldAnswerID).
 //		Scan(ctx, &v)
-func (_q *InquiryKnowledgeQuery) Select(fields ...string) *InquiryKnowledgeSelect {
+func (_q *AnswerFeedbackQuery) Select(fields ...string) *AnswerFeedbackSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
-	sbuild := &InquiryKnowledgeSelect{InquiryKnowledgeQuery: _q}
-	sbuild.label = inquiryknowledge.Label
+	sbuild := &AnswerFeedbackSelect{AnswerFeedbackQuery: _q}
+	sbuild.label = answerfeedback.Label
 	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
 
-// Aggregate returns a InquiryKnowledgeSelect configured with the given aggregations.
-func (_q *InquiryKnowledgeQuery) Aggregate(fns ...AggregateFunc) *InquiryKnowledgeSelect {
+// Aggregate returns a AnswerFeedbackSelect configured with the given aggregations.
+func (_q *AnswerFeedbackQuery) Aggregate(fns ...AggregateFunc) *AnswerFeedbackSelect {
 	return _q.Select().Aggregate(fns...)
 }
 
-func (_q *InquiryKnowledgeQuery) prepareQuery(ctx context.Context) error {
+func (_q *AnswerFeedbackQuery) prepareQuery(ctx context.Context) error {
 	for _, inter := range _q.inters {
 		if inter == nil {
-			return fmt.Errorf("dao: uninitialized interceptor (forgotten import dao/runtime?)")
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
 		if trv, ok := inter.(Traverser); ok {
 			if err := trv.Traverse(ctx, _q); err != nil {
@@ -316,8 +316,8 @@ func (_q *InquiryKnowledgeQuery) prepareQuery(ctx context.Context) error {
 		}
 	}
 	for _, f := range _q.ctx.Fields {
-		if !inquiryknowledge.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("dao: invalid field %q for query", f)}
+		if !answerfeedback.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
 	if _q.path != nil {
@@ -330,16 +330,16 @@ func (_q *InquiryKnowledgeQuery) prepareQuery(ctx context.Context) error {
 	return nil
 }
 
-func (_q *InquiryKnowledgeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InquiryKnowledge, error) {
+func (_q *AnswerFeedbackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AnswerFeedback, error) {
 	var (
-		nodes = []*InquiryKnowledge{}
+		nodes = []*AnswerFeedback{}
 		_spec = _q.querySpec()
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*InquiryKnowledge).scanValues(nil, columns)
+		return (*AnswerFeedback).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
-		node := &InquiryKnowledge{config: _q.config}
+		node := &AnswerFeedback{config: _q.config}
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
@@ -355,7 +355,7 @@ func (_q *InquiryKnowledgeQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	return nodes, nil
 }
 
-func (_q *InquiryKnowledgeQuery) sqlCount(ctx context.Context) (int, error) {
+func (_q *AnswerFeedbackQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
@@ -364,8 +364,8 @@ func (_q *InquiryKnowledgeQuery) sqlCount(ctx context.Context) (int, error) {
 	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
 
-func (_q *InquiryKnowledgeQuery) querySpec() *sqlgraph.QuerySpec {
-	_spec := sqlgraph.NewQuerySpec(inquiryknowledge.Table, inquiryknowledge.Columns, sqlgraph.NewFieldSpec(inquiryknowledge.FieldID, field.TypeInt))
+func (_q *AnswerFeedbackQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(answerfeedback.Table, answerfeedback.Columns, sqlgraph.NewFieldSpec(answerfeedback.FieldID, field.TypeInt))
 	_spec.From = _q.sql
 	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
@@ -374,9 +374,9 @@ func (_q *InquiryKnowledgeQuery) querySpec() *sqlgraph.QuerySpec {
 	}
 	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, inquiryknowledge.FieldID)
+		_spec.Node.Columns = append(_spec.Node.Columns, answerfeedback.FieldID)
 		for i := range fields {
-			if fields[i] != inquiryknowledge.FieldID {
+			if fields[i] != answerfeedback.FieldID {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
@@ -404,12 +404,12 @@ func (_q *InquiryKnowledgeQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (_q *InquiryKnowledgeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+func (_q *AnswerFeedbackQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(_q.driver.Dialect())
-	t1 := builder.Table(inquiryknowledge.Table)
+	t1 := builder.Table(answerfeedback.Table)
 	columns := _q.ctx.Fields
 	if len(columns) == 0 {
-		columns = inquiryknowledge.Columns
+		columns = answerfeedback.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
 	if _q.sql != nil {
@@ -436,28 +436,28 @@ func (_q *InquiryKnowledgeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
-// InquiryKnowledgeGroupBy is the group-by builder for InquiryKnowledge entities.
-type InquiryKnowledgeGroupBy struct {
+// AnswerFeedbackGroupBy is the group-by builder for AnswerFeedback entities.
+type AnswerFeedbackGroupBy struct {
 	selector
-	build *InquiryKnowledgeQuery
+	build *AnswerFeedbackQuery
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
-func (_g *InquiryKnowledgeGroupBy) Aggregate(fns ...AggregateFunc) *InquiryKnowledgeGroupBy {
+func (_g *AnswerFeedbackGroupBy) Aggregate(fns ...AggregateFunc) *AnswerFeedbackGroupBy {
 	_g.fns = append(_g.fns, fns...)
 	return _g
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (_g *InquiryKnowledgeGroupBy) Scan(ctx context.Context, v any) error {
+func (_g *AnswerFeedbackGroupBy) Scan(ctx context.Context, v any) error {
 	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
 	if err := _g.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*InquiryKnowledgeQuery, *InquiryKnowledgeGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+	return scanWithInterceptors[*AnswerFeedbackQuery, *AnswerFeedbackGroupBy](ctx, _g.build, _g, _g.build.inters, v)
 }
 
-func (_g *InquiryKnowledgeGroupBy) sqlScan(ctx context.Context, root *InquiryKnowledgeQuery, v any) error {
+func (_g *AnswerFeedbackGroupBy) sqlScan(ctx context.Context, root *AnswerFeedbackQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
 	aggregation := make([]string, 0, len(_g.fns))
 	for _, fn := range _g.fns {
@@ -484,28 +484,28 @@ func (_g *InquiryKnowledgeGroupBy) sqlScan(ctx context.Context, root *InquiryKno
 	return sql.ScanSlice(rows, v)
 }
 
-// InquiryKnowledgeSelect is the builder for selecting fields of InquiryKnowledge entities.
-type InquiryKnowledgeSelect struct {
-	*InquiryKnowledgeQuery
+// AnswerFeedbackSelect is the builder for selecting fields of AnswerFeedback entities.
+type AnswerFeedbackSelect struct {
+	*AnswerFeedbackQuery
 	selector
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
-func (_s *InquiryKnowledgeSelect) Aggregate(fns ...AggregateFunc) *InquiryKnowledgeSelect {
+func (_s *AnswerFeedbackSelect) Aggregate(fns ...AggregateFunc) *AnswerFeedbackSelect {
 	_s.fns = append(_s.fns, fns...)
 	return _s
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (_s *InquiryKnowledgeSelect) Scan(ctx context.Context, v any) error {
+func (_s *AnswerFeedbackSelect) Scan(ctx context.Context, v any) error {
 	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
 	if err := _s.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*InquiryKnowledgeQuery, *InquiryKnowledgeSelect](ctx, _s.InquiryKnowledgeQuery, _s, _s.inters, v)
+	return scanWithInterceptors[*AnswerFeedbackQuery, *AnswerFeedbackSelect](ctx, _s.AnswerFeedbackQuery, _s, _s.inters, v)
 }
 
-func (_s *InquiryKnowledgeSelect) sqlScan(ctx context.Context, root *InquiryKnowledgeQuery, v any) error {
+func (_s *AnswerFeedbackSelect) sqlScan(ctx context.Context, root *AnswerFeedbackQuery, v any) error {
 	selector := root.sqlQuery(ctx)
 	aggregation := make([]string, 0, len(_s.fns))
 	for _, fn := range _s.fns {
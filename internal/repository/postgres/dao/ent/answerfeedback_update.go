@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// AnswerFeedbackUpdate is the builder for updating AnswerFeedback entities.
+type AnswerFeedbackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnswerFeedbackMutation
+}
+
+// Where appends a list predicates to the AnswerFeedbackUpdate builder.
+func (_u *AnswerFeedbackUpdate) Where(ps ...predicate.AnswerFeedback) *AnswerFeedbackUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (_u *AnswerFeedbackUpdate) SetAnswerID(v int) *AnswerFeedbackUpdate {
+	_u.mutation.ResetAnswerID()
+	_u.mutation.SetAnswerID(v)
+	return _u
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableAnswerID(v *int) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetAnswerID(*v)
+	}
+	return _u
+}
+
+// AddAnswerID adds value to the "answer_id" field.
+func (_u *AnswerFeedbackUpdate) AddAnswerID(v int) *AnswerFeedbackUpdate {
+	_u.mutation.AddAnswerID(v)
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *AnswerFeedbackUpdate) SetRating(v string) *AnswerFeedbackUpdate {
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableRating(v *string) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AnswerFeedbackUpdate) SetReason(v string) *AnswerFeedbackUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableReason(v *string) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AnswerFeedbackUpdate) ClearReason() *AnswerFeedbackUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetCorrection sets the "correction" field.
+func (_u *AnswerFeedbackUpdate) SetCorrection(v string) *AnswerFeedbackUpdate {
+	_u.mutation.SetCorrection(v)
+	return _u
+}
+
+// SetNillableCorrection sets the "correction" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableCorrection(v *string) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetCorrection(*v)
+	}
+	return _u
+}
+
+// ClearCorrection clears the value of the "correction" field.
+func (_u *AnswerFeedbackUpdate) ClearCorrection() *AnswerFeedbackUpdate {
+	_u.mutation.ClearCorrection()
+	return _u
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdate) SetKnowledgeIds(v []int) *AnswerFeedbackUpdate {
+	_u.mutation.SetKnowledgeIds(v)
+	return _u
+}
+
+// AppendKnowledgeIds appends value to the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdate) AppendKnowledgeIds(v []int) *AnswerFeedbackUpdate {
+	_u.mutation.AppendKnowledgeIds(v)
+	return _u
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdate) ClearKnowledgeIds() *AnswerFeedbackUpdate {
+	_u.mutation.ClearKnowledgeIds()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AnswerFeedbackUpdate) SetUserID(v int) *AnswerFeedbackUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableUserID(v *int) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AnswerFeedbackUpdate) AddUserID(v int) *AnswerFeedbackUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AnswerFeedbackUpdate) ClearUserID() *AnswerFeedbackUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *AnswerFeedbackUpdate) SetAPIKeyID(v string) *AnswerFeedbackUpdate {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdate) SetNillableAPIKeyID(v *string) *AnswerFeedbackUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *AnswerFeedbackUpdate) ClearAPIKeyID() *AnswerFeedbackUpdate {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// Mutation returns the AnswerFeedbackMutation object of the builder.
+func (_u *AnswerFeedbackUpdate) Mutation() *AnswerFeedbackMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnswerFeedbackUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnswerFeedbackUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnswerFeedbackUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnswerFeedbackUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnswerFeedbackUpdate) check() error {
+	if v, ok := _u.mutation.Rating(); ok {
+		if err := answerfeedback.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "AnswerFeedback.rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AnswerFeedbackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answerfeedback.Table, answerfeedback.Columns, sqlgraph.NewFieldSpec(answerfeedback.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AnswerID(); ok {
+		_spec.SetField(answerfeedback.FieldAnswerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerID(); ok {
+		_spec.AddField(answerfeedback.FieldAnswerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(answerfeedback.FieldRating, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(answerfeedback.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(answerfeedback.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Correction(); ok {
+		_spec.SetField(answerfeedback.FieldCorrection, field.TypeString, value)
+	}
+	if _u.mutation.CorrectionCleared() {
+		_spec.ClearField(answerfeedback.FieldCorrection, field.TypeString)
+	}
+	if value, ok := _u.mutation.KnowledgeIds(); ok {
+		_spec.SetField(answerfeedback.FieldKnowledgeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKnowledgeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, answerfeedback.FieldKnowledgeIds, value)
+		})
+	}
+	if _u.mutation.KnowledgeIdsCleared() {
+		_spec.ClearField(answerfeedback.FieldKnowledgeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(answerfeedback.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(answerfeedback.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(answerfeedback.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(answerfeedback.FieldAPIKeyID, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(answerfeedback.FieldAPIKeyID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answerfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnswerFeedbackUpdateOne is the builder for updating a single AnswerFeedback entity.
+type AnswerFeedbackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnswerFeedbackMutation
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (_u *AnswerFeedbackUpdateOne) SetAnswerID(v int) *AnswerFeedbackUpdateOne {
+	_u.mutation.ResetAnswerID()
+	_u.mutation.SetAnswerID(v)
+	return _u
+}
+
+// SetNillableAnswerID sets the "answer_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableAnswerID(v *int) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetAnswerID(*v)
+	}
+	return _u
+}
+
+// AddAnswerID adds value to the "answer_id" field.
+func (_u *AnswerFeedbackUpdateOne) AddAnswerID(v int) *AnswerFeedbackUpdateOne {
+	_u.mutation.AddAnswerID(v)
+	return _u
+}
+
+// SetRating sets the "rating" field.
+func (_u *AnswerFeedbackUpdateOne) SetRating(v string) *AnswerFeedbackUpdateOne {
+	_u.mutation.SetRating(v)
+	return _u
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableRating(v *string) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetRating(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *AnswerFeedbackUpdateOne) SetReason(v string) *AnswerFeedbackUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableReason(v *string) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *AnswerFeedbackUpdateOne) ClearReason() *AnswerFeedbackUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetCorrection sets the "correction" field.
+func (_u *AnswerFeedbackUpdateOne) SetCorrection(v string) *AnswerFeedbackUpdateOne {
+	_u.mutation.SetCorrection(v)
+	return _u
+}
+
+// SetNillableCorrection sets the "correction" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableCorrection(v *string) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetCorrection(*v)
+	}
+	return _u
+}
+
+// ClearCorrection clears the value of the "correction" field.
+func (_u *AnswerFeedbackUpdateOne) ClearCorrection() *AnswerFeedbackUpdateOne {
+	_u.mutation.ClearCorrection()
+	return _u
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdateOne) SetKnowledgeIds(v []int) *AnswerFeedbackUpdateOne {
+	_u.mutation.SetKnowledgeIds(v)
+	return _u
+}
+
+// AppendKnowledgeIds appends value to the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdateOne) AppendKnowledgeIds(v []int) *AnswerFeedbackUpdateOne {
+	_u.mutation.AppendKnowledgeIds(v)
+	return _u
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (_u *AnswerFeedbackUpdateOne) ClearKnowledgeIds() *AnswerFeedbackUpdateOne {
+	_u.mutation.ClearKnowledgeIds()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AnswerFeedbackUpdateOne) SetUserID(v int) *AnswerFeedbackUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableUserID(v *int) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AnswerFeedbackUpdateOne) AddUserID(v int) *AnswerFeedbackUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AnswerFeedbackUpdateOne) ClearUserID() *AnswerFeedbackUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *AnswerFeedbackUpdateOne) SetAPIKeyID(v string) *AnswerFeedbackUpdateOne {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *AnswerFeedbackUpdateOne) SetNillableAPIKeyID(v *string) *AnswerFeedbackUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *AnswerFeedbackUpdateOne) ClearAPIKeyID() *AnswerFeedbackUpdateOne {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// Mutation returns the AnswerFeedbackMutation object of the builder.
+func (_u *AnswerFeedbackUpdateOne) Mutation() *AnswerFeedbackMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AnswerFeedbackUpdate builder.
+func (_u *AnswerFeedbackUpdateOne) Where(ps ...predicate.AnswerFeedback) *AnswerFeedbackUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnswerFeedbackUpdateOne) Select(field string, fields ...string) *AnswerFeedbackUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnswerFeedback entity.
+func (_u *AnswerFeedbackUpdateOne) Save(ctx context.Context) (*AnswerFeedback, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnswerFeedbackUpdateOne) SaveX(ctx context.Context) *AnswerFeedback {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnswerFeedbackUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnswerFeedbackUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnswerFeedbackUpdateOne) check() error {
+	if v, ok := _u.mutation.Rating(); ok {
+		if err := answerfeedback.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "AnswerFeedback.rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AnswerFeedbackUpdateOne) sqlSave(ctx context.Context) (_node *AnswerFeedback, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(answerfeedback.Table, answerfeedback.Columns, sqlgraph.NewFieldSpec(answerfeedback.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnswerFeedback.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, answerfeedback.FieldID)
+		for _, f := range fields {
+			if !answerfeedback.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != answerfeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AnswerID(); ok {
+		_spec.SetField(answerfeedback.FieldAnswerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerID(); ok {
+		_spec.AddField(answerfeedback.FieldAnswerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Rating(); ok {
+		_spec.SetField(answerfeedback.FieldRating, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(answerfeedback.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(answerfeedback.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Correction(); ok {
+		_spec.SetField(answerfeedback.FieldCorrection, field.TypeString, value)
+	}
+	if _u.mutation.CorrectionCleared() {
+		_spec.ClearField(answerfeedback.FieldCorrection, field.TypeString)
+	}
+	if value, ok := _u.mutation.KnowledgeIds(); ok {
+		_spec.SetField(answerfeedback.FieldKnowledgeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKnowledgeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, answerfeedback.FieldKnowledgeIds, value)
+		})
+	}
+	if _u.mutation.KnowledgeIdsCleared() {
+		_spec.ClearField(answerfeedback.FieldKnowledgeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(answerfeedback.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(answerfeedback.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(answerfeedback.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(answerfeedback.FieldAPIKeyID, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(answerfeedback.FieldAPIKeyID, field.TypeString)
+	}
+	_node = &AnswerFeedback{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{answerfeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+)
+
+// AuditRecord is the model entity for the AuditRecord schema.
+type AuditRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Trid holds the value of the "trid" field.
+	Trid string `json:"trid,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// APIKeyID holds the value of the "api_key_id" field.
+	APIKeyID string `json:"api_key_id,omitempty"`
+	// Question holds the value of the "question" field.
+	Question string `json:"question,omitempty"`
+	// QuestionEmbedding holds the value of the "question_embedding" field.
+	QuestionEmbedding pgvector.Vector `json:"question_embedding,omitempty"`
+	// Intent holds the value of the "intent" field.
+	Intent string `json:"intent,omitempty"`
+	// KnowledgeIds holds the value of the "knowledge_ids" field.
+	KnowledgeIds []int `json:"knowledge_ids,omitempty"`
+	// SimilarityScores holds the value of the "similarity_scores" field.
+	SimilarityScores []float64 `json:"similarity_scores,omitempty"`
+	// TemplateName holds the value of the "template_name" field.
+	TemplateName string `json:"template_name,omitempty"`
+	// TemplateVersion holds the value of the "template_version" field.
+	TemplateVersion int `json:"template_version,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Experiment holds the value of the "experiment" field.
+	Experiment string `json:"experiment,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant string `json:"variant,omitempty"`
+	// RawOutput holds the value of the "raw_output" field.
+	RawOutput string `json:"raw_output,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// Handoff holds the value of the "handoff" field.
+	Handoff bool `json:"handoff,omitempty"`
+	// EmbeddingLatencyMs holds the value of the "embedding_latency_ms" field.
+	EmbeddingLatencyMs int64 `json:"embedding_latency_ms,omitempty"`
+	// VectorSearchLatencyMs holds the value of the "vector_search_latency_ms" field.
+	VectorSearchLatencyMs int64 `json:"vector_search_latency_ms,omitempty"`
+	// LlmLatencyMs holds the value of the "llm_latency_ms" field.
+	LlmLatencyMs int64 `json:"llm_latency_ms,omitempty"`
+	// TotalLatencyMs holds the value of the "total_latency_ms" field.
+	TotalLatencyMs int64 `json:"total_latency_ms,omitempty"`
+	// ErrorCode holds the value of the "error_code" field.
+	ErrorCode string `json:"error_code,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldKnowledgeIds, auditrecord.FieldSimilarityScores:
+			values[i] = new([]byte)
+		case auditrecord.FieldQuestionEmbedding:
+			values[i] = new(pgvector.Vector)
+		case auditrecord.FieldHandoff:
+			values[i] = new(sql.NullBool)
+		case auditrecord.FieldID, auditrecord.FieldUserID, auditrecord.FieldTemplateVersion, auditrecord.FieldEmbeddingLatencyMs, auditrecord.FieldVectorSearchLatencyMs, auditrecord.FieldLlmLatencyMs, auditrecord.FieldTotalLatencyMs:
+			values[i] = new(sql.NullInt64)
+		case auditrecord.FieldTrid, auditrecord.FieldAPIKeyID, auditrecord.FieldQuestion, auditrecord.FieldIntent, auditrecord.FieldTemplateName, auditrecord.FieldModel, auditrecord.FieldExperiment, auditrecord.FieldVariant, auditrecord.FieldRawOutput, auditrecord.FieldAnswer, auditrecord.FieldErrorCode, auditrecord.FieldError:
+			values[i] = new(sql.NullString)
+		case auditrecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditRecord fields.
+func (_m *AuditRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case auditrecord.FieldTrid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trid", values[i])
+			} else if value.Valid {
+				_m.Trid = value.String
+			}
+		case auditrecord.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case auditrecord.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = value.String
+			}
+		case auditrecord.FieldQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question", values[i])
+			} else if value.Valid {
+				_m.Question = value.String
+			}
+		case auditrecord.FieldQuestionEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field question_embedding", values[i])
+			} else if value != nil {
+				_m.QuestionEmbedding = *value
+			}
+		case auditrecord.FieldIntent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field intent", values[i])
+			} else if value.Valid {
+				_m.Intent = value.String
+			}
+		case auditrecord.FieldKnowledgeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knowledge_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.KnowledgeIds); err != nil {
+					return fmt.Errorf("unmarshal field knowledge_ids: %w", err)
+				}
+			}
+		case auditrecord.FieldSimilarityScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field similarity_scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SimilarityScores); err != nil {
+					return fmt.Errorf("unmarshal field similarity_scores: %w", err)
+				}
+			}
+		case auditrecord.FieldTemplateName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_name", values[i])
+			} else if value.Valid {
+				_m.TemplateName = value.String
+			}
+		case auditrecord.FieldTemplateVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_version", values[i])
+			} else if value.Valid {
+				_m.TemplateVersion = int(value.Int64)
+			}
+		case auditrecord.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case auditrecord.FieldExperiment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field experiment", values[i])
+			} else if value.Valid {
+				_m.Experiment = value.String
+			}
+		case auditrecord.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				_m.Variant = value.String
+			}
+		case auditrecord.FieldRawOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_output", values[i])
+			} else if value.Valid {
+				_m.RawOutput = value.String
+			}
+		case auditrecord.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case auditrecord.FieldHandoff:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff", values[i])
+			} else if value.Valid {
+				_m.Handoff = value.Bool
+			}
+		case auditrecord.FieldEmbeddingLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_latency_ms", values[i])
+			} else if value.Valid {
+				_m.EmbeddingLatencyMs = value.Int64
+			}
+		case auditrecord.FieldVectorSearchLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vector_search_latency_ms", values[i])
+			} else if value.Valid {
+				_m.VectorSearchLatencyMs = value.Int64
+			}
+		case auditrecord.FieldLlmLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_latency_ms", values[i])
+			} else if value.Valid {
+				_m.LlmLatencyMs = value.Int64
+			}
+		case auditrecord.FieldTotalLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_latency_ms", values[i])
+			} else if value.Valid {
+				_m.TotalLatencyMs = value.Int64
+			}
+		case auditrecord.FieldErrorCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_code", values[i])
+			} else if value.Valid {
+				_m.ErrorCode = value.String
+			}
+		case auditrecord.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case auditrecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditRecord.
+// This includes values selected through modifiers, order, etc.
+func (_m *AuditRecord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditRecord.
+// Note that you need to call AuditRecord.Unwrap() before calling this method if this AuditRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AuditRecord) Update() *AuditRecordUpdateOne {
+	return NewAuditRecordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AuditRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AuditRecord) Unwrap() *AuditRecord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditRecord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AuditRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("trid=")
+	builder.WriteString(_m.Trid)
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("api_key_id=")
+	builder.WriteString(_m.APIKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("question=")
+	builder.WriteString(_m.Question)
+	builder.WriteString(", ")
+	builder.WriteString("question_embedding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuestionEmbedding))
+	builder.WriteString(", ")
+	builder.WriteString("intent=")
+	builder.WriteString(_m.Intent)
+	builder.WriteString(", ")
+	builder.WriteString("knowledge_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KnowledgeIds))
+	builder.WriteString(", ")
+	builder.WriteString("similarity_scores=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SimilarityScores))
+	builder.WriteString(", ")
+	builder.WriteString("template_name=")
+	builder.WriteString(_m.TemplateName)
+	builder.WriteString(", ")
+	builder.WriteString("template_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TemplateVersion))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("experiment=")
+	builder.WriteString(_m.Experiment)
+	builder.WriteString(", ")
+	builder.WriteString("variant=")
+	builder.WriteString(_m.Variant)
+	builder.WriteString(", ")
+	builder.WriteString("raw_output=")
+	builder.WriteString(_m.RawOutput)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("handoff=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Handoff))
+	builder.WriteString(", ")
+	builder.WriteString("embedding_latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmbeddingLatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("vector_search_latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VectorSearchLatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("llm_latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmLatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("total_latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalLatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("error_code=")
+	builder.WriteString(_m.ErrorCode)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditRecords is a parsable slice of AuditRecord.
+type AuditRecords []*AuditRecord
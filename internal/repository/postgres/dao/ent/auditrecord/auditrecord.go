@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the auditrecord type in the database.
+	Label = "audit_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTrid holds the string denoting the trid field in the database.
+	FieldTrid = "trid"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldQuestion holds the string denoting the question field in the database.
+	FieldQuestion = "question"
+	// FieldQuestionEmbedding holds the string denoting the question_embedding field in the database.
+	FieldQuestionEmbedding = "question_embedding"
+	// FieldIntent holds the string denoting the intent field in the database.
+	FieldIntent = "intent"
+	// FieldKnowledgeIds holds the string denoting the knowledge_ids field in the database.
+	FieldKnowledgeIds = "knowledge_ids"
+	// FieldSimilarityScores holds the string denoting the similarity_scores field in the database.
+	FieldSimilarityScores = "similarity_scores"
+	// FieldTemplateName holds the string denoting the template_name field in the database.
+	FieldTemplateName = "template_name"
+	// FieldTemplateVersion holds the string denoting the template_version field in the database.
+	FieldTemplateVersion = "template_version"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldExperiment holds the string denoting the experiment field in the database.
+	FieldExperiment = "experiment"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldRawOutput holds the string denoting the raw_output field in the database.
+	FieldRawOutput = "raw_output"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldHandoff holds the string denoting the handoff field in the database.
+	FieldHandoff = "handoff"
+	// FieldEmbeddingLatencyMs holds the string denoting the embedding_latency_ms field in the database.
+	FieldEmbeddingLatencyMs = "embedding_latency_ms"
+	// FieldVectorSearchLatencyMs holds the string denoting the vector_search_latency_ms field in the database.
+	FieldVectorSearchLatencyMs = "vector_search_latency_ms"
+	// FieldLlmLatencyMs holds the string denoting the llm_latency_ms field in the database.
+	FieldLlmLatencyMs = "llm_latency_ms"
+	// FieldTotalLatencyMs holds the string denoting the total_latency_ms field in the database.
+	FieldTotalLatencyMs = "total_latency_ms"
+	// FieldErrorCode holds the string denoting the error_code field in the database.
+	FieldErrorCode = "error_code"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditrecord in the database.
+	Table = "audit_records"
+)
+
+// Columns holds all SQL columns for auditrecord fields.
+var Columns = []string{
+	FieldID,
+	FieldTrid,
+	FieldUserID,
+	FieldAPIKeyID,
+	FieldQuestion,
+	FieldQuestionEmbedding,
+	FieldIntent,
+	FieldKnowledgeIds,
+	FieldSimilarityScores,
+	FieldTemplateName,
+	FieldTemplateVersion,
+	FieldModel,
+	FieldExperiment,
+	FieldVariant,
+	FieldRawOutput,
+	FieldAnswer,
+	FieldHandoff,
+	FieldEmbeddingLatencyMs,
+	FieldVectorSearchLatencyMs,
+	FieldLlmLatencyMs,
+	FieldTotalLatencyMs,
+	FieldErrorCode,
+	FieldError,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultHandoff holds the default value on creation for the "handoff" field.
+	DefaultHandoff bool
+	// EmbeddingLatencyMsValidator is a validator for the "embedding_latency_ms" field. It is called by the builders before save.
+	EmbeddingLatencyMsValidator func(int64) error
+	// VectorSearchLatencyMsValidator is a validator for the "vector_search_latency_ms" field. It is called by the builders before save.
+	VectorSearchLatencyMsValidator func(int64) error
+	// LlmLatencyMsValidator is a validator for the "llm_latency_ms" field. It is called by the builders before save.
+	LlmLatencyMsValidator func(int64) error
+	// TotalLatencyMsValidator is a validator for the "total_latency_ms" field. It is called by the builders before save.
+	TotalLatencyMsValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AuditRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTrid orders the results by the trid field.
+func ByTrid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrid, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAPIKeyID orders the results by the api_key_id field.
+func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
+}
+
+// ByQuestion orders the results by the question field.
+func ByQuestion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestion, opts...).ToFunc()
+}
+
+// ByQuestionEmbedding orders the results by the question_embedding field.
+func ByQuestionEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionEmbedding, opts...).ToFunc()
+}
+
+// ByIntent orders the results by the intent field.
+func ByIntent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntent, opts...).ToFunc()
+}
+
+// ByTemplateName orders the results by the template_name field.
+func ByTemplateName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateName, opts...).ToFunc()
+}
+
+// ByTemplateVersion orders the results by the template_version field.
+func ByTemplateVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateVersion, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByExperiment orders the results by the experiment field.
+func ByExperiment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperiment, opts...).ToFunc()
+}
+
+// ByVariant orders the results by the variant field.
+func ByVariant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariant, opts...).ToFunc()
+}
+
+// ByRawOutput orders the results by the raw_output field.
+func ByRawOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawOutput, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByHandoff orders the results by the handoff field.
+func ByHandoff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandoff, opts...).ToFunc()
+}
+
+// ByEmbeddingLatencyMs orders the results by the embedding_latency_ms field.
+func ByEmbeddingLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingLatencyMs, opts...).ToFunc()
+}
+
+// ByVectorSearchLatencyMs orders the results by the vector_search_latency_ms field.
+func ByVectorSearchLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVectorSearchLatencyMs, opts...).ToFunc()
+}
+
+// ByLlmLatencyMs orders the results by the llm_latency_ms field.
+func ByLlmLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmLatencyMs, opts...).ToFunc()
+}
+
+// ByTotalLatencyMs orders the results by the total_latency_ms field.
+func ByTotalLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalLatencyMs, opts...).ToFunc()
+}
+
+// ByErrorCode orders the results by the error_code field.
+func ByErrorCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorCode, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
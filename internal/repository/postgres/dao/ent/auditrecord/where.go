@@ -0,0 +1,1446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldID, id))
+}
+
+// Trid applies equality check predicate on the "trid" field. It's identical to TridEQ.
+func Trid(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTrid, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldUserID, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// Question applies equality check predicate on the "question" field. It's identical to QuestionEQ.
+func Question(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldQuestion, v))
+}
+
+// QuestionEmbedding applies equality check predicate on the "question_embedding" field. It's identical to QuestionEmbeddingEQ.
+func QuestionEmbedding(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldQuestionEmbedding, v))
+}
+
+// Intent applies equality check predicate on the "intent" field. It's identical to IntentEQ.
+func Intent(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldIntent, v))
+}
+
+// TemplateName applies equality check predicate on the "template_name" field. It's identical to TemplateNameEQ.
+func TemplateName(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTemplateName, v))
+}
+
+// TemplateVersion applies equality check predicate on the "template_version" field. It's identical to TemplateVersionEQ.
+func TemplateVersion(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldModel, v))
+}
+
+// Experiment applies equality check predicate on the "experiment" field. It's identical to ExperimentEQ.
+func Experiment(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldExperiment, v))
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldVariant, v))
+}
+
+// RawOutput applies equality check predicate on the "raw_output" field. It's identical to RawOutputEQ.
+func RawOutput(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldRawOutput, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Handoff applies equality check predicate on the "handoff" field. It's identical to HandoffEQ.
+func Handoff(v bool) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldHandoff, v))
+}
+
+// EmbeddingLatencyMs applies equality check predicate on the "embedding_latency_ms" field. It's identical to EmbeddingLatencyMsEQ.
+func EmbeddingLatencyMs(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldEmbeddingLatencyMs, v))
+}
+
+// VectorSearchLatencyMs applies equality check predicate on the "vector_search_latency_ms" field. It's identical to VectorSearchLatencyMsEQ.
+func VectorSearchLatencyMs(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldVectorSearchLatencyMs, v))
+}
+
+// LlmLatencyMs applies equality check predicate on the "llm_latency_ms" field. It's identical to LlmLatencyMsEQ.
+func LlmLatencyMs(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldLlmLatencyMs, v))
+}
+
+// TotalLatencyMs applies equality check predicate on the "total_latency_ms" field. It's identical to TotalLatencyMsEQ.
+func TotalLatencyMs(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTotalLatencyMs, v))
+}
+
+// ErrorCode applies equality check predicate on the "error_code" field. It's identical to ErrorCodeEQ.
+func ErrorCode(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TridEQ applies the EQ predicate on the "trid" field.
+func TridEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTrid, v))
+}
+
+// TridNEQ applies the NEQ predicate on the "trid" field.
+func TridNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldTrid, v))
+}
+
+// TridIn applies the In predicate on the "trid" field.
+func TridIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldTrid, vs...))
+}
+
+// TridNotIn applies the NotIn predicate on the "trid" field.
+func TridNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldTrid, vs...))
+}
+
+// TridGT applies the GT predicate on the "trid" field.
+func TridGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldTrid, v))
+}
+
+// TridGTE applies the GTE predicate on the "trid" field.
+func TridGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldTrid, v))
+}
+
+// TridLT applies the LT predicate on the "trid" field.
+func TridLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldTrid, v))
+}
+
+// TridLTE applies the LTE predicate on the "trid" field.
+func TridLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldTrid, v))
+}
+
+// TridContains applies the Contains predicate on the "trid" field.
+func TridContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldTrid, v))
+}
+
+// TridHasPrefix applies the HasPrefix predicate on the "trid" field.
+func TridHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldTrid, v))
+}
+
+// TridHasSuffix applies the HasSuffix predicate on the "trid" field.
+func TridHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldTrid, v))
+}
+
+// TridIsNil applies the IsNil predicate on the "trid" field.
+func TridIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldTrid))
+}
+
+// TridNotNil applies the NotNil predicate on the "trid" field.
+func TridNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldTrid))
+}
+
+// TridEqualFold applies the EqualFold predicate on the "trid" field.
+func TridEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldTrid, v))
+}
+
+// TridContainsFold applies the ContainsFold predicate on the "trid" field.
+func TridContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldTrid, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldUserID))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDContains applies the Contains predicate on the "api_key_id" field.
+func APIKeyIDContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldAPIKeyID, v))
+}
+
+// APIKeyIDHasPrefix applies the HasPrefix predicate on the "api_key_id" field.
+func APIKeyIDHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldAPIKeyID, v))
+}
+
+// APIKeyIDHasSuffix applies the HasSuffix predicate on the "api_key_id" field.
+func APIKeyIDHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIsNil applies the IsNil predicate on the "api_key_id" field.
+func APIKeyIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldAPIKeyID))
+}
+
+// APIKeyIDNotNil applies the NotNil predicate on the "api_key_id" field.
+func APIKeyIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldAPIKeyID))
+}
+
+// APIKeyIDEqualFold applies the EqualFold predicate on the "api_key_id" field.
+func APIKeyIDEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldAPIKeyID, v))
+}
+
+// APIKeyIDContainsFold applies the ContainsFold predicate on the "api_key_id" field.
+func APIKeyIDContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldAPIKeyID, v))
+}
+
+// QuestionEQ applies the EQ predicate on the "question" field.
+func QuestionEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldQuestion, v))
+}
+
+// QuestionNEQ applies the NEQ predicate on the "question" field.
+func QuestionNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldQuestion, v))
+}
+
+// QuestionIn applies the In predicate on the "question" field.
+func QuestionIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldQuestion, vs...))
+}
+
+// QuestionNotIn applies the NotIn predicate on the "question" field.
+func QuestionNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldQuestion, vs...))
+}
+
+// QuestionGT applies the GT predicate on the "question" field.
+func QuestionGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldQuestion, v))
+}
+
+// QuestionGTE applies the GTE predicate on the "question" field.
+func QuestionGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldQuestion, v))
+}
+
+// QuestionLT applies the LT predicate on the "question" field.
+func QuestionLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldQuestion, v))
+}
+
+// QuestionLTE applies the LTE predicate on the "question" field.
+func QuestionLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldQuestion, v))
+}
+
+// QuestionContains applies the Contains predicate on the "question" field.
+func QuestionContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldQuestion, v))
+}
+
+// QuestionHasPrefix applies the HasPrefix predicate on the "question" field.
+func QuestionHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldQuestion, v))
+}
+
+// QuestionHasSuffix applies the HasSuffix predicate on the "question" field.
+func QuestionHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldQuestion, v))
+}
+
+// QuestionEqualFold applies the EqualFold predicate on the "question" field.
+func QuestionEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldQuestion, v))
+}
+
+// QuestionContainsFold applies the ContainsFold predicate on the "question" field.
+func QuestionContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldQuestion, v))
+}
+
+// QuestionEmbeddingEQ applies the EQ predicate on the "question_embedding" field.
+func QuestionEmbeddingEQ(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingNEQ applies the NEQ predicate on the "question_embedding" field.
+func QuestionEmbeddingNEQ(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingIn applies the In predicate on the "question_embedding" field.
+func QuestionEmbeddingIn(vs ...pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldQuestionEmbedding, vs...))
+}
+
+// QuestionEmbeddingNotIn applies the NotIn predicate on the "question_embedding" field.
+func QuestionEmbeddingNotIn(vs ...pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldQuestionEmbedding, vs...))
+}
+
+// QuestionEmbeddingGT applies the GT predicate on the "question_embedding" field.
+func QuestionEmbeddingGT(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingGTE applies the GTE predicate on the "question_embedding" field.
+func QuestionEmbeddingGTE(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingLT applies the LT predicate on the "question_embedding" field.
+func QuestionEmbeddingLT(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingLTE applies the LTE predicate on the "question_embedding" field.
+func QuestionEmbeddingLTE(v pgvector.Vector) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldQuestionEmbedding, v))
+}
+
+// QuestionEmbeddingIsNil applies the IsNil predicate on the "question_embedding" field.
+func QuestionEmbeddingIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldQuestionEmbedding))
+}
+
+// QuestionEmbeddingNotNil applies the NotNil predicate on the "question_embedding" field.
+func QuestionEmbeddingNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldQuestionEmbedding))
+}
+
+// IntentEQ applies the EQ predicate on the "intent" field.
+func IntentEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldIntent, v))
+}
+
+// IntentNEQ applies the NEQ predicate on the "intent" field.
+func IntentNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldIntent, v))
+}
+
+// IntentIn applies the In predicate on the "intent" field.
+func IntentIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldIntent, vs...))
+}
+
+// IntentNotIn applies the NotIn predicate on the "intent" field.
+func IntentNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldIntent, vs...))
+}
+
+// IntentGT applies the GT predicate on the "intent" field.
+func IntentGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldIntent, v))
+}
+
+// IntentGTE applies the GTE predicate on the "intent" field.
+func IntentGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldIntent, v))
+}
+
+// IntentLT applies the LT predicate on the "intent" field.
+func IntentLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldIntent, v))
+}
+
+// IntentLTE applies the LTE predicate on the "intent" field.
+func IntentLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldIntent, v))
+}
+
+// IntentContains applies the Contains predicate on the "intent" field.
+func IntentContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldIntent, v))
+}
+
+// IntentHasPrefix applies the HasPrefix predicate on the "intent" field.
+func IntentHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldIntent, v))
+}
+
+// IntentHasSuffix applies the HasSuffix predicate on the "intent" field.
+func IntentHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldIntent, v))
+}
+
+// IntentIsNil applies the IsNil predicate on the "intent" field.
+func IntentIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldIntent))
+}
+
+// IntentNotNil applies the NotNil predicate on the "intent" field.
+func IntentNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldIntent))
+}
+
+// IntentEqualFold applies the EqualFold predicate on the "intent" field.
+func IntentEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldIntent, v))
+}
+
+// IntentContainsFold applies the ContainsFold predicate on the "intent" field.
+func IntentContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldIntent, v))
+}
+
+// KnowledgeIdsIsNil applies the IsNil predicate on the "knowledge_ids" field.
+func KnowledgeIdsIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldKnowledgeIds))
+}
+
+// KnowledgeIdsNotNil applies the NotNil predicate on the "knowledge_ids" field.
+func KnowledgeIdsNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldKnowledgeIds))
+}
+
+// SimilarityScoresIsNil applies the IsNil predicate on the "similarity_scores" field.
+func SimilarityScoresIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldSimilarityScores))
+}
+
+// SimilarityScoresNotNil applies the NotNil predicate on the "similarity_scores" field.
+func SimilarityScoresNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldSimilarityScores))
+}
+
+// TemplateNameEQ applies the EQ predicate on the "template_name" field.
+func TemplateNameEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTemplateName, v))
+}
+
+// TemplateNameNEQ applies the NEQ predicate on the "template_name" field.
+func TemplateNameNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldTemplateName, v))
+}
+
+// TemplateNameIn applies the In predicate on the "template_name" field.
+func TemplateNameIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldTemplateName, vs...))
+}
+
+// TemplateNameNotIn applies the NotIn predicate on the "template_name" field.
+func TemplateNameNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldTemplateName, vs...))
+}
+
+// TemplateNameGT applies the GT predicate on the "template_name" field.
+func TemplateNameGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldTemplateName, v))
+}
+
+// TemplateNameGTE applies the GTE predicate on the "template_name" field.
+func TemplateNameGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldTemplateName, v))
+}
+
+// TemplateNameLT applies the LT predicate on the "template_name" field.
+func TemplateNameLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldTemplateName, v))
+}
+
+// TemplateNameLTE applies the LTE predicate on the "template_name" field.
+func TemplateNameLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldTemplateName, v))
+}
+
+// TemplateNameContains applies the Contains predicate on the "template_name" field.
+func TemplateNameContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldTemplateName, v))
+}
+
+// TemplateNameHasPrefix applies the HasPrefix predicate on the "template_name" field.
+func TemplateNameHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldTemplateName, v))
+}
+
+// TemplateNameHasSuffix applies the HasSuffix predicate on the "template_name" field.
+func TemplateNameHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldTemplateName, v))
+}
+
+// TemplateNameIsNil applies the IsNil predicate on the "template_name" field.
+func TemplateNameIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldTemplateName))
+}
+
+// TemplateNameNotNil applies the NotNil predicate on the "template_name" field.
+func TemplateNameNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldTemplateName))
+}
+
+// TemplateNameEqualFold applies the EqualFold predicate on the "template_name" field.
+func TemplateNameEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldTemplateName, v))
+}
+
+// TemplateNameContainsFold applies the ContainsFold predicate on the "template_name" field.
+func TemplateNameContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldTemplateName, v))
+}
+
+// TemplateVersionEQ applies the EQ predicate on the "template_version" field.
+func TemplateVersionEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionNEQ applies the NEQ predicate on the "template_version" field.
+func TemplateVersionNEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIn applies the In predicate on the "template_version" field.
+func TemplateVersionIn(vs ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionNotIn applies the NotIn predicate on the "template_version" field.
+func TemplateVersionNotIn(vs ...int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionGT applies the GT predicate on the "template_version" field.
+func TemplateVersionGT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionGTE applies the GTE predicate on the "template_version" field.
+func TemplateVersionGTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLT applies the LT predicate on the "template_version" field.
+func TemplateVersionLT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLTE applies the LTE predicate on the "template_version" field.
+func TemplateVersionLTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIsNil applies the IsNil predicate on the "template_version" field.
+func TemplateVersionIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldTemplateVersion))
+}
+
+// TemplateVersionNotNil applies the NotNil predicate on the "template_version" field.
+func TemplateVersionNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldTemplateVersion))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldModel))
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldModel))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldModel, v))
+}
+
+// ExperimentEQ applies the EQ predicate on the "experiment" field.
+func ExperimentEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldExperiment, v))
+}
+
+// ExperimentNEQ applies the NEQ predicate on the "experiment" field.
+func ExperimentNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldExperiment, v))
+}
+
+// ExperimentIn applies the In predicate on the "experiment" field.
+func ExperimentIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldExperiment, vs...))
+}
+
+// ExperimentNotIn applies the NotIn predicate on the "experiment" field.
+func ExperimentNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldExperiment, vs...))
+}
+
+// ExperimentGT applies the GT predicate on the "experiment" field.
+func ExperimentGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldExperiment, v))
+}
+
+// ExperimentGTE applies the GTE predicate on the "experiment" field.
+func ExperimentGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldExperiment, v))
+}
+
+// ExperimentLT applies the LT predicate on the "experiment" field.
+func ExperimentLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldExperiment, v))
+}
+
+// ExperimentLTE applies the LTE predicate on the "experiment" field.
+func ExperimentLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldExperiment, v))
+}
+
+// ExperimentContains applies the Contains predicate on the "experiment" field.
+func ExperimentContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldExperiment, v))
+}
+
+// ExperimentHasPrefix applies the HasPrefix predicate on the "experiment" field.
+func ExperimentHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldExperiment, v))
+}
+
+// ExperimentHasSuffix applies the HasSuffix predicate on the "experiment" field.
+func ExperimentHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldExperiment, v))
+}
+
+// ExperimentIsNil applies the IsNil predicate on the "experiment" field.
+func ExperimentIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldExperiment))
+}
+
+// ExperimentNotNil applies the NotNil predicate on the "experiment" field.
+func ExperimentNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldExperiment))
+}
+
+// ExperimentEqualFold applies the EqualFold predicate on the "experiment" field.
+func ExperimentEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldExperiment, v))
+}
+
+// ExperimentContainsFold applies the ContainsFold predicate on the "experiment" field.
+func ExperimentContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldExperiment, v))
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldVariant, v))
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldVariant, v))
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldVariant, vs...))
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldVariant, vs...))
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldVariant, v))
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldVariant, v))
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldVariant, v))
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldVariant, v))
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldVariant, v))
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldVariant, v))
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldVariant, v))
+}
+
+// VariantIsNil applies the IsNil predicate on the "variant" field.
+func VariantIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldVariant))
+}
+
+// VariantNotNil applies the NotNil predicate on the "variant" field.
+func VariantNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldVariant))
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldVariant, v))
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldVariant, v))
+}
+
+// RawOutputEQ applies the EQ predicate on the "raw_output" field.
+func RawOutputEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldRawOutput, v))
+}
+
+// RawOutputNEQ applies the NEQ predicate on the "raw_output" field.
+func RawOutputNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldRawOutput, v))
+}
+
+// RawOutputIn applies the In predicate on the "raw_output" field.
+func RawOutputIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldRawOutput, vs...))
+}
+
+// RawOutputNotIn applies the NotIn predicate on the "raw_output" field.
+func RawOutputNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldRawOutput, vs...))
+}
+
+// RawOutputGT applies the GT predicate on the "raw_output" field.
+func RawOutputGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldRawOutput, v))
+}
+
+// RawOutputGTE applies the GTE predicate on the "raw_output" field.
+func RawOutputGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldRawOutput, v))
+}
+
+// RawOutputLT applies the LT predicate on the "raw_output" field.
+func RawOutputLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldRawOutput, v))
+}
+
+// RawOutputLTE applies the LTE predicate on the "raw_output" field.
+func RawOutputLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldRawOutput, v))
+}
+
+// RawOutputContains applies the Contains predicate on the "raw_output" field.
+func RawOutputContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldRawOutput, v))
+}
+
+// RawOutputHasPrefix applies the HasPrefix predicate on the "raw_output" field.
+func RawOutputHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldRawOutput, v))
+}
+
+// RawOutputHasSuffix applies the HasSuffix predicate on the "raw_output" field.
+func RawOutputHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldRawOutput, v))
+}
+
+// RawOutputIsNil applies the IsNil predicate on the "raw_output" field.
+func RawOutputIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldRawOutput))
+}
+
+// RawOutputNotNil applies the NotNil predicate on the "raw_output" field.
+func RawOutputNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldRawOutput))
+}
+
+// RawOutputEqualFold applies the EqualFold predicate on the "raw_output" field.
+func RawOutputEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldRawOutput, v))
+}
+
+// RawOutputContainsFold applies the ContainsFold predicate on the "raw_output" field.
+func RawOutputContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldRawOutput, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerIsNil applies the IsNil predicate on the "answer" field.
+func AnswerIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldAnswer))
+}
+
+// AnswerNotNil applies the NotNil predicate on the "answer" field.
+func AnswerNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldAnswer))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// HandoffEQ applies the EQ predicate on the "handoff" field.
+func HandoffEQ(v bool) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldHandoff, v))
+}
+
+// HandoffNEQ applies the NEQ predicate on the "handoff" field.
+func HandoffNEQ(v bool) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldHandoff, v))
+}
+
+// EmbeddingLatencyMsEQ applies the EQ predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldEmbeddingLatencyMs, v))
+}
+
+// EmbeddingLatencyMsNEQ applies the NEQ predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsNEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldEmbeddingLatencyMs, v))
+}
+
+// EmbeddingLatencyMsIn applies the In predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldEmbeddingLatencyMs, vs...))
+}
+
+// EmbeddingLatencyMsNotIn applies the NotIn predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsNotIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldEmbeddingLatencyMs, vs...))
+}
+
+// EmbeddingLatencyMsGT applies the GT predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsGT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldEmbeddingLatencyMs, v))
+}
+
+// EmbeddingLatencyMsGTE applies the GTE predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsGTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldEmbeddingLatencyMs, v))
+}
+
+// EmbeddingLatencyMsLT applies the LT predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsLT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldEmbeddingLatencyMs, v))
+}
+
+// EmbeddingLatencyMsLTE applies the LTE predicate on the "embedding_latency_ms" field.
+func EmbeddingLatencyMsLTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldEmbeddingLatencyMs, v))
+}
+
+// VectorSearchLatencyMsEQ applies the EQ predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldVectorSearchLatencyMs, v))
+}
+
+// VectorSearchLatencyMsNEQ applies the NEQ predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsNEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldVectorSearchLatencyMs, v))
+}
+
+// VectorSearchLatencyMsIn applies the In predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldVectorSearchLatencyMs, vs...))
+}
+
+// VectorSearchLatencyMsNotIn applies the NotIn predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsNotIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldVectorSearchLatencyMs, vs...))
+}
+
+// VectorSearchLatencyMsGT applies the GT predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsGT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldVectorSearchLatencyMs, v))
+}
+
+// VectorSearchLatencyMsGTE applies the GTE predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsGTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldVectorSearchLatencyMs, v))
+}
+
+// VectorSearchLatencyMsLT applies the LT predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsLT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldVectorSearchLatencyMs, v))
+}
+
+// VectorSearchLatencyMsLTE applies the LTE predicate on the "vector_search_latency_ms" field.
+func VectorSearchLatencyMsLTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldVectorSearchLatencyMs, v))
+}
+
+// LlmLatencyMsEQ applies the EQ predicate on the "llm_latency_ms" field.
+func LlmLatencyMsEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldLlmLatencyMs, v))
+}
+
+// LlmLatencyMsNEQ applies the NEQ predicate on the "llm_latency_ms" field.
+func LlmLatencyMsNEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldLlmLatencyMs, v))
+}
+
+// LlmLatencyMsIn applies the In predicate on the "llm_latency_ms" field.
+func LlmLatencyMsIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldLlmLatencyMs, vs...))
+}
+
+// LlmLatencyMsNotIn applies the NotIn predicate on the "llm_latency_ms" field.
+func LlmLatencyMsNotIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldLlmLatencyMs, vs...))
+}
+
+// LlmLatencyMsGT applies the GT predicate on the "llm_latency_ms" field.
+func LlmLatencyMsGT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldLlmLatencyMs, v))
+}
+
+// LlmLatencyMsGTE applies the GTE predicate on the "llm_latency_ms" field.
+func LlmLatencyMsGTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldLlmLatencyMs, v))
+}
+
+// LlmLatencyMsLT applies the LT predicate on the "llm_latency_ms" field.
+func LlmLatencyMsLT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldLlmLatencyMs, v))
+}
+
+// LlmLatencyMsLTE applies the LTE predicate on the "llm_latency_ms" field.
+func LlmLatencyMsLTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldLlmLatencyMs, v))
+}
+
+// TotalLatencyMsEQ applies the EQ predicate on the "total_latency_ms" field.
+func TotalLatencyMsEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldTotalLatencyMs, v))
+}
+
+// TotalLatencyMsNEQ applies the NEQ predicate on the "total_latency_ms" field.
+func TotalLatencyMsNEQ(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldTotalLatencyMs, v))
+}
+
+// TotalLatencyMsIn applies the In predicate on the "total_latency_ms" field.
+func TotalLatencyMsIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldTotalLatencyMs, vs...))
+}
+
+// TotalLatencyMsNotIn applies the NotIn predicate on the "total_latency_ms" field.
+func TotalLatencyMsNotIn(vs ...int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldTotalLatencyMs, vs...))
+}
+
+// TotalLatencyMsGT applies the GT predicate on the "total_latency_ms" field.
+func TotalLatencyMsGT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldTotalLatencyMs, v))
+}
+
+// TotalLatencyMsGTE applies the GTE predicate on the "total_latency_ms" field.
+func TotalLatencyMsGTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldTotalLatencyMs, v))
+}
+
+// TotalLatencyMsLT applies the LT predicate on the "total_latency_ms" field.
+func TotalLatencyMsLT(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldTotalLatencyMs, v))
+}
+
+// TotalLatencyMsLTE applies the LTE predicate on the "total_latency_ms" field.
+func TotalLatencyMsLTE(v int64) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldTotalLatencyMs, v))
+}
+
+// ErrorCodeEQ applies the EQ predicate on the "error_code" field.
+func ErrorCodeEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeNEQ applies the NEQ predicate on the "error_code" field.
+func ErrorCodeNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeIn applies the In predicate on the "error_code" field.
+func ErrorCodeIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeNotIn applies the NotIn predicate on the "error_code" field.
+func ErrorCodeNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeGT applies the GT predicate on the "error_code" field.
+func ErrorCodeGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldErrorCode, v))
+}
+
+// ErrorCodeGTE applies the GTE predicate on the "error_code" field.
+func ErrorCodeGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldErrorCode, v))
+}
+
+// ErrorCodeLT applies the LT predicate on the "error_code" field.
+func ErrorCodeLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldErrorCode, v))
+}
+
+// ErrorCodeLTE applies the LTE predicate on the "error_code" field.
+func ErrorCodeLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldErrorCode, v))
+}
+
+// ErrorCodeContains applies the Contains predicate on the "error_code" field.
+func ErrorCodeContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldErrorCode, v))
+}
+
+// ErrorCodeHasPrefix applies the HasPrefix predicate on the "error_code" field.
+func ErrorCodeHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldErrorCode, v))
+}
+
+// ErrorCodeHasSuffix applies the HasSuffix predicate on the "error_code" field.
+func ErrorCodeHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldErrorCode, v))
+}
+
+// ErrorCodeIsNil applies the IsNil predicate on the "error_code" field.
+func ErrorCodeIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldErrorCode))
+}
+
+// ErrorCodeNotNil applies the NotNil predicate on the "error_code" field.
+func ErrorCodeNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldErrorCode))
+}
+
+// ErrorCodeEqualFold applies the EqualFold predicate on the "error_code" field.
+func ErrorCodeEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldErrorCode, v))
+}
+
+// ErrorCodeContainsFold applies the ContainsFold predicate on the "error_code" field.
+func ErrorCodeContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldErrorCode, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.NotPredicates(p))
+}
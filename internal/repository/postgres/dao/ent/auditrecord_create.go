@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+)
+
+// AuditRecordCreate is the builder for creating a AuditRecord entity.
+type AuditRecordCreate struct {
+	config
+	mutation *AuditRecordMutation
+	hooks    []Hook
+}
+
+// SetTrid sets the "trid" field.
+func (_c *AuditRecordCreate) SetTrid(v string) *AuditRecordCreate {
+	_c.mutation.SetTrid(v)
+	return _c
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableTrid(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetTrid(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AuditRecordCreate) SetUserID(v int) *AuditRecordCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableUserID(v *int) *AuditRecordCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *AuditRecordCreate) SetAPIKeyID(v string) *AuditRecordCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableAPIKeyID(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *AuditRecordCreate) SetQuestion(v string) *AuditRecordCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetQuestionEmbedding sets the "question_embedding" field.
+func (_c *AuditRecordCreate) SetQuestionEmbedding(v pgvector.Vector) *AuditRecordCreate {
+	_c.mutation.SetQuestionEmbedding(v)
+	return _c
+}
+
+// SetNillableQuestionEmbedding sets the "question_embedding" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableQuestionEmbedding(v *pgvector.Vector) *AuditRecordCreate {
+	if v != nil {
+		_c.SetQuestionEmbedding(*v)
+	}
+	return _c
+}
+
+// SetIntent sets the "intent" field.
+func (_c *AuditRecordCreate) SetIntent(v string) *AuditRecordCreate {
+	_c.mutation.SetIntent(v)
+	return _c
+}
+
+// SetNillableIntent sets the "intent" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableIntent(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetIntent(*v)
+	}
+	return _c
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_c *AuditRecordCreate) SetKnowledgeIds(v []int) *AuditRecordCreate {
+	_c.mutation.SetKnowledgeIds(v)
+	return _c
+}
+
+// SetSimilarityScores sets the "similarity_scores" field.
+func (_c *AuditRecordCreate) SetSimilarityScores(v []float64) *AuditRecordCreate {
+	_c.mutation.SetSimilarityScores(v)
+	return _c
+}
+
+// SetTemplateName sets the "template_name" field.
+func (_c *AuditRecordCreate) SetTemplateName(v string) *AuditRecordCreate {
+	_c.mutation.SetTemplateName(v)
+	return _c
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableTemplateName(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetTemplateName(*v)
+	}
+	return _c
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_c *AuditRecordCreate) SetTemplateVersion(v int) *AuditRecordCreate {
+	_c.mutation.SetTemplateVersion(v)
+	return _c
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableTemplateVersion(v *int) *AuditRecordCreate {
+	if v != nil {
+		_c.SetTemplateVersion(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AuditRecordCreate) SetModel(v string) *AuditRecordCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableModel(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetExperiment sets the "experiment" field.
+func (_c *AuditRecordCreate) SetExperiment(v string) *AuditRecordCreate {
+	_c.mutation.SetExperiment(v)
+	return _c
+}
+
+// SetNillableExperiment sets the "experiment" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableExperiment(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetExperiment(*v)
+	}
+	return _c
+}
+
+// SetVariant sets the "variant" field.
+func (_c *AuditRecordCreate) SetVariant(v string) *AuditRecordCreate {
+	_c.mutation.SetVariant(v)
+	return _c
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableVariant(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetVariant(*v)
+	}
+	return _c
+}
+
+// SetRawOutput sets the "raw_output" field.
+func (_c *AuditRecordCreate) SetRawOutput(v string) *AuditRecordCreate {
+	_c.mutation.SetRawOutput(v)
+	return _c
+}
+
+// SetNillableRawOutput sets the "raw_output" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableRawOutput(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetRawOutput(*v)
+	}
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *AuditRecordCreate) SetAnswer(v string) *AuditRecordCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableAnswer(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetHandoff sets the "handoff" field.
+func (_c *AuditRecordCreate) SetHandoff(v bool) *AuditRecordCreate {
+	_c.mutation.SetHandoff(v)
+	return _c
+}
+
+// SetNillableHandoff sets the "handoff" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableHandoff(v *bool) *AuditRecordCreate {
+	if v != nil {
+		_c.SetHandoff(*v)
+	}
+	return _c
+}
+
+// SetEmbeddingLatencyMs sets the "embedding_latency_ms" field.
+func (_c *AuditRecordCreate) SetEmbeddingLatencyMs(v int64) *AuditRecordCreate {
+	_c.mutation.SetEmbeddingLatencyMs(v)
+	return _c
+}
+
+// SetVectorSearchLatencyMs sets the "vector_search_latency_ms" field.
+func (_c *AuditRecordCreate) SetVectorSearchLatencyMs(v int64) *AuditRecordCreate {
+	_c.mutation.SetVectorSearchLatencyMs(v)
+	return _c
+}
+
+// SetLlmLatencyMs sets the "llm_latency_ms" field.
+func (_c *AuditRecordCreate) SetLlmLatencyMs(v int64) *AuditRecordCreate {
+	_c.mutation.SetLlmLatencyMs(v)
+	return _c
+}
+
+// SetTotalLatencyMs sets the "total_latency_ms" field.
+func (_c *AuditRecordCreate) SetTotalLatencyMs(v int64) *AuditRecordCreate {
+	_c.mutation.SetTotalLatencyMs(v)
+	return _c
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_c *AuditRecordCreate) SetErrorCode(v string) *AuditRecordCreate {
+	_c.mutation.SetErrorCode(v)
+	return _c
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableErrorCode(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetErrorCode(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *AuditRecordCreate) SetError(v string) *AuditRecordCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableError(v *string) *AuditRecordCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuditRecordCreate) SetCreatedAt(v time.Time) *AuditRecordCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuditRecordCreate) SetNillableCreatedAt(v *time.Time) *AuditRecordCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AuditRecordCreate) SetID(v int) *AuditRecordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (_c *AuditRecordCreate) Mutation() *AuditRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditRecord in the database.
+func (_c *AuditRecordCreate) Save(ctx context.Context) (*AuditRecord, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditRecordCreate) SaveX(ctx context.Context) *AuditRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditRecordCreate) defaults() {
+	if _, ok := _c.mutation.Handoff(); !ok {
+		v := auditrecord.DefaultHandoff
+		_c.mutation.SetHandoff(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := auditrecord.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditRecordCreate) check() error {
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "AuditRecord.question"`)}
+	}
+	if _, ok := _c.mutation.Handoff(); !ok {
+		return &ValidationError{Name: "handoff", err: errors.New(`ent: missing required field "AuditRecord.handoff"`)}
+	}
+	if _, ok := _c.mutation.EmbeddingLatencyMs(); !ok {
+		return &ValidationError{Name: "embedding_latency_ms", err: errors.New(`ent: missing required field "AuditRecord.embedding_latency_ms"`)}
+	}
+	if v, ok := _c.mutation.EmbeddingLatencyMs(); ok {
+		if err := auditrecord.EmbeddingLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.embedding_latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VectorSearchLatencyMs(); !ok {
+		return &ValidationError{Name: "vector_search_latency_ms", err: errors.New(`ent: missing required field "AuditRecord.vector_search_latency_ms"`)}
+	}
+	if v, ok := _c.mutation.VectorSearchLatencyMs(); ok {
+		if err := auditrecord.VectorSearchLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "vector_search_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.vector_search_latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LlmLatencyMs(); !ok {
+		return &ValidationError{Name: "llm_latency_ms", err: errors.New(`ent: missing required field "AuditRecord.llm_latency_ms"`)}
+	}
+	if v, ok := _c.mutation.LlmLatencyMs(); ok {
+		if err := auditrecord.LlmLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "llm_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.llm_latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalLatencyMs(); !ok {
+		return &ValidationError{Name: "total_latency_ms", err: errors.New(`ent: missing required field "AuditRecord.total_latency_ms"`)}
+	}
+	if v, ok := _c.mutation.TotalLatencyMs(); ok {
+		if err := auditrecord.TotalLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.total_latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditRecord.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AuditRecordCreate) sqlSave(ctx context.Context) (*AuditRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditRecordCreate) createSpec() (*AuditRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(auditrecord.Table, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Trid(); ok {
+		_spec.SetField(auditrecord.FieldTrid, field.TypeString, value)
+		_node.Trid = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(auditrecord.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(auditrecord.FieldAPIKeyID, field.TypeString, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(auditrecord.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.QuestionEmbedding(); ok {
+		_spec.SetField(auditrecord.FieldQuestionEmbedding, field.TypeOther, value)
+		_node.QuestionEmbedding = value
+	}
+	if value, ok := _c.mutation.Intent(); ok {
+		_spec.SetField(auditrecord.FieldIntent, field.TypeString, value)
+		_node.Intent = value
+	}
+	if value, ok := _c.mutation.KnowledgeIds(); ok {
+		_spec.SetField(auditrecord.FieldKnowledgeIds, field.TypeJSON, value)
+		_node.KnowledgeIds = value
+	}
+	if value, ok := _c.mutation.SimilarityScores(); ok {
+		_spec.SetField(auditrecord.FieldSimilarityScores, field.TypeJSON, value)
+		_node.SimilarityScores = value
+	}
+	if value, ok := _c.mutation.TemplateName(); ok {
+		_spec.SetField(auditrecord.FieldTemplateName, field.TypeString, value)
+		_node.TemplateName = value
+	}
+	if value, ok := _c.mutation.TemplateVersion(); ok {
+		_spec.SetField(auditrecord.FieldTemplateVersion, field.TypeInt, value)
+		_node.TemplateVersion = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(auditrecord.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Experiment(); ok {
+		_spec.SetField(auditrecord.FieldExperiment, field.TypeString, value)
+		_node.Experiment = value
+	}
+	if value, ok := _c.mutation.Variant(); ok {
+		_spec.SetField(auditrecord.FieldVariant, field.TypeString, value)
+		_node.Variant = value
+	}
+	if value, ok := _c.mutation.RawOutput(); ok {
+		_spec.SetField(auditrecord.FieldRawOutput, field.TypeString, value)
+		_node.RawOutput = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(auditrecord.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Handoff(); ok {
+		_spec.SetField(auditrecord.FieldHandoff, field.TypeBool, value)
+		_node.Handoff = value
+	}
+	if value, ok := _c.mutation.EmbeddingLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldEmbeddingLatencyMs, field.TypeInt64, value)
+		_node.EmbeddingLatencyMs = value
+	}
+	if value, ok := _c.mutation.VectorSearchLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldVectorSearchLatencyMs, field.TypeInt64, value)
+		_node.VectorSearchLatencyMs = value
+	}
+	if value, ok := _c.mutation.LlmLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldLlmLatencyMs, field.TypeInt64, value)
+		_node.LlmLatencyMs = value
+	}
+	if value, ok := _c.mutation.TotalLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldTotalLatencyMs, field.TypeInt64, value)
+		_node.TotalLatencyMs = value
+	}
+	if value, ok := _c.mutation.ErrorCode(); ok {
+		_spec.SetField(auditrecord.FieldErrorCode, field.TypeString, value)
+		_node.ErrorCode = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(auditrecord.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(auditrecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditRecordCreateBulk is the builder for creating many AuditRecord entities in bulk.
+type AuditRecordCreateBulk struct {
+	config
+	err      error
+	builders []*AuditRecordCreate
+}
+
+// Save creates the AuditRecord entities in the database.
+func (_c *AuditRecordCreateBulk) Save(ctx context.Context) ([]*AuditRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditRecordCreateBulk) SaveX(ctx context.Context) []*AuditRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
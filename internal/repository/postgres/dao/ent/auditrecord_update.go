@@ -0,0 +1,1401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// AuditRecordUpdate is the builder for updating AuditRecord entities.
+type AuditRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditRecordMutation
+}
+
+// Where appends a list predicates to the AuditRecordUpdate builder.
+func (_u *AuditRecordUpdate) Where(ps ...predicate.AuditRecord) *AuditRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTrid sets the "trid" field.
+func (_u *AuditRecordUpdate) SetTrid(v string) *AuditRecordUpdate {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableTrid(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *AuditRecordUpdate) ClearTrid() *AuditRecordUpdate {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuditRecordUpdate) SetUserID(v int) *AuditRecordUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableUserID(v *int) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AuditRecordUpdate) AddUserID(v int) *AuditRecordUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuditRecordUpdate) ClearUserID() *AuditRecordUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *AuditRecordUpdate) SetAPIKeyID(v string) *AuditRecordUpdate {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableAPIKeyID(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *AuditRecordUpdate) ClearAPIKeyID() *AuditRecordUpdate {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AuditRecordUpdate) SetQuestion(v string) *AuditRecordUpdate {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableQuestion(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetQuestionEmbedding sets the "question_embedding" field.
+func (_u *AuditRecordUpdate) SetQuestionEmbedding(v pgvector.Vector) *AuditRecordUpdate {
+	_u.mutation.SetQuestionEmbedding(v)
+	return _u
+}
+
+// SetNillableQuestionEmbedding sets the "question_embedding" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableQuestionEmbedding(v *pgvector.Vector) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetQuestionEmbedding(*v)
+	}
+	return _u
+}
+
+// ClearQuestionEmbedding clears the value of the "question_embedding" field.
+func (_u *AuditRecordUpdate) ClearQuestionEmbedding() *AuditRecordUpdate {
+	_u.mutation.ClearQuestionEmbedding()
+	return _u
+}
+
+// SetIntent sets the "intent" field.
+func (_u *AuditRecordUpdate) SetIntent(v string) *AuditRecordUpdate {
+	_u.mutation.SetIntent(v)
+	return _u
+}
+
+// SetNillableIntent sets the "intent" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableIntent(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetIntent(*v)
+	}
+	return _u
+}
+
+// ClearIntent clears the value of the "intent" field.
+func (_u *AuditRecordUpdate) ClearIntent() *AuditRecordUpdate {
+	_u.mutation.ClearIntent()
+	return _u
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_u *AuditRecordUpdate) SetKnowledgeIds(v []int) *AuditRecordUpdate {
+	_u.mutation.SetKnowledgeIds(v)
+	return _u
+}
+
+// AppendKnowledgeIds appends value to the "knowledge_ids" field.
+func (_u *AuditRecordUpdate) AppendKnowledgeIds(v []int) *AuditRecordUpdate {
+	_u.mutation.AppendKnowledgeIds(v)
+	return _u
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (_u *AuditRecordUpdate) ClearKnowledgeIds() *AuditRecordUpdate {
+	_u.mutation.ClearKnowledgeIds()
+	return _u
+}
+
+// SetSimilarityScores sets the "similarity_scores" field.
+func (_u *AuditRecordUpdate) SetSimilarityScores(v []float64) *AuditRecordUpdate {
+	_u.mutation.SetSimilarityScores(v)
+	return _u
+}
+
+// AppendSimilarityScores appends value to the "similarity_scores" field.
+func (_u *AuditRecordUpdate) AppendSimilarityScores(v []float64) *AuditRecordUpdate {
+	_u.mutation.AppendSimilarityScores(v)
+	return _u
+}
+
+// ClearSimilarityScores clears the value of the "similarity_scores" field.
+func (_u *AuditRecordUpdate) ClearSimilarityScores() *AuditRecordUpdate {
+	_u.mutation.ClearSimilarityScores()
+	return _u
+}
+
+// SetTemplateName sets the "template_name" field.
+func (_u *AuditRecordUpdate) SetTemplateName(v string) *AuditRecordUpdate {
+	_u.mutation.SetTemplateName(v)
+	return _u
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableTemplateName(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetTemplateName(*v)
+	}
+	return _u
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (_u *AuditRecordUpdate) ClearTemplateName() *AuditRecordUpdate {
+	_u.mutation.ClearTemplateName()
+	return _u
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_u *AuditRecordUpdate) SetTemplateVersion(v int) *AuditRecordUpdate {
+	_u.mutation.ResetTemplateVersion()
+	_u.mutation.SetTemplateVersion(v)
+	return _u
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableTemplateVersion(v *int) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetTemplateVersion(*v)
+	}
+	return _u
+}
+
+// AddTemplateVersion adds value to the "template_version" field.
+func (_u *AuditRecordUpdate) AddTemplateVersion(v int) *AuditRecordUpdate {
+	_u.mutation.AddTemplateVersion(v)
+	return _u
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (_u *AuditRecordUpdate) ClearTemplateVersion() *AuditRecordUpdate {
+	_u.mutation.ClearTemplateVersion()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AuditRecordUpdate) SetModel(v string) *AuditRecordUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableModel(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *AuditRecordUpdate) ClearModel() *AuditRecordUpdate {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetExperiment sets the "experiment" field.
+func (_u *AuditRecordUpdate) SetExperiment(v string) *AuditRecordUpdate {
+	_u.mutation.SetExperiment(v)
+	return _u
+}
+
+// SetNillableExperiment sets the "experiment" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableExperiment(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetExperiment(*v)
+	}
+	return _u
+}
+
+// ClearExperiment clears the value of the "experiment" field.
+func (_u *AuditRecordUpdate) ClearExperiment() *AuditRecordUpdate {
+	_u.mutation.ClearExperiment()
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *AuditRecordUpdate) SetVariant(v string) *AuditRecordUpdate {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableVariant(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (_u *AuditRecordUpdate) ClearVariant() *AuditRecordUpdate {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
+// SetRawOutput sets the "raw_output" field.
+func (_u *AuditRecordUpdate) SetRawOutput(v string) *AuditRecordUpdate {
+	_u.mutation.SetRawOutput(v)
+	return _u
+}
+
+// SetNillableRawOutput sets the "raw_output" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableRawOutput(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetRawOutput(*v)
+	}
+	return _u
+}
+
+// ClearRawOutput clears the value of the "raw_output" field.
+func (_u *AuditRecordUpdate) ClearRawOutput() *AuditRecordUpdate {
+	_u.mutation.ClearRawOutput()
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AuditRecordUpdate) SetAnswer(v string) *AuditRecordUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableAnswer(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *AuditRecordUpdate) ClearAnswer() *AuditRecordUpdate {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetHandoff sets the "handoff" field.
+func (_u *AuditRecordUpdate) SetHandoff(v bool) *AuditRecordUpdate {
+	_u.mutation.SetHandoff(v)
+	return _u
+}
+
+// SetNillableHandoff sets the "handoff" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableHandoff(v *bool) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetHandoff(*v)
+	}
+	return _u
+}
+
+// SetEmbeddingLatencyMs sets the "embedding_latency_ms" field.
+func (_u *AuditRecordUpdate) SetEmbeddingLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.ResetEmbeddingLatencyMs()
+	_u.mutation.SetEmbeddingLatencyMs(v)
+	return _u
+}
+
+// SetNillableEmbeddingLatencyMs sets the "embedding_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableEmbeddingLatencyMs(v *int64) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetEmbeddingLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddEmbeddingLatencyMs adds value to the "embedding_latency_ms" field.
+func (_u *AuditRecordUpdate) AddEmbeddingLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.AddEmbeddingLatencyMs(v)
+	return _u
+}
+
+// SetVectorSearchLatencyMs sets the "vector_search_latency_ms" field.
+func (_u *AuditRecordUpdate) SetVectorSearchLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.ResetVectorSearchLatencyMs()
+	_u.mutation.SetVectorSearchLatencyMs(v)
+	return _u
+}
+
+// SetNillableVectorSearchLatencyMs sets the "vector_search_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableVectorSearchLatencyMs(v *int64) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetVectorSearchLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddVectorSearchLatencyMs adds value to the "vector_search_latency_ms" field.
+func (_u *AuditRecordUpdate) AddVectorSearchLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.AddVectorSearchLatencyMs(v)
+	return _u
+}
+
+// SetLlmLatencyMs sets the "llm_latency_ms" field.
+func (_u *AuditRecordUpdate) SetLlmLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.ResetLlmLatencyMs()
+	_u.mutation.SetLlmLatencyMs(v)
+	return _u
+}
+
+// SetNillableLlmLatencyMs sets the "llm_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableLlmLatencyMs(v *int64) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetLlmLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLlmLatencyMs adds value to the "llm_latency_ms" field.
+func (_u *AuditRecordUpdate) AddLlmLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.AddLlmLatencyMs(v)
+	return _u
+}
+
+// SetTotalLatencyMs sets the "total_latency_ms" field.
+func (_u *AuditRecordUpdate) SetTotalLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.ResetTotalLatencyMs()
+	_u.mutation.SetTotalLatencyMs(v)
+	return _u
+}
+
+// SetNillableTotalLatencyMs sets the "total_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableTotalLatencyMs(v *int64) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetTotalLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddTotalLatencyMs adds value to the "total_latency_ms" field.
+func (_u *AuditRecordUpdate) AddTotalLatencyMs(v int64) *AuditRecordUpdate {
+	_u.mutation.AddTotalLatencyMs(v)
+	return _u
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_u *AuditRecordUpdate) SetErrorCode(v string) *AuditRecordUpdate {
+	_u.mutation.SetErrorCode(v)
+	return _u
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableErrorCode(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetErrorCode(*v)
+	}
+	return _u
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (_u *AuditRecordUpdate) ClearErrorCode() *AuditRecordUpdate {
+	_u.mutation.ClearErrorCode()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AuditRecordUpdate) SetError(v string) *AuditRecordUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AuditRecordUpdate) SetNillableError(v *string) *AuditRecordUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AuditRecordUpdate) ClearError() *AuditRecordUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (_u *AuditRecordUpdate) Mutation() *AuditRecordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditRecordUpdate) check() error {
+	if v, ok := _u.mutation.EmbeddingLatencyMs(); ok {
+		if err := auditrecord.EmbeddingLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.embedding_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VectorSearchLatencyMs(); ok {
+		if err := auditrecord.VectorSearchLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "vector_search_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.vector_search_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LlmLatencyMs(); ok {
+		if err := auditrecord.LlmLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "llm_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.llm_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLatencyMs(); ok {
+		if err := auditrecord.TotalLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.total_latency_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditrecord.Table, auditrecord.Columns, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(auditrecord.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(auditrecord.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(auditrecord.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(auditrecord.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(auditrecord.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(auditrecord.FieldAPIKeyID, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(auditrecord.FieldAPIKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(auditrecord.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionEmbedding(); ok {
+		_spec.SetField(auditrecord.FieldQuestionEmbedding, field.TypeOther, value)
+	}
+	if _u.mutation.QuestionEmbeddingCleared() {
+		_spec.ClearField(auditrecord.FieldQuestionEmbedding, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Intent(); ok {
+		_spec.SetField(auditrecord.FieldIntent, field.TypeString, value)
+	}
+	if _u.mutation.IntentCleared() {
+		_spec.ClearField(auditrecord.FieldIntent, field.TypeString)
+	}
+	if value, ok := _u.mutation.KnowledgeIds(); ok {
+		_spec.SetField(auditrecord.FieldKnowledgeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKnowledgeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditrecord.FieldKnowledgeIds, value)
+		})
+	}
+	if _u.mutation.KnowledgeIdsCleared() {
+		_spec.ClearField(auditrecord.FieldKnowledgeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SimilarityScores(); ok {
+		_spec.SetField(auditrecord.FieldSimilarityScores, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSimilarityScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditrecord.FieldSimilarityScores, value)
+		})
+	}
+	if _u.mutation.SimilarityScoresCleared() {
+		_spec.ClearField(auditrecord.FieldSimilarityScores, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TemplateName(); ok {
+		_spec.SetField(auditrecord.FieldTemplateName, field.TypeString, value)
+	}
+	if _u.mutation.TemplateNameCleared() {
+		_spec.ClearField(auditrecord.FieldTemplateName, field.TypeString)
+	}
+	if value, ok := _u.mutation.TemplateVersion(); ok {
+		_spec.SetField(auditrecord.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(auditrecord.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateVersionCleared() {
+		_spec.ClearField(auditrecord.FieldTemplateVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(auditrecord.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(auditrecord.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experiment(); ok {
+		_spec.SetField(auditrecord.FieldExperiment, field.TypeString, value)
+	}
+	if _u.mutation.ExperimentCleared() {
+		_spec.ClearField(auditrecord.FieldExperiment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(auditrecord.FieldVariant, field.TypeString, value)
+	}
+	if _u.mutation.VariantCleared() {
+		_spec.ClearField(auditrecord.FieldVariant, field.TypeString)
+	}
+	if value, ok := _u.mutation.RawOutput(); ok {
+		_spec.SetField(auditrecord.FieldRawOutput, field.TypeString, value)
+	}
+	if _u.mutation.RawOutputCleared() {
+		_spec.ClearField(auditrecord.FieldRawOutput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(auditrecord.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(auditrecord.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Handoff(); ok {
+		_spec.SetField(auditrecord.FieldHandoff, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EmbeddingLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldEmbeddingLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEmbeddingLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldEmbeddingLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VectorSearchLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldVectorSearchLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVectorSearchLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldVectorSearchLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LlmLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldLlmLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLlmLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldLlmLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldTotalLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldTotalLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ErrorCode(); ok {
+		_spec.SetField(auditrecord.FieldErrorCode, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCodeCleared() {
+		_spec.ClearField(auditrecord.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(auditrecord.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(auditrecord.FieldError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditRecordUpdateOne is the builder for updating a single AuditRecord entity.
+type AuditRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditRecordMutation
+}
+
+// SetTrid sets the "trid" field.
+func (_u *AuditRecordUpdateOne) SetTrid(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableTrid(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *AuditRecordUpdateOne) ClearTrid() *AuditRecordUpdateOne {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuditRecordUpdateOne) SetUserID(v int) *AuditRecordUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableUserID(v *int) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AuditRecordUpdateOne) AddUserID(v int) *AuditRecordUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuditRecordUpdateOne) ClearUserID() *AuditRecordUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *AuditRecordUpdateOne) SetAPIKeyID(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableAPIKeyID(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *AuditRecordUpdateOne) ClearAPIKeyID() *AuditRecordUpdateOne {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *AuditRecordUpdateOne) SetQuestion(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableQuestion(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetQuestionEmbedding sets the "question_embedding" field.
+func (_u *AuditRecordUpdateOne) SetQuestionEmbedding(v pgvector.Vector) *AuditRecordUpdateOne {
+	_u.mutation.SetQuestionEmbedding(v)
+	return _u
+}
+
+// SetNillableQuestionEmbedding sets the "question_embedding" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableQuestionEmbedding(v *pgvector.Vector) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetQuestionEmbedding(*v)
+	}
+	return _u
+}
+
+// ClearQuestionEmbedding clears the value of the "question_embedding" field.
+func (_u *AuditRecordUpdateOne) ClearQuestionEmbedding() *AuditRecordUpdateOne {
+	_u.mutation.ClearQuestionEmbedding()
+	return _u
+}
+
+// SetIntent sets the "intent" field.
+func (_u *AuditRecordUpdateOne) SetIntent(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetIntent(v)
+	return _u
+}
+
+// SetNillableIntent sets the "intent" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableIntent(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetIntent(*v)
+	}
+	return _u
+}
+
+// ClearIntent clears the value of the "intent" field.
+func (_u *AuditRecordUpdateOne) ClearIntent() *AuditRecordUpdateOne {
+	_u.mutation.ClearIntent()
+	return _u
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (_u *AuditRecordUpdateOne) SetKnowledgeIds(v []int) *AuditRecordUpdateOne {
+	_u.mutation.SetKnowledgeIds(v)
+	return _u
+}
+
+// AppendKnowledgeIds appends value to the "knowledge_ids" field.
+func (_u *AuditRecordUpdateOne) AppendKnowledgeIds(v []int) *AuditRecordUpdateOne {
+	_u.mutation.AppendKnowledgeIds(v)
+	return _u
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (_u *AuditRecordUpdateOne) ClearKnowledgeIds() *AuditRecordUpdateOne {
+	_u.mutation.ClearKnowledgeIds()
+	return _u
+}
+
+// SetSimilarityScores sets the "similarity_scores" field.
+func (_u *AuditRecordUpdateOne) SetSimilarityScores(v []float64) *AuditRecordUpdateOne {
+	_u.mutation.SetSimilarityScores(v)
+	return _u
+}
+
+// AppendSimilarityScores appends value to the "similarity_scores" field.
+func (_u *AuditRecordUpdateOne) AppendSimilarityScores(v []float64) *AuditRecordUpdateOne {
+	_u.mutation.AppendSimilarityScores(v)
+	return _u
+}
+
+// ClearSimilarityScores clears the value of the "similarity_scores" field.
+func (_u *AuditRecordUpdateOne) ClearSimilarityScores() *AuditRecordUpdateOne {
+	_u.mutation.ClearSimilarityScores()
+	return _u
+}
+
+// SetTemplateName sets the "template_name" field.
+func (_u *AuditRecordUpdateOne) SetTemplateName(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetTemplateName(v)
+	return _u
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableTemplateName(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetTemplateName(*v)
+	}
+	return _u
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (_u *AuditRecordUpdateOne) ClearTemplateName() *AuditRecordUpdateOne {
+	_u.mutation.ClearTemplateName()
+	return _u
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (_u *AuditRecordUpdateOne) SetTemplateVersion(v int) *AuditRecordUpdateOne {
+	_u.mutation.ResetTemplateVersion()
+	_u.mutation.SetTemplateVersion(v)
+	return _u
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableTemplateVersion(v *int) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetTemplateVersion(*v)
+	}
+	return _u
+}
+
+// AddTemplateVersion adds value to the "template_version" field.
+func (_u *AuditRecordUpdateOne) AddTemplateVersion(v int) *AuditRecordUpdateOne {
+	_u.mutation.AddTemplateVersion(v)
+	return _u
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (_u *AuditRecordUpdateOne) ClearTemplateVersion() *AuditRecordUpdateOne {
+	_u.mutation.ClearTemplateVersion()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *AuditRecordUpdateOne) SetModel(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableModel(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *AuditRecordUpdateOne) ClearModel() *AuditRecordUpdateOne {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetExperiment sets the "experiment" field.
+func (_u *AuditRecordUpdateOne) SetExperiment(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetExperiment(v)
+	return _u
+}
+
+// SetNillableExperiment sets the "experiment" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableExperiment(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetExperiment(*v)
+	}
+	return _u
+}
+
+// ClearExperiment clears the value of the "experiment" field.
+func (_u *AuditRecordUpdateOne) ClearExperiment() *AuditRecordUpdateOne {
+	_u.mutation.ClearExperiment()
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *AuditRecordUpdateOne) SetVariant(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableVariant(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (_u *AuditRecordUpdateOne) ClearVariant() *AuditRecordUpdateOne {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
+// SetRawOutput sets the "raw_output" field.
+func (_u *AuditRecordUpdateOne) SetRawOutput(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetRawOutput(v)
+	return _u
+}
+
+// SetNillableRawOutput sets the "raw_output" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableRawOutput(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetRawOutput(*v)
+	}
+	return _u
+}
+
+// ClearRawOutput clears the value of the "raw_output" field.
+func (_u *AuditRecordUpdateOne) ClearRawOutput() *AuditRecordUpdateOne {
+	_u.mutation.ClearRawOutput()
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *AuditRecordUpdateOne) SetAnswer(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableAnswer(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *AuditRecordUpdateOne) ClearAnswer() *AuditRecordUpdateOne {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetHandoff sets the "handoff" field.
+func (_u *AuditRecordUpdateOne) SetHandoff(v bool) *AuditRecordUpdateOne {
+	_u.mutation.SetHandoff(v)
+	return _u
+}
+
+// SetNillableHandoff sets the "handoff" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableHandoff(v *bool) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetHandoff(*v)
+	}
+	return _u
+}
+
+// SetEmbeddingLatencyMs sets the "embedding_latency_ms" field.
+func (_u *AuditRecordUpdateOne) SetEmbeddingLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.ResetEmbeddingLatencyMs()
+	_u.mutation.SetEmbeddingLatencyMs(v)
+	return _u
+}
+
+// SetNillableEmbeddingLatencyMs sets the "embedding_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableEmbeddingLatencyMs(v *int64) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddEmbeddingLatencyMs adds value to the "embedding_latency_ms" field.
+func (_u *AuditRecordUpdateOne) AddEmbeddingLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.AddEmbeddingLatencyMs(v)
+	return _u
+}
+
+// SetVectorSearchLatencyMs sets the "vector_search_latency_ms" field.
+func (_u *AuditRecordUpdateOne) SetVectorSearchLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.ResetVectorSearchLatencyMs()
+	_u.mutation.SetVectorSearchLatencyMs(v)
+	return _u
+}
+
+// SetNillableVectorSearchLatencyMs sets the "vector_search_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableVectorSearchLatencyMs(v *int64) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetVectorSearchLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddVectorSearchLatencyMs adds value to the "vector_search_latency_ms" field.
+func (_u *AuditRecordUpdateOne) AddVectorSearchLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.AddVectorSearchLatencyMs(v)
+	return _u
+}
+
+// SetLlmLatencyMs sets the "llm_latency_ms" field.
+func (_u *AuditRecordUpdateOne) SetLlmLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.ResetLlmLatencyMs()
+	_u.mutation.SetLlmLatencyMs(v)
+	return _u
+}
+
+// SetNillableLlmLatencyMs sets the "llm_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableLlmLatencyMs(v *int64) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetLlmLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLlmLatencyMs adds value to the "llm_latency_ms" field.
+func (_u *AuditRecordUpdateOne) AddLlmLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.AddLlmLatencyMs(v)
+	return _u
+}
+
+// SetTotalLatencyMs sets the "total_latency_ms" field.
+func (_u *AuditRecordUpdateOne) SetTotalLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.ResetTotalLatencyMs()
+	_u.mutation.SetTotalLatencyMs(v)
+	return _u
+}
+
+// SetNillableTotalLatencyMs sets the "total_latency_ms" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableTotalLatencyMs(v *int64) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetTotalLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddTotalLatencyMs adds value to the "total_latency_ms" field.
+func (_u *AuditRecordUpdateOne) AddTotalLatencyMs(v int64) *AuditRecordUpdateOne {
+	_u.mutation.AddTotalLatencyMs(v)
+	return _u
+}
+
+// SetErrorCode sets the "error_code" field.
+func (_u *AuditRecordUpdateOne) SetErrorCode(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetErrorCode(v)
+	return _u
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableErrorCode(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetErrorCode(*v)
+	}
+	return _u
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (_u *AuditRecordUpdateOne) ClearErrorCode() *AuditRecordUpdateOne {
+	_u.mutation.ClearErrorCode()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *AuditRecordUpdateOne) SetError(v string) *AuditRecordUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *AuditRecordUpdateOne) SetNillableError(v *string) *AuditRecordUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *AuditRecordUpdateOne) ClearError() *AuditRecordUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (_u *AuditRecordUpdateOne) Mutation() *AuditRecordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditRecordUpdate builder.
+func (_u *AuditRecordUpdateOne) Where(ps ...predicate.AuditRecord) *AuditRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditRecordUpdateOne) Select(field string, fields ...string) *AuditRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditRecord entity.
+func (_u *AuditRecordUpdateOne) Save(ctx context.Context) (*AuditRecord, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditRecordUpdateOne) SaveX(ctx context.Context) *AuditRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuditRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.EmbeddingLatencyMs(); ok {
+		if err := auditrecord.EmbeddingLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "embedding_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.embedding_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VectorSearchLatencyMs(); ok {
+		if err := auditrecord.VectorSearchLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "vector_search_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.vector_search_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LlmLatencyMs(); ok {
+		if err := auditrecord.LlmLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "llm_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.llm_latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalLatencyMs(); ok {
+		if err := auditrecord.TotalLatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_latency_ms", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.total_latency_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AuditRecordUpdateOne) sqlSave(ctx context.Context) (_node *AuditRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditrecord.Table, auditrecord.Columns, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditrecord.FieldID)
+		for _, f := range fields {
+			if !auditrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(auditrecord.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(auditrecord.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(auditrecord.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(auditrecord.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(auditrecord.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(auditrecord.FieldAPIKeyID, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(auditrecord.FieldAPIKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(auditrecord.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionEmbedding(); ok {
+		_spec.SetField(auditrecord.FieldQuestionEmbedding, field.TypeOther, value)
+	}
+	if _u.mutation.QuestionEmbeddingCleared() {
+		_spec.ClearField(auditrecord.FieldQuestionEmbedding, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Intent(); ok {
+		_spec.SetField(auditrecord.FieldIntent, field.TypeString, value)
+	}
+	if _u.mutation.IntentCleared() {
+		_spec.ClearField(auditrecord.FieldIntent, field.TypeString)
+	}
+	if value, ok := _u.mutation.KnowledgeIds(); ok {
+		_spec.SetField(auditrecord.FieldKnowledgeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKnowledgeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditrecord.FieldKnowledgeIds, value)
+		})
+	}
+	if _u.mutation.KnowledgeIdsCleared() {
+		_spec.ClearField(auditrecord.FieldKnowledgeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SimilarityScores(); ok {
+		_spec.SetField(auditrecord.FieldSimilarityScores, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSimilarityScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditrecord.FieldSimilarityScores, value)
+		})
+	}
+	if _u.mutation.SimilarityScoresCleared() {
+		_spec.ClearField(auditrecord.FieldSimilarityScores, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TemplateName(); ok {
+		_spec.SetField(auditrecord.FieldTemplateName, field.TypeString, value)
+	}
+	if _u.mutation.TemplateNameCleared() {
+		_spec.ClearField(auditrecord.FieldTemplateName, field.TypeString)
+	}
+	if value, ok := _u.mutation.TemplateVersion(); ok {
+		_spec.SetField(auditrecord.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(auditrecord.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateVersionCleared() {
+		_spec.ClearField(auditrecord.FieldTemplateVersion, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(auditrecord.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(auditrecord.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Experiment(); ok {
+		_spec.SetField(auditrecord.FieldExperiment, field.TypeString, value)
+	}
+	if _u.mutation.ExperimentCleared() {
+		_spec.ClearField(auditrecord.FieldExperiment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(auditrecord.FieldVariant, field.TypeString, value)
+	}
+	if _u.mutation.VariantCleared() {
+		_spec.ClearField(auditrecord.FieldVariant, field.TypeString)
+	}
+	if value, ok := _u.mutation.RawOutput(); ok {
+		_spec.SetField(auditrecord.FieldRawOutput, field.TypeString, value)
+	}
+	if _u.mutation.RawOutputCleared() {
+		_spec.ClearField(auditrecord.FieldRawOutput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(auditrecord.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(auditrecord.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.Handoff(); ok {
+		_spec.SetField(auditrecord.FieldHandoff, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EmbeddingLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldEmbeddingLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEmbeddingLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldEmbeddingLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.VectorSearchLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldVectorSearchLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVectorSearchLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldVectorSearchLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LlmLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldLlmLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLlmLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldLlmLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalLatencyMs(); ok {
+		_spec.SetField(auditrecord.FieldTotalLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalLatencyMs(); ok {
+		_spec.AddField(auditrecord.FieldTotalLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ErrorCode(); ok {
+		_spec.SetField(auditrecord.FieldErrorCode, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCodeCleared() {
+		_spec.ClearField(auditrecord.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(auditrecord.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(auditrecord.FieldError, field.TypeString)
+	}
+	_node = &AuditRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
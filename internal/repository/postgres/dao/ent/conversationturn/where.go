@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationturn
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldUserID, v))
+}
+
+// Trid applies equality check predicate on the "trid" field. It's identical to TridEQ.
+func Trid(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldTrid, v))
+}
+
+// Question applies equality check predicate on the "question" field. It's identical to QuestionEQ.
+func Question(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldQuestion, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldAnswer, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TridEQ applies the EQ predicate on the "trid" field.
+func TridEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldTrid, v))
+}
+
+// TridNEQ applies the NEQ predicate on the "trid" field.
+func TridNEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldTrid, v))
+}
+
+// TridIn applies the In predicate on the "trid" field.
+func TridIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldTrid, vs...))
+}
+
+// TridNotIn applies the NotIn predicate on the "trid" field.
+func TridNotIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldTrid, vs...))
+}
+
+// TridGT applies the GT predicate on the "trid" field.
+func TridGT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGT(FieldTrid, v))
+}
+
+// TridGTE applies the GTE predicate on the "trid" field.
+func TridGTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGTE(FieldTrid, v))
+}
+
+// TridLT applies the LT predicate on the "trid" field.
+func TridLT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLT(FieldTrid, v))
+}
+
+// TridLTE applies the LTE predicate on the "trid" field.
+func TridLTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLTE(FieldTrid, v))
+}
+
+// TridContains applies the Contains predicate on the "trid" field.
+func TridContains(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContains(FieldTrid, v))
+}
+
+// TridHasPrefix applies the HasPrefix predicate on the "trid" field.
+func TridHasPrefix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasPrefix(FieldTrid, v))
+}
+
+// TridHasSuffix applies the HasSuffix predicate on the "trid" field.
+func TridHasSuffix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasSuffix(FieldTrid, v))
+}
+
+// TridIsNil applies the IsNil predicate on the "trid" field.
+func TridIsNil() predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIsNull(FieldTrid))
+}
+
+// TridNotNil applies the NotNil predicate on the "trid" field.
+func TridNotNil() predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotNull(FieldTrid))
+}
+
+// TridEqualFold applies the EqualFold predicate on the "trid" field.
+func TridEqualFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEqualFold(FieldTrid, v))
+}
+
+// TridContainsFold applies the ContainsFold predicate on the "trid" field.
+func TridContainsFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContainsFold(FieldTrid, v))
+}
+
+// QuestionEQ applies the EQ predicate on the "question" field.
+func QuestionEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldQuestion, v))
+}
+
+// QuestionNEQ applies the NEQ predicate on the "question" field.
+func QuestionNEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldQuestion, v))
+}
+
+// QuestionIn applies the In predicate on the "question" field.
+func QuestionIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldQuestion, vs...))
+}
+
+// QuestionNotIn applies the NotIn predicate on the "question" field.
+func QuestionNotIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldQuestion, vs...))
+}
+
+// QuestionGT applies the GT predicate on the "question" field.
+func QuestionGT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGT(FieldQuestion, v))
+}
+
+// QuestionGTE applies the GTE predicate on the "question" field.
+func QuestionGTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGTE(FieldQuestion, v))
+}
+
+// QuestionLT applies the LT predicate on the "question" field.
+func QuestionLT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLT(FieldQuestion, v))
+}
+
+// QuestionLTE applies the LTE predicate on the "question" field.
+func QuestionLTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLTE(FieldQuestion, v))
+}
+
+// QuestionContains applies the Contains predicate on the "question" field.
+func QuestionContains(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContains(FieldQuestion, v))
+}
+
+// QuestionHasPrefix applies the HasPrefix predicate on the "question" field.
+func QuestionHasPrefix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasPrefix(FieldQuestion, v))
+}
+
+// QuestionHasSuffix applies the HasSuffix predicate on the "question" field.
+func QuestionHasSuffix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasSuffix(FieldQuestion, v))
+}
+
+// QuestionEqualFold applies the EqualFold predicate on the "question" field.
+func QuestionEqualFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEqualFold(FieldQuestion, v))
+}
+
+// QuestionContainsFold applies the ContainsFold predicate on the "question" field.
+func QuestionContainsFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContainsFold(FieldQuestion, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ConversationTurn {
+	return predicate.ConversationTurn(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ConversationTurn {
+	return predicate.ConversationTurn(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConversationTurn) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConversationTurn) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConversationTurn) predicate.ConversationTurn {
+	return predicate.ConversationTurn(sql.NotPredicates(p))
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
+)
+
+// ConversationTurnCreate is the builder for creating a ConversationTurn entity.
+type ConversationTurnCreate struct {
+	config
+	mutation *ConversationTurnMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ConversationTurnCreate) SetUserID(v int) *ConversationTurnCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTrid sets the "trid" field.
+func (_c *ConversationTurnCreate) SetTrid(v string) *ConversationTurnCreate {
+	_c.mutation.SetTrid(v)
+	return _c
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_c *ConversationTurnCreate) SetNillableTrid(v *string) *ConversationTurnCreate {
+	if v != nil {
+		_c.SetTrid(*v)
+	}
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *ConversationTurnCreate) SetQuestion(v string) *ConversationTurnCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *ConversationTurnCreate) SetAnswer(v string) *ConversationTurnCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationTurnCreate) SetCreatedAt(v time.Time) *ConversationTurnCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationTurnCreate) SetNillableCreatedAt(v *time.Time) *ConversationTurnCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationTurnCreate) SetID(v int) *ConversationTurnCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ConversationTurnCreate) SetUser(v *User) *ConversationTurnCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ConversationTurnMutation object of the builder.
+func (_c *ConversationTurnCreate) Mutation() *ConversationTurnMutation {
+	return _c.mutation
+}
+
+// Save creates the ConversationTurn in the database.
+func (_c *ConversationTurnCreate) Save(ctx context.Context) (*ConversationTurn, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationTurnCreate) SaveX(ctx context.Context) *ConversationTurn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationTurnCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationTurnCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationTurnCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversationturn.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationTurnCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ConversationTurn.user_id"`)}
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "ConversationTurn.question"`)}
+	}
+	if v, ok := _c.mutation.Question(); ok {
+		if err := conversationturn.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "ConversationTurn.question": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required field "ConversationTurn.answer"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConversationTurn.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ConversationTurn.user"`)}
+	}
+	return nil
+}
+
+func (_c *ConversationTurnCreate) sqlSave(ctx context.Context) (*ConversationTurn, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationTurnCreate) createSpec() (*ConversationTurn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationTurn{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversationturn.Table, sqlgraph.NewFieldSpec(conversationturn.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Trid(); ok {
+		_spec.SetField(conversationturn.FieldTrid, field.TypeString, value)
+		_node.Trid = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(conversationturn.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(conversationturn.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationturn.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationturn.UserTable,
+			Columns: []string{conversationturn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConversationTurnCreateBulk is the builder for creating many ConversationTurn entities in bulk.
+type ConversationTurnCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationTurnCreate
+}
+
+// Save creates the ConversationTurn entities in the database.
+func (_c *ConversationTurnCreateBulk) Save(ctx context.Context) ([]*ConversationTurn, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConversationTurn, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationTurnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationTurnCreateBulk) SaveX(ctx context.Context) []*ConversationTurn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationTurnCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationTurnCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by ent, DO NOT EDIT.
 
-package dao
+package ent
 
 import (
 	"context"
@@ -8,30 +8,30 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/inquiryknowledge"
-	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/predicate"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
 )
 
-// InquiryKnowledgeDelete is the builder for deleting a InquiryKnowledge entity.
-type InquiryKnowledgeDelete struct {
+// ConversationTurnDelete is the builder for deleting a ConversationTurn entity.
+type ConversationTurnDelete struct {
 	config
 	hooks    []Hook
-	mutation *InquiryKnowledgeMutation
+	mutation *ConversationTurnMutation
 }
 
-// Where appends a list predicates to the InquiryKnowledgeDelete builder.
-func (_d *InquiryKnowledgeDelete) Where(ps ...predicate.InquiryKnowledge) *InquiryKnowledgeDelete {
+// Where appends a list predicates to the ConversationTurnDelete builder.
+func (_d *ConversationTurnDelete) Where(ps ...predicate.ConversationTurn) *ConversationTurnDelete {
 	_d.mutation.Where(ps...)
 	return _d
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (_d *InquiryKnowledgeDelete) Exec(ctx context.Context) (int, error) {
+func (_d *ConversationTurnDelete) Exec(ctx context.Context) (int, error) {
 	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (_d *InquiryKnowledgeDelete) ExecX(ctx context.Context) int {
+func (_d *ConversationTurnDelete) ExecX(ctx context.Context) int {
 	n, err := _d.Exec(ctx)
 	if err != nil {
 		panic(err)
@@ -39,8 +39,8 @@ func (_d *InquiryKnowledgeDelete) ExecX(ctx context.Context) int {
 	return n
 }
 
-func (_d *InquiryKnowledgeDelete) sqlExec(ctx context.Context) (int, error) {
-	_spec := sqlgraph.NewDeleteSpec(inquiryknowledge.Table, sqlgraph.NewFieldSpec(inquiryknowledge.FieldID, field.TypeInt))
+func (_d *ConversationTurnDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(conversationturn.Table, sqlgraph.NewFieldSpec(conversationturn.FieldID, field.TypeInt))
 	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -56,32 +56,32 @@ func (_d *InquiryKnowledgeDelete) sqlExec(ctx context.Context) (int, error) {
 	return affected, err
 }
 
-// InquiryKnowledgeDeleteOne is the builder for deleting a single InquiryKnowledge entity.
-type InquiryKnowledgeDeleteOne struct {
-	_d *InquiryKnowledgeDelete
+// ConversationTurnDeleteOne is the builder for deleting a single ConversationTurn entity.
+type ConversationTurnDeleteOne struct {
+	_d *ConversationTurnDelete
 }
 
-// Where appends a list predicates to the InquiryKnowledgeDelete builder.
-func (_d *InquiryKnowledgeDeleteOne) Where(ps ...predicate.InquiryKnowledge) *InquiryKnowledgeDeleteOne {
+// Where appends a list predicates to the ConversationTurnDelete builder.
+func (_d *ConversationTurnDeleteOne) Where(ps ...predicate.ConversationTurn) *ConversationTurnDeleteOne {
 	_d._d.mutation.Where(ps...)
 	return _d
 }
 
 // Exec executes the deletion query.
-func (_d *InquiryKnowledgeDeleteOne) Exec(ctx context.Context) error {
+func (_d *ConversationTurnDeleteOne) Exec(ctx context.Context) error {
 	n, err := _d._d.Exec(ctx)
 	switch {
 	case err != nil:
 		return err
 	case n == 0:
-		return &NotFoundError{inquiryknowledge.Label}
+		return &NotFoundError{conversationturn.Label}
 	default:
 		return nil
 	}
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (_d *InquiryKnowledgeDeleteOne) ExecX(ctx context.Context) {
+func (_d *ConversationTurnDeleteOne) ExecX(ctx context.Context) {
 	if err := _d.Exec(ctx); err != nil {
 		panic(err)
 	}
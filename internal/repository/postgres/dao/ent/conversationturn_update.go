@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
+)
+
+// ConversationTurnUpdate is the builder for updating ConversationTurn entities.
+type ConversationTurnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationTurnMutation
+}
+
+// Where appends a list predicates to the ConversationTurnUpdate builder.
+func (_u *ConversationTurnUpdate) Where(ps ...predicate.ConversationTurn) *ConversationTurnUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ConversationTurnUpdate) SetUserID(v int) *ConversationTurnUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ConversationTurnUpdate) SetNillableUserID(v *int) *ConversationTurnUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTrid sets the "trid" field.
+func (_u *ConversationTurnUpdate) SetTrid(v string) *ConversationTurnUpdate {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *ConversationTurnUpdate) SetNillableTrid(v *string) *ConversationTurnUpdate {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *ConversationTurnUpdate) ClearTrid() *ConversationTurnUpdate {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *ConversationTurnUpdate) SetQuestion(v string) *ConversationTurnUpdate {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *ConversationTurnUpdate) SetNillableQuestion(v *string) *ConversationTurnUpdate {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *ConversationTurnUpdate) SetAnswer(v string) *ConversationTurnUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *ConversationTurnUpdate) SetNillableAnswer(v *string) *ConversationTurnUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ConversationTurnUpdate) SetUser(v *User) *ConversationTurnUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ConversationTurnMutation object of the builder.
+func (_u *ConversationTurnUpdate) Mutation() *ConversationTurnMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ConversationTurnUpdate) ClearUser() *ConversationTurnUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationTurnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationTurnUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationTurnUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationTurnUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationTurnUpdate) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := conversationturn.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "ConversationTurn.question": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationTurn.user"`)
+	}
+	return nil
+}
+
+func (_u *ConversationTurnUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationturn.Table, conversationturn.Columns, sqlgraph.NewFieldSpec(conversationturn.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(conversationturn.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(conversationturn.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(conversationturn.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(conversationturn.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationturn.UserTable,
+			Columns: []string{conversationturn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationturn.UserTable,
+			Columns: []string{conversationturn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationturn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationTurnUpdateOne is the builder for updating a single ConversationTurn entity.
+type ConversationTurnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationTurnMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ConversationTurnUpdateOne) SetUserID(v int) *ConversationTurnUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ConversationTurnUpdateOne) SetNillableUserID(v *int) *ConversationTurnUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTrid sets the "trid" field.
+func (_u *ConversationTurnUpdateOne) SetTrid(v string) *ConversationTurnUpdateOne {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *ConversationTurnUpdateOne) SetNillableTrid(v *string) *ConversationTurnUpdateOne {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *ConversationTurnUpdateOne) ClearTrid() *ConversationTurnUpdateOne {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *ConversationTurnUpdateOne) SetQuestion(v string) *ConversationTurnUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *ConversationTurnUpdateOne) SetNillableQuestion(v *string) *ConversationTurnUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *ConversationTurnUpdateOne) SetAnswer(v string) *ConversationTurnUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *ConversationTurnUpdateOne) SetNillableAnswer(v *string) *ConversationTurnUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ConversationTurnUpdateOne) SetUser(v *User) *ConversationTurnUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ConversationTurnMutation object of the builder.
+func (_u *ConversationTurnUpdateOne) Mutation() *ConversationTurnMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ConversationTurnUpdateOne) ClearUser() *ConversationTurnUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ConversationTurnUpdate builder.
+func (_u *ConversationTurnUpdateOne) Where(ps ...predicate.ConversationTurn) *ConversationTurnUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationTurnUpdateOne) Select(field string, fields ...string) *ConversationTurnUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConversationTurn entity.
+func (_u *ConversationTurnUpdateOne) Save(ctx context.Context) (*ConversationTurn, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationTurnUpdateOne) SaveX(ctx context.Context) *ConversationTurn {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationTurnUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationTurnUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationTurnUpdateOne) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := conversationturn.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "ConversationTurn.question": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationTurn.user"`)
+	}
+	return nil
+}
+
+func (_u *ConversationTurnUpdateOne) sqlSave(ctx context.Context) (_node *ConversationTurn, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationturn.Table, conversationturn.Columns, sqlgraph.NewFieldSpec(conversationturn.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationTurn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationturn.FieldID)
+		for _, f := range fields {
+			if !conversationturn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationturn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(conversationturn.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(conversationturn.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(conversationturn.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(conversationturn.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationturn.UserTable,
+			Columns: []string{conversationturn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationturn.UserTable,
+			Columns: []string{conversationturn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConversationTurn{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationturn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/apikey"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/inquiryknowledge"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/ratelimitbucket"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
 )
 
@@ -74,8 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			inquiryknowledge.Table: inquiryknowledge.ValidColumn,
-			user.Table:             user.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			answerfeedback.Table:     answerfeedback.ValidColumn,
+			auditrecord.Table:        auditrecord.ValidColumn,
+			conversationturn.Table:   conversationturn.ValidColumn,
+			experimentexposure.Table: experimentexposure.ValidColumn,
+			inquiryknowledge.Table:   inquiryknowledge.ValidColumn,
+			ratelimitbucket.Table:    ratelimitbucket.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
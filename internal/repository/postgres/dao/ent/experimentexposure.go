@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
+)
+
+// ExperimentExposure is the model entity for the ExperimentExposure schema.
+type ExperimentExposure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Experiment holds the value of the "experiment" field.
+	Experiment string `json:"experiment,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant string `json:"variant,omitempty"`
+	// Trid holds the value of the "trid" field.
+	Trid string `json:"trid,omitempty"`
+	// AuditRecordID holds the value of the "audit_record_id" field.
+	AuditRecordID *int `json:"audit_record_id,omitempty"`
+	// LatencyMs holds the value of the "latency_ms" field.
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+	// FeedbackScore holds the value of the "feedback_score" field.
+	FeedbackScore *float64 `json:"feedback_score,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExperimentExposure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case experimentexposure.FieldFeedbackScore:
+			values[i] = new(sql.NullFloat64)
+		case experimentexposure.FieldID, experimentexposure.FieldAuditRecordID, experimentexposure.FieldLatencyMs:
+			values[i] = new(sql.NullInt64)
+		case experimentexposure.FieldExperiment, experimentexposure.FieldVariant, experimentexposure.FieldTrid:
+			values[i] = new(sql.NullString)
+		case experimentexposure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExperimentExposure fields.
+func (_m *ExperimentExposure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case experimentexposure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case experimentexposure.FieldExperiment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field experiment", values[i])
+			} else if value.Valid {
+				_m.Experiment = value.String
+			}
+		case experimentexposure.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				_m.Variant = value.String
+			}
+		case experimentexposure.FieldTrid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trid", values[i])
+			} else if value.Valid {
+				_m.Trid = value.String
+			}
+		case experimentexposure.FieldAuditRecordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field audit_record_id", values[i])
+			} else if value.Valid {
+				_m.AuditRecordID = new(int)
+				*_m.AuditRecordID = int(value.Int64)
+			}
+		case experimentexposure.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				_m.LatencyMs = value.Int64
+			}
+		case experimentexposure.FieldFeedbackScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field feedback_score", values[i])
+			} else if value.Valid {
+				_m.FeedbackScore = new(float64)
+				*_m.FeedbackScore = value.Float64
+			}
+		case experimentexposure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExperimentExposure.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExperimentExposure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExperimentExposure.
+// Note that you need to call ExperimentExposure.Unwrap() before calling this method if this ExperimentExposure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExperimentExposure) Update() *ExperimentExposureUpdateOne {
+	return NewExperimentExposureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExperimentExposure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExperimentExposure) Unwrap() *ExperimentExposure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExperimentExposure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExperimentExposure) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExperimentExposure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("experiment=")
+	builder.WriteString(_m.Experiment)
+	builder.WriteString(", ")
+	builder.WriteString("variant=")
+	builder.WriteString(_m.Variant)
+	builder.WriteString(", ")
+	builder.WriteString("trid=")
+	builder.WriteString(_m.Trid)
+	builder.WriteString(", ")
+	if v := _m.AuditRecordID; v != nil {
+		builder.WriteString("audit_record_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencyMs))
+	builder.WriteString(", ")
+	if v := _m.FeedbackScore; v != nil {
+		builder.WriteString("feedback_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExperimentExposures is a parsable slice of ExperimentExposure.
+type ExperimentExposures []*ExperimentExposure
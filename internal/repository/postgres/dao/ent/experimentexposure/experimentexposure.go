@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package experimentexposure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the experimentexposure type in the database.
+	Label = "experiment_exposure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldExperiment holds the string denoting the experiment field in the database.
+	FieldExperiment = "experiment"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldTrid holds the string denoting the trid field in the database.
+	FieldTrid = "trid"
+	// FieldAuditRecordID holds the string denoting the audit_record_id field in the database.
+	FieldAuditRecordID = "audit_record_id"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldFeedbackScore holds the string denoting the feedback_score field in the database.
+	FieldFeedbackScore = "feedback_score"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the experimentexposure in the database.
+	Table = "experiment_exposures"
+)
+
+// Columns holds all SQL columns for experimentexposure fields.
+var Columns = []string{
+	FieldID,
+	FieldExperiment,
+	FieldVariant,
+	FieldTrid,
+	FieldAuditRecordID,
+	FieldLatencyMs,
+	FieldFeedbackScore,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ExperimentValidator is a validator for the "experiment" field. It is called by the builders before save.
+	ExperimentValidator func(string) error
+	// VariantValidator is a validator for the "variant" field. It is called by the builders before save.
+	VariantValidator func(string) error
+	// LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	LatencyMsValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ExperimentExposure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByExperiment orders the results by the experiment field.
+func ByExperiment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperiment, opts...).ToFunc()
+}
+
+// ByVariant orders the results by the variant field.
+func ByVariant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariant, opts...).ToFunc()
+}
+
+// ByTrid orders the results by the trid field.
+func ByTrid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrid, opts...).ToFunc()
+}
+
+// ByAuditRecordID orders the results by the audit_record_id field.
+func ByAuditRecordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuditRecordID, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// ByFeedbackScore orders the results by the feedback_score field.
+func ByFeedbackScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeedbackScore, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
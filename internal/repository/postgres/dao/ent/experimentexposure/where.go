@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package experimentexposure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldID, id))
+}
+
+// Experiment applies equality check predicate on the "experiment" field. It's identical to ExperimentEQ.
+func Experiment(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldExperiment, v))
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldVariant, v))
+}
+
+// Trid applies equality check predicate on the "trid" field. It's identical to TridEQ.
+func Trid(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldTrid, v))
+}
+
+// AuditRecordID applies equality check predicate on the "audit_record_id" field. It's identical to AuditRecordIDEQ.
+func AuditRecordID(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldAuditRecordID, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// FeedbackScore applies equality check predicate on the "feedback_score" field. It's identical to FeedbackScoreEQ.
+func FeedbackScore(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldFeedbackScore, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExperimentEQ applies the EQ predicate on the "experiment" field.
+func ExperimentEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldExperiment, v))
+}
+
+// ExperimentNEQ applies the NEQ predicate on the "experiment" field.
+func ExperimentNEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldExperiment, v))
+}
+
+// ExperimentIn applies the In predicate on the "experiment" field.
+func ExperimentIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldExperiment, vs...))
+}
+
+// ExperimentNotIn applies the NotIn predicate on the "experiment" field.
+func ExperimentNotIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldExperiment, vs...))
+}
+
+// ExperimentGT applies the GT predicate on the "experiment" field.
+func ExperimentGT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldExperiment, v))
+}
+
+// ExperimentGTE applies the GTE predicate on the "experiment" field.
+func ExperimentGTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldExperiment, v))
+}
+
+// ExperimentLT applies the LT predicate on the "experiment" field.
+func ExperimentLT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldExperiment, v))
+}
+
+// ExperimentLTE applies the LTE predicate on the "experiment" field.
+func ExperimentLTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldExperiment, v))
+}
+
+// ExperimentContains applies the Contains predicate on the "experiment" field.
+func ExperimentContains(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContains(FieldExperiment, v))
+}
+
+// ExperimentHasPrefix applies the HasPrefix predicate on the "experiment" field.
+func ExperimentHasPrefix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasPrefix(FieldExperiment, v))
+}
+
+// ExperimentHasSuffix applies the HasSuffix predicate on the "experiment" field.
+func ExperimentHasSuffix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasSuffix(FieldExperiment, v))
+}
+
+// ExperimentEqualFold applies the EqualFold predicate on the "experiment" field.
+func ExperimentEqualFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEqualFold(FieldExperiment, v))
+}
+
+// ExperimentContainsFold applies the ContainsFold predicate on the "experiment" field.
+func ExperimentContainsFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContainsFold(FieldExperiment, v))
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldVariant, v))
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldVariant, v))
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldVariant, vs...))
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldVariant, vs...))
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldVariant, v))
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldVariant, v))
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldVariant, v))
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldVariant, v))
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContains(FieldVariant, v))
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasPrefix(FieldVariant, v))
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasSuffix(FieldVariant, v))
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEqualFold(FieldVariant, v))
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContainsFold(FieldVariant, v))
+}
+
+// TridEQ applies the EQ predicate on the "trid" field.
+func TridEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldTrid, v))
+}
+
+// TridNEQ applies the NEQ predicate on the "trid" field.
+func TridNEQ(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldTrid, v))
+}
+
+// TridIn applies the In predicate on the "trid" field.
+func TridIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldTrid, vs...))
+}
+
+// TridNotIn applies the NotIn predicate on the "trid" field.
+func TridNotIn(vs ...string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldTrid, vs...))
+}
+
+// TridGT applies the GT predicate on the "trid" field.
+func TridGT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldTrid, v))
+}
+
+// TridGTE applies the GTE predicate on the "trid" field.
+func TridGTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldTrid, v))
+}
+
+// TridLT applies the LT predicate on the "trid" field.
+func TridLT(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldTrid, v))
+}
+
+// TridLTE applies the LTE predicate on the "trid" field.
+func TridLTE(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldTrid, v))
+}
+
+// TridContains applies the Contains predicate on the "trid" field.
+func TridContains(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContains(FieldTrid, v))
+}
+
+// TridHasPrefix applies the HasPrefix predicate on the "trid" field.
+func TridHasPrefix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasPrefix(FieldTrid, v))
+}
+
+// TridHasSuffix applies the HasSuffix predicate on the "trid" field.
+func TridHasSuffix(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldHasSuffix(FieldTrid, v))
+}
+
+// TridIsNil applies the IsNil predicate on the "trid" field.
+func TridIsNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIsNull(FieldTrid))
+}
+
+// TridNotNil applies the NotNil predicate on the "trid" field.
+func TridNotNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotNull(FieldTrid))
+}
+
+// TridEqualFold applies the EqualFold predicate on the "trid" field.
+func TridEqualFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEqualFold(FieldTrid, v))
+}
+
+// TridContainsFold applies the ContainsFold predicate on the "trid" field.
+func TridContainsFold(v string) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldContainsFold(FieldTrid, v))
+}
+
+// AuditRecordIDEQ applies the EQ predicate on the "audit_record_id" field.
+func AuditRecordIDEQ(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDNEQ applies the NEQ predicate on the "audit_record_id" field.
+func AuditRecordIDNEQ(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDIn applies the In predicate on the "audit_record_id" field.
+func AuditRecordIDIn(vs ...int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldAuditRecordID, vs...))
+}
+
+// AuditRecordIDNotIn applies the NotIn predicate on the "audit_record_id" field.
+func AuditRecordIDNotIn(vs ...int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldAuditRecordID, vs...))
+}
+
+// AuditRecordIDGT applies the GT predicate on the "audit_record_id" field.
+func AuditRecordIDGT(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDGTE applies the GTE predicate on the "audit_record_id" field.
+func AuditRecordIDGTE(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDLT applies the LT predicate on the "audit_record_id" field.
+func AuditRecordIDLT(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDLTE applies the LTE predicate on the "audit_record_id" field.
+func AuditRecordIDLTE(v int) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldAuditRecordID, v))
+}
+
+// AuditRecordIDIsNil applies the IsNil predicate on the "audit_record_id" field.
+func AuditRecordIDIsNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIsNull(FieldAuditRecordID))
+}
+
+// AuditRecordIDNotNil applies the NotNil predicate on the "audit_record_id" field.
+func AuditRecordIDNotNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotNull(FieldAuditRecordID))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// FeedbackScoreEQ applies the EQ predicate on the "feedback_score" field.
+func FeedbackScoreEQ(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreNEQ applies the NEQ predicate on the "feedback_score" field.
+func FeedbackScoreNEQ(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreIn applies the In predicate on the "feedback_score" field.
+func FeedbackScoreIn(vs ...float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldFeedbackScore, vs...))
+}
+
+// FeedbackScoreNotIn applies the NotIn predicate on the "feedback_score" field.
+func FeedbackScoreNotIn(vs ...float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldFeedbackScore, vs...))
+}
+
+// FeedbackScoreGT applies the GT predicate on the "feedback_score" field.
+func FeedbackScoreGT(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreGTE applies the GTE predicate on the "feedback_score" field.
+func FeedbackScoreGTE(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreLT applies the LT predicate on the "feedback_score" field.
+func FeedbackScoreLT(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreLTE applies the LTE predicate on the "feedback_score" field.
+func FeedbackScoreLTE(v float64) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldFeedbackScore, v))
+}
+
+// FeedbackScoreIsNil applies the IsNil predicate on the "feedback_score" field.
+func FeedbackScoreIsNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIsNull(FieldFeedbackScore))
+}
+
+// FeedbackScoreNotNil applies the NotNil predicate on the "feedback_score" field.
+func FeedbackScoreNotNil() predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotNull(FieldFeedbackScore))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExperimentExposure) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExperimentExposure) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExperimentExposure) predicate.ExperimentExposure {
+	return predicate.ExperimentExposure(sql.NotPredicates(p))
+}
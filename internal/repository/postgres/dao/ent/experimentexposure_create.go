@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
+)
+
+// ExperimentExposureCreate is the builder for creating a ExperimentExposure entity.
+type ExperimentExposureCreate struct {
+	config
+	mutation *ExperimentExposureMutation
+	hooks    []Hook
+}
+
+// SetExperiment sets the "experiment" field.
+func (_c *ExperimentExposureCreate) SetExperiment(v string) *ExperimentExposureCreate {
+	_c.mutation.SetExperiment(v)
+	return _c
+}
+
+// SetVariant sets the "variant" field.
+func (_c *ExperimentExposureCreate) SetVariant(v string) *ExperimentExposureCreate {
+	_c.mutation.SetVariant(v)
+	return _c
+}
+
+// SetTrid sets the "trid" field.
+func (_c *ExperimentExposureCreate) SetTrid(v string) *ExperimentExposureCreate {
+	_c.mutation.SetTrid(v)
+	return _c
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_c *ExperimentExposureCreate) SetNillableTrid(v *string) *ExperimentExposureCreate {
+	if v != nil {
+		_c.SetTrid(*v)
+	}
+	return _c
+}
+
+// SetAuditRecordID sets the "audit_record_id" field.
+func (_c *ExperimentExposureCreate) SetAuditRecordID(v int) *ExperimentExposureCreate {
+	_c.mutation.SetAuditRecordID(v)
+	return _c
+}
+
+// SetNillableAuditRecordID sets the "audit_record_id" field if the given value is not nil.
+func (_c *ExperimentExposureCreate) SetNillableAuditRecordID(v *int) *ExperimentExposureCreate {
+	if v != nil {
+		_c.SetAuditRecordID(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *ExperimentExposureCreate) SetLatencyMs(v int64) *ExperimentExposureCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetFeedbackScore sets the "feedback_score" field.
+func (_c *ExperimentExposureCreate) SetFeedbackScore(v float64) *ExperimentExposureCreate {
+	_c.mutation.SetFeedbackScore(v)
+	return _c
+}
+
+// SetNillableFeedbackScore sets the "feedback_score" field if the given value is not nil.
+func (_c *ExperimentExposureCreate) SetNillableFeedbackScore(v *float64) *ExperimentExposureCreate {
+	if v != nil {
+		_c.SetFeedbackScore(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExperimentExposureCreate) SetCreatedAt(v time.Time) *ExperimentExposureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExperimentExposureCreate) SetNillableCreatedAt(v *time.Time) *ExperimentExposureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExperimentExposureCreate) SetID(v int) *ExperimentExposureCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ExperimentExposureMutation object of the builder.
+func (_c *ExperimentExposureCreate) Mutation() *ExperimentExposureMutation {
+	return _c.mutation
+}
+
+// Save creates the ExperimentExposure in the database.
+func (_c *ExperimentExposureCreate) Save(ctx context.Context) (*ExperimentExposure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExperimentExposureCreate) SaveX(ctx context.Context) *ExperimentExposure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExperimentExposureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExperimentExposureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExperimentExposureCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := experimentexposure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExperimentExposureCreate) check() error {
+	if _, ok := _c.mutation.Experiment(); !ok {
+		return &ValidationError{Name: "experiment", err: errors.New(`ent: missing required field "ExperimentExposure.experiment"`)}
+	}
+	if v, ok := _c.mutation.Experiment(); ok {
+		if err := experimentexposure.ExperimentValidator(v); err != nil {
+			return &ValidationError{Name: "experiment", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.experiment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Variant(); !ok {
+		return &ValidationError{Name: "variant", err: errors.New(`ent: missing required field "ExperimentExposure.variant"`)}
+	}
+	if v, ok := _c.mutation.Variant(); ok {
+		if err := experimentexposure.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.variant": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "ExperimentExposure.latency_ms"`)}
+	}
+	if v, ok := _c.mutation.LatencyMs(); ok {
+		if err := experimentexposure.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExperimentExposure.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExperimentExposureCreate) sqlSave(ctx context.Context) (*ExperimentExposure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExperimentExposureCreate) createSpec() (*ExperimentExposure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExperimentExposure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(experimentexposure.Table, sqlgraph.NewFieldSpec(experimentexposure.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Experiment(); ok {
+		_spec.SetField(experimentexposure.FieldExperiment, field.TypeString, value)
+		_node.Experiment = value
+	}
+	if value, ok := _c.mutation.Variant(); ok {
+		_spec.SetField(experimentexposure.FieldVariant, field.TypeString, value)
+		_node.Variant = value
+	}
+	if value, ok := _c.mutation.Trid(); ok {
+		_spec.SetField(experimentexposure.FieldTrid, field.TypeString, value)
+		_node.Trid = value
+	}
+	if value, ok := _c.mutation.AuditRecordID(); ok {
+		_spec.SetField(experimentexposure.FieldAuditRecordID, field.TypeInt, value)
+		_node.AuditRecordID = &value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(experimentexposure.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.FeedbackScore(); ok {
+		_spec.SetField(experimentexposure.FieldFeedbackScore, field.TypeFloat64, value)
+		_node.FeedbackScore = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(experimentexposure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ExperimentExposureCreateBulk is the builder for creating many ExperimentExposure entities in bulk.
+type ExperimentExposureCreateBulk struct {
+	config
+	err      error
+	builders []*ExperimentExposureCreate
+}
+
+// Save creates the ExperimentExposure entities in the database.
+func (_c *ExperimentExposureCreateBulk) Save(ctx context.Context) ([]*ExperimentExposure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExperimentExposure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExperimentExposureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExperimentExposureCreateBulk) SaveX(ctx context.Context) []*ExperimentExposure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExperimentExposureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExperimentExposureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+)
+
+// ExperimentExposureUpdate is the builder for updating ExperimentExposure entities.
+type ExperimentExposureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExperimentExposureMutation
+}
+
+// Where appends a list predicates to the ExperimentExposureUpdate builder.
+func (_u *ExperimentExposureUpdate) Where(ps ...predicate.ExperimentExposure) *ExperimentExposureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetExperiment sets the "experiment" field.
+func (_u *ExperimentExposureUpdate) SetExperiment(v string) *ExperimentExposureUpdate {
+	_u.mutation.SetExperiment(v)
+	return _u
+}
+
+// SetNillableExperiment sets the "experiment" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableExperiment(v *string) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetExperiment(*v)
+	}
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *ExperimentExposureUpdate) SetVariant(v string) *ExperimentExposureUpdate {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableVariant(v *string) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// SetTrid sets the "trid" field.
+func (_u *ExperimentExposureUpdate) SetTrid(v string) *ExperimentExposureUpdate {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableTrid(v *string) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *ExperimentExposureUpdate) ClearTrid() *ExperimentExposureUpdate {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetAuditRecordID sets the "audit_record_id" field.
+func (_u *ExperimentExposureUpdate) SetAuditRecordID(v int) *ExperimentExposureUpdate {
+	_u.mutation.ResetAuditRecordID()
+	_u.mutation.SetAuditRecordID(v)
+	return _u
+}
+
+// SetNillableAuditRecordID sets the "audit_record_id" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableAuditRecordID(v *int) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetAuditRecordID(*v)
+	}
+	return _u
+}
+
+// AddAuditRecordID adds value to the "audit_record_id" field.
+func (_u *ExperimentExposureUpdate) AddAuditRecordID(v int) *ExperimentExposureUpdate {
+	_u.mutation.AddAuditRecordID(v)
+	return _u
+}
+
+// ClearAuditRecordID clears the value of the "audit_record_id" field.
+func (_u *ExperimentExposureUpdate) ClearAuditRecordID() *ExperimentExposureUpdate {
+	_u.mutation.ClearAuditRecordID()
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *ExperimentExposureUpdate) SetLatencyMs(v int64) *ExperimentExposureUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableLatencyMs(v *int64) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *ExperimentExposureUpdate) AddLatencyMs(v int64) *ExperimentExposureUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetFeedbackScore sets the "feedback_score" field.
+func (_u *ExperimentExposureUpdate) SetFeedbackScore(v float64) *ExperimentExposureUpdate {
+	_u.mutation.ResetFeedbackScore()
+	_u.mutation.SetFeedbackScore(v)
+	return _u
+}
+
+// SetNillableFeedbackScore sets the "feedback_score" field if the given value is not nil.
+func (_u *ExperimentExposureUpdate) SetNillableFeedbackScore(v *float64) *ExperimentExposureUpdate {
+	if v != nil {
+		_u.SetFeedbackScore(*v)
+	}
+	return _u
+}
+
+// AddFeedbackScore adds value to the "feedback_score" field.
+func (_u *ExperimentExposureUpdate) AddFeedbackScore(v float64) *ExperimentExposureUpdate {
+	_u.mutation.AddFeedbackScore(v)
+	return _u
+}
+
+// ClearFeedbackScore clears the value of the "feedback_score" field.
+func (_u *ExperimentExposureUpdate) ClearFeedbackScore() *ExperimentExposureUpdate {
+	_u.mutation.ClearFeedbackScore()
+	return _u
+}
+
+// Mutation returns the ExperimentExposureMutation object of the builder.
+func (_u *ExperimentExposureUpdate) Mutation() *ExperimentExposureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExperimentExposureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExperimentExposureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExperimentExposureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExperimentExposureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExperimentExposureUpdate) check() error {
+	if v, ok := _u.mutation.Experiment(); ok {
+		if err := experimentexposure.ExperimentValidator(v); err != nil {
+			return &ValidationError{Name: "experiment", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.experiment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Variant(); ok {
+		if err := experimentexposure.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.variant": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := experimentexposure.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.latency_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExperimentExposureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(experimentexposure.Table, experimentexposure.Columns, sqlgraph.NewFieldSpec(experimentexposure.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Experiment(); ok {
+		_spec.SetField(experimentexposure.FieldExperiment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(experimentexposure.FieldVariant, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(experimentexposure.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(experimentexposure.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuditRecordID(); ok {
+		_spec.SetField(experimentexposure.FieldAuditRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuditRecordID(); ok {
+		_spec.AddField(experimentexposure.FieldAuditRecordID, field.TypeInt, value)
+	}
+	if _u.mutation.AuditRecordIDCleared() {
+		_spec.ClearField(experimentexposure.FieldAuditRecordID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(experimentexposure.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(experimentexposure.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FeedbackScore(); ok {
+		_spec.SetField(experimentexposure.FieldFeedbackScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeedbackScore(); ok {
+		_spec.AddField(experimentexposure.FieldFeedbackScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeedbackScoreCleared() {
+		_spec.ClearField(experimentexposure.FieldFeedbackScore, field.TypeFloat64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experimentexposure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExperimentExposureUpdateOne is the builder for updating a single ExperimentExposure entity.
+type ExperimentExposureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExperimentExposureMutation
+}
+
+// SetExperiment sets the "experiment" field.
+func (_u *ExperimentExposureUpdateOne) SetExperiment(v string) *ExperimentExposureUpdateOne {
+	_u.mutation.SetExperiment(v)
+	return _u
+}
+
+// SetNillableExperiment sets the "experiment" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableExperiment(v *string) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetExperiment(*v)
+	}
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *ExperimentExposureUpdateOne) SetVariant(v string) *ExperimentExposureUpdateOne {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableVariant(v *string) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// SetTrid sets the "trid" field.
+func (_u *ExperimentExposureUpdateOne) SetTrid(v string) *ExperimentExposureUpdateOne {
+	_u.mutation.SetTrid(v)
+	return _u
+}
+
+// SetNillableTrid sets the "trid" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableTrid(v *string) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetTrid(*v)
+	}
+	return _u
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (_u *ExperimentExposureUpdateOne) ClearTrid() *ExperimentExposureUpdateOne {
+	_u.mutation.ClearTrid()
+	return _u
+}
+
+// SetAuditRecordID sets the "audit_record_id" field.
+func (_u *ExperimentExposureUpdateOne) SetAuditRecordID(v int) *ExperimentExposureUpdateOne {
+	_u.mutation.ResetAuditRecordID()
+	_u.mutation.SetAuditRecordID(v)
+	return _u
+}
+
+// SetNillableAuditRecordID sets the "audit_record_id" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableAuditRecordID(v *int) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetAuditRecordID(*v)
+	}
+	return _u
+}
+
+// AddAuditRecordID adds value to the "audit_record_id" field.
+func (_u *ExperimentExposureUpdateOne) AddAuditRecordID(v int) *ExperimentExposureUpdateOne {
+	_u.mutation.AddAuditRecordID(v)
+	return _u
+}
+
+// ClearAuditRecordID clears the value of the "audit_record_id" field.
+func (_u *ExperimentExposureUpdateOne) ClearAuditRecordID() *ExperimentExposureUpdateOne {
+	_u.mutation.ClearAuditRecordID()
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *ExperimentExposureUpdateOne) SetLatencyMs(v int64) *ExperimentExposureUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableLatencyMs(v *int64) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *ExperimentExposureUpdateOne) AddLatencyMs(v int64) *ExperimentExposureUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetFeedbackScore sets the "feedback_score" field.
+func (_u *ExperimentExposureUpdateOne) SetFeedbackScore(v float64) *ExperimentExposureUpdateOne {
+	_u.mutation.ResetFeedbackScore()
+	_u.mutation.SetFeedbackScore(v)
+	return _u
+}
+
+// SetNillableFeedbackScore sets the "feedback_score" field if the given value is not nil.
+func (_u *ExperimentExposureUpdateOne) SetNillableFeedbackScore(v *float64) *ExperimentExposureUpdateOne {
+	if v != nil {
+		_u.SetFeedbackScore(*v)
+	}
+	return _u
+}
+
+// AddFeedbackScore adds value to the "feedback_score" field.
+func (_u *ExperimentExposureUpdateOne) AddFeedbackScore(v float64) *ExperimentExposureUpdateOne {
+	_u.mutation.AddFeedbackScore(v)
+	return _u
+}
+
+// ClearFeedbackScore clears the value of the "feedback_score" field.
+func (_u *ExperimentExposureUpdateOne) ClearFeedbackScore() *ExperimentExposureUpdateOne {
+	_u.mutation.ClearFeedbackScore()
+	return _u
+}
+
+// Mutation returns the ExperimentExposureMutation object of the builder.
+func (_u *ExperimentExposureUpdateOne) Mutation() *ExperimentExposureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExperimentExposureUpdate builder.
+func (_u *ExperimentExposureUpdateOne) Where(ps ...predicate.ExperimentExposure) *ExperimentExposureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExperimentExposureUpdateOne) Select(field string, fields ...string) *ExperimentExposureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExperimentExposure entity.
+func (_u *ExperimentExposureUpdateOne) Save(ctx context.Context) (*ExperimentExposure, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExperimentExposureUpdateOne) SaveX(ctx context.Context) *ExperimentExposure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExperimentExposureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExperimentExposureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExperimentExposureUpdateOne) check() error {
+	if v, ok := _u.mutation.Experiment(); ok {
+		if err := experimentexposure.ExperimentValidator(v); err != nil {
+			return &ValidationError{Name: "experiment", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.experiment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Variant(); ok {
+		if err := experimentexposure.VariantValidator(v); err != nil {
+			return &ValidationError{Name: "variant", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.variant": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := experimentexposure.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "ExperimentExposure.latency_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExperimentExposureUpdateOne) sqlSave(ctx context.Context) (_node *ExperimentExposure, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(experimentexposure.Table, experimentexposure.Columns, sqlgraph.NewFieldSpec(experimentexposure.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExperimentExposure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, experimentexposure.FieldID)
+		for _, f := range fields {
+			if !experimentexposure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != experimentexposure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Experiment(); ok {
+		_spec.SetField(experimentexposure.FieldExperiment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(experimentexposure.FieldVariant, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trid(); ok {
+		_spec.SetField(experimentexposure.FieldTrid, field.TypeString, value)
+	}
+	if _u.mutation.TridCleared() {
+		_spec.ClearField(experimentexposure.FieldTrid, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuditRecordID(); ok {
+		_spec.SetField(experimentexposure.FieldAuditRecordID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAuditRecordID(); ok {
+		_spec.AddField(experimentexposure.FieldAuditRecordID, field.TypeInt, value)
+	}
+	if _u.mutation.AuditRecordIDCleared() {
+		_spec.ClearField(experimentexposure.FieldAuditRecordID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(experimentexposure.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(experimentexposure.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FeedbackScore(); ok {
+		_spec.SetField(experimentexposure.FieldFeedbackScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeedbackScore(); ok {
+		_spec.AddField(experimentexposure.FieldFeedbackScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeedbackScoreCleared() {
+		_spec.ClearField(experimentexposure.FieldFeedbackScore, field.TypeFloat64)
+	}
+	_node = &ExperimentExposure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experimentexposure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
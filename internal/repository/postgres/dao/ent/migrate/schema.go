@@ -9,6 +9,179 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "secret_hash", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_name",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[2]},
+			},
+		},
+	}
+	// AnswerFeedbacksColumns holds the columns for the "answer_feedbacks" table.
+	AnswerFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "answer_id", Type: field.TypeInt},
+		{Name: "rating", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "correction", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "knowledge_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "api_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AnswerFeedbacksTable holds the schema information for the "answer_feedbacks" table.
+	AnswerFeedbacksTable = &schema.Table{
+		Name:       "answer_feedbacks",
+		Columns:    AnswerFeedbacksColumns,
+		PrimaryKey: []*schema.Column{AnswerFeedbacksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "answerfeedback_answer_id",
+				Unique:  true,
+				Columns: []*schema.Column{AnswerFeedbacksColumns[1]},
+			},
+			{
+				Name:    "answerfeedback_rating_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AnswerFeedbacksColumns[2], AnswerFeedbacksColumns[8]},
+			},
+		},
+	}
+	// AuditRecordsColumns holds the columns for the "audit_records" table.
+	AuditRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "trid", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "api_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "question", Type: field.TypeString, Size: 2147483647},
+		{Name: "question_embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
+		{Name: "intent", Type: field.TypeString, Nullable: true},
+		{Name: "knowledge_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "similarity_scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "template_name", Type: field.TypeString, Nullable: true},
+		{Name: "template_version", Type: field.TypeInt, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "experiment", Type: field.TypeString, Nullable: true},
+		{Name: "variant", Type: field.TypeString, Nullable: true},
+		{Name: "raw_output", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "handoff", Type: field.TypeBool, Default: false},
+		{Name: "embedding_latency_ms", Type: field.TypeInt64},
+		{Name: "vector_search_latency_ms", Type: field.TypeInt64},
+		{Name: "llm_latency_ms", Type: field.TypeInt64},
+		{Name: "total_latency_ms", Type: field.TypeInt64},
+		{Name: "error_code", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditRecordsTable holds the schema information for the "audit_records" table.
+	AuditRecordsTable = &schema.Table{
+		Name:       "audit_records",
+		Columns:    AuditRecordsColumns,
+		PrimaryKey: []*schema.Column{AuditRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditrecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[23]},
+			},
+			{
+				Name:    "auditrecord_intent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[6], AuditRecordsColumns[23]},
+			},
+			{
+				Name:    "auditrecord_error_code_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[21], AuditRecordsColumns[23]},
+			},
+			{
+				Name:    "auditrecord_trid",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[1]},
+			},
+		},
+	}
+	// ConversationTurnsColumns holds the columns for the "conversation_turns" table.
+	ConversationTurnsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "trid", Type: field.TypeString, Nullable: true},
+		{Name: "question", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ConversationTurnsTable holds the schema information for the "conversation_turns" table.
+	ConversationTurnsTable = &schema.Table{
+		Name:       "conversation_turns",
+		Columns:    ConversationTurnsColumns,
+		PrimaryKey: []*schema.Column{ConversationTurnsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_turns_users_conversation_turns",
+				Columns:    []*schema.Column{ConversationTurnsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationturn_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationTurnsColumns[5], ConversationTurnsColumns[4]},
+			},
+		},
+	}
+	// ExperimentExposuresColumns holds the columns for the "experiment_exposures" table.
+	ExperimentExposuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "experiment", Type: field.TypeString},
+		{Name: "variant", Type: field.TypeString},
+		{Name: "trid", Type: field.TypeString, Nullable: true},
+		{Name: "audit_record_id", Type: field.TypeInt, Nullable: true},
+		{Name: "latency_ms", Type: field.TypeInt64},
+		{Name: "feedback_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ExperimentExposuresTable holds the schema information for the "experiment_exposures" table.
+	ExperimentExposuresTable = &schema.Table{
+		Name:       "experiment_exposures",
+		Columns:    ExperimentExposuresColumns,
+		PrimaryKey: []*schema.Column{ExperimentExposuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "experimentexposure_experiment_variant",
+				Unique:  false,
+				Columns: []*schema.Column{ExperimentExposuresColumns[1], ExperimentExposuresColumns[2]},
+			},
+			{
+				Name:    "experimentexposure_trid",
+				Unique:  false,
+				Columns: []*schema.Column{ExperimentExposuresColumns[3]},
+			},
+			{
+				Name:    "experimentexposure_audit_record_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExperimentExposuresColumns[4]},
+			},
+		},
+	}
 	// InquiryKnowledgesColumns holds the columns for the "inquiry_knowledges" table.
 	InquiryKnowledgesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -38,11 +211,33 @@ var (
 			},
 		},
 	}
+	// RateLimitBucketsColumns holds the columns for the "rate_limit_buckets" table.
+	RateLimitBucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tokens", Type: field.TypeFloat64},
+		{Name: "allowed", Type: field.TypeBool, Default: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RateLimitBucketsTable holds the schema information for the "rate_limit_buckets" table.
+	RateLimitBucketsTable = &schema.Table{
+		Name:       "rate_limit_buckets",
+		Columns:    RateLimitBucketsColumns,
+		PrimaryKey: []*schema.Column{RateLimitBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimitbucket_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitBucketsColumns[3]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
-		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -51,19 +246,60 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "user_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[1], UsersColumns[2]},
+			},
+			{
+				Name:    "user_email",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[4]},
+			},
 			{
 				Name:    "user_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[3]},
+				Columns: []*schema.Column{UsersColumns[5]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AnswerFeedbacksTable,
+		AuditRecordsTable,
+		ConversationTurnsTable,
+		ExperimentExposuresTable,
 		InquiryKnowledgesTable,
+		RateLimitBucketsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	APIKeysTable.Annotation = &entsql.Annotation{
+		Table: "api_keys",
+	}
+	AnswerFeedbacksTable.Annotation = &entsql.Annotation{
+		Table: "answer_feedbacks",
+	}
+	AuditRecordsTable.Annotation = &entsql.Annotation{
+		Table: "audit_records",
+	}
+	ConversationTurnsTable.ForeignKeys[0].RefTable = UsersTable
+	ConversationTurnsTable.Annotation = &entsql.Annotation{
+		Table: "conversation_turns",
+	}
+	ExperimentExposuresTable.Annotation = &entsql.Annotation{
+		Table: "experiment_exposures",
+	}
+	InquiryKnowledgesTable.Annotation = &entsql.Annotation{
+		Table: "inquiry_knowledges",
+	}
+	RateLimitBucketsTable.Annotation = &entsql.Annotation{
+		Table: "rate_limit_buckets",
+	}
+	UsersTable.Annotation = &entsql.Annotation{
+		Table: "users",
+	}
 }
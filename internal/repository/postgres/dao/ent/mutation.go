@@ -12,8 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	pgvector "github.com/pgvector/pgvector-go"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/apikey"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/inquiryknowledge"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/predicate"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/ratelimitbucket"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
 )
 
@@ -26,41 +32,47 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeInquiryKnowledge = "InquiryKnowledge"
-	TypeUser             = "User"
+	TypeAPIKey             = "APIKey"
+	TypeAnswerFeedback     = "AnswerFeedback"
+	TypeAuditRecord        = "AuditRecord"
+	TypeConversationTurn   = "ConversationTurn"
+	TypeExperimentExposure = "ExperimentExposure"
+	TypeInquiryKnowledge   = "InquiryKnowledge"
+	TypeRateLimitBucket    = "RateLimitBucket"
+	TypeUser               = "User"
 )
 
-// InquiryKnowledgeMutation represents an operation that mutates the InquiryKnowledge nodes in the graph.
-type InquiryKnowledgeMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	instruction           *string
-	instruction_embedding *pgvector.Vector
-	response              *string
-	category              *string
-	intent                *string
-	flags                 *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*InquiryKnowledge, error)
-	predicates            []predicate.InquiryKnowledge
+	op            Op
+	typ           string
+	id            *int
+	key_id        *string
+	name          *string
+	secret_hash   *string
+	scopes        *[]string
+	appendscopes  []string
+	created_at    *time.Time
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*InquiryKnowledgeMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// inquiryknowledgeOption allows management of the mutation configuration using functional options.
-type inquiryknowledgeOption func(*InquiryKnowledgeMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newInquiryKnowledgeMutation creates new mutation for the InquiryKnowledge entity.
-func newInquiryKnowledgeMutation(c config, op Op, opts ...inquiryknowledgeOption) *InquiryKnowledgeMutation {
-	m := &InquiryKnowledgeMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInquiryKnowledge,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -69,20 +81,20 @@ func newInquiryKnowledgeMutation(c config, op Op, opts ...inquiryknowledgeOption
 	return m
 }
 
-// withInquiryKnowledgeID sets the ID field of the mutation.
-func withInquiryKnowledgeID(id int) inquiryknowledgeOption {
-	return func(m *InquiryKnowledgeMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id int) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *InquiryKnowledge
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*InquiryKnowledge, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().InquiryKnowledge.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -91,10 +103,10 @@ func withInquiryKnowledgeID(id int) inquiryknowledgeOption {
 	}
 }
 
-// withInquiryKnowledge sets the old InquiryKnowledge of the mutation.
-func withInquiryKnowledge(node *InquiryKnowledge) inquiryknowledgeOption {
-	return func(m *InquiryKnowledgeMutation) {
-		m.oldValue = func(context.Context) (*InquiryKnowledge, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -103,7 +115,7 @@ func withInquiryKnowledge(node *InquiryKnowledge) inquiryknowledgeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InquiryKnowledgeMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -111,7 +123,7 @@ func (m InquiryKnowledgeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InquiryKnowledgeMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -121,14 +133,14 @@ func (m InquiryKnowledgeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of InquiryKnowledge entities.
-func (m *InquiryKnowledgeMutation) SetID(id int) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InquiryKnowledgeMutation) ID() (id int, exists bool) {
+func (m *APIKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -139,7 +151,7 @@ func (m *InquiryKnowledgeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InquiryKnowledgeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -148,410 +160,360 @@ func (m *InquiryKnowledgeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().InquiryKnowledge.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetInstruction sets the "instruction" field.
-func (m *InquiryKnowledgeMutation) SetInstruction(s string) {
-	m.instruction = &s
+// SetKeyID sets the "key_id" field.
+func (m *APIKeyMutation) SetKeyID(s string) {
+	m.key_id = &s
 }
 
-// Instruction returns the value of the "instruction" field in the mutation.
-func (m *InquiryKnowledgeMutation) Instruction() (r string, exists bool) {
-	v := m.instruction
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *APIKeyMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInstruction returns the old "instruction" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyID returns the old "key_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldInstruction(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInstruction is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInstruction requires an ID field in the mutation")
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInstruction: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
 	}
-	return oldValue.Instruction, nil
+	return oldValue.KeyID, nil
 }
 
-// ResetInstruction resets all changes to the "instruction" field.
-func (m *InquiryKnowledgeMutation) ResetInstruction() {
-	m.instruction = nil
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *APIKeyMutation) ResetKeyID() {
+	m.key_id = nil
 }
 
-// SetInstructionEmbedding sets the "instruction_embedding" field.
-func (m *InquiryKnowledgeMutation) SetInstructionEmbedding(pg pgvector.Vector) {
-	m.instruction_embedding = &pg
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// InstructionEmbedding returns the value of the "instruction_embedding" field in the mutation.
-func (m *InquiryKnowledgeMutation) InstructionEmbedding() (r pgvector.Vector, exists bool) {
-	v := m.instruction_embedding
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInstructionEmbedding returns the old "instruction_embedding" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldInstructionEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInstructionEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInstructionEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInstructionEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.InstructionEmbedding, nil
-}
-
-// ClearInstructionEmbedding clears the value of the "instruction_embedding" field.
-func (m *InquiryKnowledgeMutation) ClearInstructionEmbedding() {
-	m.instruction_embedding = nil
-	m.clearedFields[inquiryknowledge.FieldInstructionEmbedding] = struct{}{}
-}
-
-// InstructionEmbeddingCleared returns if the "instruction_embedding" field was cleared in this mutation.
-func (m *InquiryKnowledgeMutation) InstructionEmbeddingCleared() bool {
-	_, ok := m.clearedFields[inquiryknowledge.FieldInstructionEmbedding]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetInstructionEmbedding resets all changes to the "instruction_embedding" field.
-func (m *InquiryKnowledgeMutation) ResetInstructionEmbedding() {
-	m.instruction_embedding = nil
-	delete(m.clearedFields, inquiryknowledge.FieldInstructionEmbedding)
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetResponse sets the "response" field.
-func (m *InquiryKnowledgeMutation) SetResponse(s string) {
-	m.response = &s
+// SetSecretHash sets the "secret_hash" field.
+func (m *APIKeyMutation) SetSecretHash(s string) {
+	m.secret_hash = &s
 }
 
-// Response returns the value of the "response" field in the mutation.
-func (m *InquiryKnowledgeMutation) Response() (r string, exists bool) {
-	v := m.response
+// SecretHash returns the value of the "secret_hash" field in the mutation.
+func (m *APIKeyMutation) SecretHash() (r string, exists bool) {
+	v := m.secret_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResponse returns the old "response" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretHash returns the old "secret_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldResponse(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldSecretHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponse requires an ID field in the mutation")
+		return v, errors.New("OldSecretHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretHash: %w", err)
 	}
-	return oldValue.Response, nil
+	return oldValue.SecretHash, nil
 }
 
-// ResetResponse resets all changes to the "response" field.
-func (m *InquiryKnowledgeMutation) ResetResponse() {
-	m.response = nil
+// ResetSecretHash resets all changes to the "secret_hash" field.
+func (m *APIKeyMutation) ResetSecretHash() {
+	m.secret_hash = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *InquiryKnowledgeMutation) SetCategory(s string) {
-	m.category = &s
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *InquiryKnowledgeMutation) Category() (r string, exists bool) {
-	v := m.category
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.Scopes, nil
 }
 
-// ClearCategory clears the value of the "category" field.
-func (m *InquiryKnowledgeMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[inquiryknowledge.FieldCategory] = struct{}{}
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *InquiryKnowledgeMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[inquiryknowledge.FieldCategory]
-	return ok
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *InquiryKnowledgeMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, inquiryknowledge.FieldCategory)
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// SetIntent sets the "intent" field.
-func (m *InquiryKnowledgeMutation) SetIntent(s string) {
-	m.intent = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Intent returns the value of the "intent" field in the mutation.
-func (m *InquiryKnowledgeMutation) Intent() (r string, exists bool) {
-	v := m.intent
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntent returns the old "intent" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldIntent(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntent is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntent requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntent: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Intent, nil
-}
-
-// ClearIntent clears the value of the "intent" field.
-func (m *InquiryKnowledgeMutation) ClearIntent() {
-	m.intent = nil
-	m.clearedFields[inquiryknowledge.FieldIntent] = struct{}{}
-}
-
-// IntentCleared returns if the "intent" field was cleared in this mutation.
-func (m *InquiryKnowledgeMutation) IntentCleared() bool {
-	_, ok := m.clearedFields[inquiryknowledge.FieldIntent]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIntent resets all changes to the "intent" field.
-func (m *InquiryKnowledgeMutation) ResetIntent() {
-	m.intent = nil
-	delete(m.clearedFields, inquiryknowledge.FieldIntent)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFlags sets the "flags" field.
-func (m *InquiryKnowledgeMutation) SetFlags(s string) {
-	m.flags = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Flags returns the value of the "flags" field in the mutation.
-func (m *InquiryKnowledgeMutation) Flags() (r string, exists bool) {
-	v := m.flags
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlags returns the old "flags" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldFlags(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlags is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlags requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlags: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Flags, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearFlags clears the value of the "flags" field.
-func (m *InquiryKnowledgeMutation) ClearFlags() {
-	m.flags = nil
-	m.clearedFields[inquiryknowledge.FieldFlags] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// FlagsCleared returns if the "flags" field was cleared in this mutation.
-func (m *InquiryKnowledgeMutation) FlagsCleared() bool {
-	_, ok := m.clearedFields[inquiryknowledge.FieldFlags]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
 	return ok
 }
 
-// ResetFlags resets all changes to the "flags" field.
-func (m *InquiryKnowledgeMutation) ResetFlags() {
-	m.flags = nil
-	delete(m.clearedFields, inquiryknowledge.FieldFlags)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *InquiryKnowledgeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InquiryKnowledgeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InquiryKnowledgeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *InquiryKnowledgeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *InquiryKnowledgeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the InquiryKnowledge entity.
-// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryKnowledgeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *InquiryKnowledgeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the InquiryKnowledgeMutation builder.
-func (m *InquiryKnowledgeMutation) Where(ps ...predicate.InquiryKnowledge) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the InquiryKnowledgeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InquiryKnowledgeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.InquiryKnowledge, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *InquiryKnowledgeMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InquiryKnowledgeMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (InquiryKnowledge).
-func (m *InquiryKnowledgeMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InquiryKnowledgeMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.instruction != nil {
-		fields = append(fields, inquiryknowledge.FieldInstruction)
-	}
-	if m.instruction_embedding != nil {
-		fields = append(fields, inquiryknowledge.FieldInstructionEmbedding)
-	}
-	if m.response != nil {
-		fields = append(fields, inquiryknowledge.FieldResponse)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.key_id != nil {
+		fields = append(fields, apikey.FieldKeyID)
 	}
-	if m.category != nil {
-		fields = append(fields, inquiryknowledge.FieldCategory)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.intent != nil {
-		fields = append(fields, inquiryknowledge.FieldIntent)
+	if m.secret_hash != nil {
+		fields = append(fields, apikey.FieldSecretHash)
 	}
-	if m.flags != nil {
-		fields = append(fields, inquiryknowledge.FieldFlags)
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, inquiryknowledge.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, inquiryknowledge.FieldUpdatedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -559,24 +521,22 @@ func (m *InquiryKnowledgeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *InquiryKnowledgeMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case inquiryknowledge.FieldInstruction:
-		return m.Instruction()
-	case inquiryknowledge.FieldInstructionEmbedding:
-		return m.InstructionEmbedding()
-	case inquiryknowledge.FieldResponse:
-		return m.Response()
-	case inquiryknowledge.FieldCategory:
-		return m.Category()
-	case inquiryknowledge.FieldIntent:
-		return m.Intent()
-	case inquiryknowledge.FieldFlags:
-		return m.Flags()
-	case inquiryknowledge.FieldCreatedAt:
+	case apikey.FieldKeyID:
+		return m.KeyID()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldSecretHash:
+		return m.SecretHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case inquiryknowledge.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -584,83 +544,5757 @@ func (m *InquiryKnowledgeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InquiryKnowledgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case inquiryknowledge.FieldInstruction:
-		return m.OldInstruction(ctx)
-	case inquiryknowledge.FieldInstructionEmbedding:
-		return m.OldInstructionEmbedding(ctx)
-	case inquiryknowledge.FieldResponse:
-		return m.OldResponse(ctx)
-	case inquiryknowledge.FieldCategory:
-		return m.OldCategory(ctx)
-	case inquiryknowledge.FieldIntent:
-		return m.OldIntent(ctx)
-	case inquiryknowledge.FieldFlags:
-		return m.OldFlags(ctx)
-	case inquiryknowledge.FieldCreatedAt:
+	case apikey.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldSecretHash:
+		return m.OldSecretHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case inquiryknowledge.FieldUpdatedAt:
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apikey.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldSecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIKeyMutation) ResetField(name string) error {
+	switch name {
+	case apikey.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldSecretHash:
+		m.ResetSecretHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
+}
+
+// AnswerFeedbackMutation represents an operation that mutates the AnswerFeedback nodes in the graph.
+type AnswerFeedbackMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	answer_id           *int
+	addanswer_id        *int
+	rating              *string
+	reason              *string
+	correction          *string
+	knowledge_ids       *[]int
+	appendknowledge_ids []int
+	user_id             *int
+	adduser_id          *int
+	api_key_id          *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*AnswerFeedback, error)
+	predicates          []predicate.AnswerFeedback
+}
+
+var _ ent.Mutation = (*AnswerFeedbackMutation)(nil)
+
+// answerfeedbackOption allows management of the mutation configuration using functional options.
+type answerfeedbackOption func(*AnswerFeedbackMutation)
+
+// newAnswerFeedbackMutation creates new mutation for the AnswerFeedback entity.
+func newAnswerFeedbackMutation(c config, op Op, opts ...answerfeedbackOption) *AnswerFeedbackMutation {
+	m := &AnswerFeedbackMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAnswerFeedback,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAnswerFeedbackID sets the ID field of the mutation.
+func withAnswerFeedbackID(id int) answerfeedbackOption {
+	return func(m *AnswerFeedbackMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AnswerFeedback
+		)
+		m.oldValue = func(ctx context.Context) (*AnswerFeedback, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AnswerFeedback.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAnswerFeedback sets the old AnswerFeedback of the mutation.
+func withAnswerFeedback(node *AnswerFeedback) answerfeedbackOption {
+	return func(m *AnswerFeedbackMutation) {
+		m.oldValue = func(context.Context) (*AnswerFeedback, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AnswerFeedbackMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AnswerFeedbackMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AnswerFeedback entities.
+func (m *AnswerFeedbackMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AnswerFeedbackMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AnswerFeedbackMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AnswerFeedback.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAnswerID sets the "answer_id" field.
+func (m *AnswerFeedbackMutation) SetAnswerID(i int) {
+	m.answer_id = &i
+	m.addanswer_id = nil
+}
+
+// AnswerID returns the value of the "answer_id" field in the mutation.
+func (m *AnswerFeedbackMutation) AnswerID() (r int, exists bool) {
+	v := m.answer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerID returns the old "answer_id" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldAnswerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerID: %w", err)
+	}
+	return oldValue.AnswerID, nil
+}
+
+// AddAnswerID adds i to the "answer_id" field.
+func (m *AnswerFeedbackMutation) AddAnswerID(i int) {
+	if m.addanswer_id != nil {
+		*m.addanswer_id += i
+	} else {
+		m.addanswer_id = &i
+	}
+}
+
+// AddedAnswerID returns the value that was added to the "answer_id" field in this mutation.
+func (m *AnswerFeedbackMutation) AddedAnswerID() (r int, exists bool) {
+	v := m.addanswer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnswerID resets all changes to the "answer_id" field.
+func (m *AnswerFeedbackMutation) ResetAnswerID() {
+	m.answer_id = nil
+	m.addanswer_id = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *AnswerFeedbackMutation) SetRating(s string) {
+	m.rating = &s
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *AnswerFeedbackMutation) Rating() (r string, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldRating(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *AnswerFeedbackMutation) ResetRating() {
+	m.rating = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *AnswerFeedbackMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *AnswerFeedbackMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *AnswerFeedbackMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[answerfeedback.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *AnswerFeedbackMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[answerfeedback.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *AnswerFeedbackMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, answerfeedback.FieldReason)
+}
+
+// SetCorrection sets the "correction" field.
+func (m *AnswerFeedbackMutation) SetCorrection(s string) {
+	m.correction = &s
+}
+
+// Correction returns the value of the "correction" field in the mutation.
+func (m *AnswerFeedbackMutation) Correction() (r string, exists bool) {
+	v := m.correction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrection returns the old "correction" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldCorrection(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrection: %w", err)
+	}
+	return oldValue.Correction, nil
+}
+
+// ClearCorrection clears the value of the "correction" field.
+func (m *AnswerFeedbackMutation) ClearCorrection() {
+	m.correction = nil
+	m.clearedFields[answerfeedback.FieldCorrection] = struct{}{}
+}
+
+// CorrectionCleared returns if the "correction" field was cleared in this mutation.
+func (m *AnswerFeedbackMutation) CorrectionCleared() bool {
+	_, ok := m.clearedFields[answerfeedback.FieldCorrection]
+	return ok
+}
+
+// ResetCorrection resets all changes to the "correction" field.
+func (m *AnswerFeedbackMutation) ResetCorrection() {
+	m.correction = nil
+	delete(m.clearedFields, answerfeedback.FieldCorrection)
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (m *AnswerFeedbackMutation) SetKnowledgeIds(i []int) {
+	m.knowledge_ids = &i
+	m.appendknowledge_ids = nil
+}
+
+// KnowledgeIds returns the value of the "knowledge_ids" field in the mutation.
+func (m *AnswerFeedbackMutation) KnowledgeIds() (r []int, exists bool) {
+	v := m.knowledge_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKnowledgeIds returns the old "knowledge_ids" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldKnowledgeIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnowledgeIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnowledgeIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnowledgeIds: %w", err)
+	}
+	return oldValue.KnowledgeIds, nil
+}
+
+// AppendKnowledgeIds adds i to the "knowledge_ids" field.
+func (m *AnswerFeedbackMutation) AppendKnowledgeIds(i []int) {
+	m.appendknowledge_ids = append(m.appendknowledge_ids, i...)
+}
+
+// AppendedKnowledgeIds returns the list of values that were appended to the "knowledge_ids" field in this mutation.
+func (m *AnswerFeedbackMutation) AppendedKnowledgeIds() ([]int, bool) {
+	if len(m.appendknowledge_ids) == 0 {
+		return nil, false
+	}
+	return m.appendknowledge_ids, true
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (m *AnswerFeedbackMutation) ClearKnowledgeIds() {
+	m.knowledge_ids = nil
+	m.appendknowledge_ids = nil
+	m.clearedFields[answerfeedback.FieldKnowledgeIds] = struct{}{}
+}
+
+// KnowledgeIdsCleared returns if the "knowledge_ids" field was cleared in this mutation.
+func (m *AnswerFeedbackMutation) KnowledgeIdsCleared() bool {
+	_, ok := m.clearedFields[answerfeedback.FieldKnowledgeIds]
+	return ok
+}
+
+// ResetKnowledgeIds resets all changes to the "knowledge_ids" field.
+func (m *AnswerFeedbackMutation) ResetKnowledgeIds() {
+	m.knowledge_ids = nil
+	m.appendknowledge_ids = nil
+	delete(m.clearedFields, answerfeedback.FieldKnowledgeIds)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AnswerFeedbackMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AnswerFeedbackMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *AnswerFeedbackMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *AnswerFeedbackMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AnswerFeedbackMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[answerfeedback.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AnswerFeedbackMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[answerfeedback.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AnswerFeedbackMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, answerfeedback.FieldUserID)
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (m *AnswerFeedbackMutation) SetAPIKeyID(s string) {
+	m.api_key_id = &s
+}
+
+// APIKeyID returns the value of the "api_key_id" field in the mutation.
+func (m *AnswerFeedbackMutation) APIKeyID() (r string, exists bool) {
+	v := m.api_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyID returns the old "api_key_id" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldAPIKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
+	}
+	return oldValue.APIKeyID, nil
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (m *AnswerFeedbackMutation) ClearAPIKeyID() {
+	m.api_key_id = nil
+	m.clearedFields[answerfeedback.FieldAPIKeyID] = struct{}{}
+}
+
+// APIKeyIDCleared returns if the "api_key_id" field was cleared in this mutation.
+func (m *AnswerFeedbackMutation) APIKeyIDCleared() bool {
+	_, ok := m.clearedFields[answerfeedback.FieldAPIKeyID]
+	return ok
+}
+
+// ResetAPIKeyID resets all changes to the "api_key_id" field.
+func (m *AnswerFeedbackMutation) ResetAPIKeyID() {
+	m.api_key_id = nil
+	delete(m.clearedFields, answerfeedback.FieldAPIKeyID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AnswerFeedbackMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AnswerFeedbackMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AnswerFeedback entity.
+// If the AnswerFeedback object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnswerFeedbackMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AnswerFeedbackMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AnswerFeedbackMutation builder.
+func (m *AnswerFeedbackMutation) Where(ps ...predicate.AnswerFeedback) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AnswerFeedbackMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnswerFeedbackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnswerFeedback, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AnswerFeedbackMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AnswerFeedbackMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AnswerFeedback).
+func (m *AnswerFeedbackMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnswerFeedbackMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.answer_id != nil {
+		fields = append(fields, answerfeedback.FieldAnswerID)
+	}
+	if m.rating != nil {
+		fields = append(fields, answerfeedback.FieldRating)
+	}
+	if m.reason != nil {
+		fields = append(fields, answerfeedback.FieldReason)
+	}
+	if m.correction != nil {
+		fields = append(fields, answerfeedback.FieldCorrection)
+	}
+	if m.knowledge_ids != nil {
+		fields = append(fields, answerfeedback.FieldKnowledgeIds)
+	}
+	if m.user_id != nil {
+		fields = append(fields, answerfeedback.FieldUserID)
+	}
+	if m.api_key_id != nil {
+		fields = append(fields, answerfeedback.FieldAPIKeyID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, answerfeedback.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AnswerFeedbackMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		return m.AnswerID()
+	case answerfeedback.FieldRating:
+		return m.Rating()
+	case answerfeedback.FieldReason:
+		return m.Reason()
+	case answerfeedback.FieldCorrection:
+		return m.Correction()
+	case answerfeedback.FieldKnowledgeIds:
+		return m.KnowledgeIds()
+	case answerfeedback.FieldUserID:
+		return m.UserID()
+	case answerfeedback.FieldAPIKeyID:
+		return m.APIKeyID()
+	case answerfeedback.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AnswerFeedbackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		return m.OldAnswerID(ctx)
+	case answerfeedback.FieldRating:
+		return m.OldRating(ctx)
+	case answerfeedback.FieldReason:
+		return m.OldReason(ctx)
+	case answerfeedback.FieldCorrection:
+		return m.OldCorrection(ctx)
+	case answerfeedback.FieldKnowledgeIds:
+		return m.OldKnowledgeIds(ctx)
+	case answerfeedback.FieldUserID:
+		return m.OldUserID(ctx)
+	case answerfeedback.FieldAPIKeyID:
+		return m.OldAPIKeyID(ctx)
+	case answerfeedback.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AnswerFeedback field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnswerFeedbackMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerID(v)
+		return nil
+	case answerfeedback.FieldRating:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case answerfeedback.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case answerfeedback.FieldCorrection:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrection(v)
+		return nil
+	case answerfeedback.FieldKnowledgeIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKnowledgeIds(v)
+		return nil
+	case answerfeedback.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case answerfeedback.FieldAPIKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyID(v)
+		return nil
+	case answerfeedback.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AnswerFeedback field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AnswerFeedbackMutation) AddedFields() []string {
+	var fields []string
+	if m.addanswer_id != nil {
+		fields = append(fields, answerfeedback.FieldAnswerID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, answerfeedback.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AnswerFeedbackMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		return m.AddedAnswerID()
+	case answerfeedback.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnswerFeedbackMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerID(v)
+		return nil
+	case answerfeedback.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AnswerFeedback numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AnswerFeedbackMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(answerfeedback.FieldReason) {
+		fields = append(fields, answerfeedback.FieldReason)
+	}
+	if m.FieldCleared(answerfeedback.FieldCorrection) {
+		fields = append(fields, answerfeedback.FieldCorrection)
+	}
+	if m.FieldCleared(answerfeedback.FieldKnowledgeIds) {
+		fields = append(fields, answerfeedback.FieldKnowledgeIds)
+	}
+	if m.FieldCleared(answerfeedback.FieldUserID) {
+		fields = append(fields, answerfeedback.FieldUserID)
+	}
+	if m.FieldCleared(answerfeedback.FieldAPIKeyID) {
+		fields = append(fields, answerfeedback.FieldAPIKeyID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AnswerFeedbackMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AnswerFeedbackMutation) ClearField(name string) error {
+	switch name {
+	case answerfeedback.FieldReason:
+		m.ClearReason()
+		return nil
+	case answerfeedback.FieldCorrection:
+		m.ClearCorrection()
+		return nil
+	case answerfeedback.FieldKnowledgeIds:
+		m.ClearKnowledgeIds()
+		return nil
+	case answerfeedback.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case answerfeedback.FieldAPIKeyID:
+		m.ClearAPIKeyID()
+		return nil
+	}
+	return fmt.Errorf("unknown AnswerFeedback nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnswerFeedbackMutation) ResetField(name string) error {
+	switch name {
+	case answerfeedback.FieldAnswerID:
+		m.ResetAnswerID()
+		return nil
+	case answerfeedback.FieldRating:
+		m.ResetRating()
+		return nil
+	case answerfeedback.FieldReason:
+		m.ResetReason()
+		return nil
+	case answerfeedback.FieldCorrection:
+		m.ResetCorrection()
+		return nil
+	case answerfeedback.FieldKnowledgeIds:
+		m.ResetKnowledgeIds()
+		return nil
+	case answerfeedback.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case answerfeedback.FieldAPIKeyID:
+		m.ResetAPIKeyID()
+		return nil
+	case answerfeedback.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AnswerFeedback field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AnswerFeedbackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AnswerFeedbackMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AnswerFeedbackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AnswerFeedbackMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AnswerFeedbackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AnswerFeedbackMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AnswerFeedbackMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AnswerFeedback unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AnswerFeedbackMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AnswerFeedback edge %s", name)
+}
+
+// AuditRecordMutation represents an operation that mutates the AuditRecord nodes in the graph.
+type AuditRecordMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	trid                        *string
+	user_id                     *int
+	adduser_id                  *int
+	api_key_id                  *string
+	question                    *string
+	question_embedding          *pgvector.Vector
+	intent                      *string
+	knowledge_ids               *[]int
+	appendknowledge_ids         []int
+	similarity_scores           *[]float64
+	appendsimilarity_scores     []float64
+	template_name               *string
+	template_version            *int
+	addtemplate_version         *int
+	model                       *string
+	experiment                  *string
+	variant                     *string
+	raw_output                  *string
+	answer                      *string
+	handoff                     *bool
+	embedding_latency_ms        *int64
+	addembedding_latency_ms     *int64
+	vector_search_latency_ms    *int64
+	addvector_search_latency_ms *int64
+	llm_latency_ms              *int64
+	addllm_latency_ms           *int64
+	total_latency_ms            *int64
+	addtotal_latency_ms         *int64
+	error_code                  *string
+	error                       *string
+	created_at                  *time.Time
+	clearedFields               map[string]struct{}
+	done                        bool
+	oldValue                    func(context.Context) (*AuditRecord, error)
+	predicates                  []predicate.AuditRecord
+}
+
+var _ ent.Mutation = (*AuditRecordMutation)(nil)
+
+// auditrecordOption allows management of the mutation configuration using functional options.
+type auditrecordOption func(*AuditRecordMutation)
+
+// newAuditRecordMutation creates new mutation for the AuditRecord entity.
+func newAuditRecordMutation(c config, op Op, opts ...auditrecordOption) *AuditRecordMutation {
+	m := &AuditRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAuditRecordID sets the ID field of the mutation.
+func withAuditRecordID(id int) auditrecordOption {
+	return func(m *AuditRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditRecord
+		)
+		m.oldValue = func(ctx context.Context) (*AuditRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAuditRecord sets the old AuditRecord of the mutation.
+func withAuditRecord(node *AuditRecord) auditrecordOption {
+	return func(m *AuditRecordMutation) {
+		m.oldValue = func(context.Context) (*AuditRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditRecord entities.
+func (m *AuditRecordMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditRecordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditRecordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTrid sets the "trid" field.
+func (m *AuditRecordMutation) SetTrid(s string) {
+	m.trid = &s
+}
+
+// Trid returns the value of the "trid" field in the mutation.
+func (m *AuditRecordMutation) Trid() (r string, exists bool) {
+	v := m.trid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrid returns the old "trid" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldTrid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrid: %w", err)
+	}
+	return oldValue.Trid, nil
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (m *AuditRecordMutation) ClearTrid() {
+	m.trid = nil
+	m.clearedFields[auditrecord.FieldTrid] = struct{}{}
+}
+
+// TridCleared returns if the "trid" field was cleared in this mutation.
+func (m *AuditRecordMutation) TridCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldTrid]
+	return ok
+}
+
+// ResetTrid resets all changes to the "trid" field.
+func (m *AuditRecordMutation) ResetTrid() {
+	m.trid = nil
+	delete(m.clearedFields, auditrecord.FieldTrid)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AuditRecordMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuditRecordMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *AuditRecordMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *AuditRecordMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AuditRecordMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[auditrecord.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuditRecordMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, auditrecord.FieldUserID)
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (m *AuditRecordMutation) SetAPIKeyID(s string) {
+	m.api_key_id = &s
+}
+
+// APIKeyID returns the value of the "api_key_id" field in the mutation.
+func (m *AuditRecordMutation) APIKeyID() (r string, exists bool) {
+	v := m.api_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyID returns the old "api_key_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldAPIKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
+	}
+	return oldValue.APIKeyID, nil
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (m *AuditRecordMutation) ClearAPIKeyID() {
+	m.api_key_id = nil
+	m.clearedFields[auditrecord.FieldAPIKeyID] = struct{}{}
+}
+
+// APIKeyIDCleared returns if the "api_key_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) APIKeyIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldAPIKeyID]
+	return ok
+}
+
+// ResetAPIKeyID resets all changes to the "api_key_id" field.
+func (m *AuditRecordMutation) ResetAPIKeyID() {
+	m.api_key_id = nil
+	delete(m.clearedFields, auditrecord.FieldAPIKeyID)
+}
+
+// SetQuestion sets the "question" field.
+func (m *AuditRecordMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *AuditRecordMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *AuditRecordMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetQuestionEmbedding sets the "question_embedding" field.
+func (m *AuditRecordMutation) SetQuestionEmbedding(pg pgvector.Vector) {
+	m.question_embedding = &pg
+}
+
+// QuestionEmbedding returns the value of the "question_embedding" field in the mutation.
+func (m *AuditRecordMutation) QuestionEmbedding() (r pgvector.Vector, exists bool) {
+	v := m.question_embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionEmbedding returns the old "question_embedding" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldQuestionEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionEmbedding: %w", err)
+	}
+	return oldValue.QuestionEmbedding, nil
+}
+
+// ClearQuestionEmbedding clears the value of the "question_embedding" field.
+func (m *AuditRecordMutation) ClearQuestionEmbedding() {
+	m.question_embedding = nil
+	m.clearedFields[auditrecord.FieldQuestionEmbedding] = struct{}{}
+}
+
+// QuestionEmbeddingCleared returns if the "question_embedding" field was cleared in this mutation.
+func (m *AuditRecordMutation) QuestionEmbeddingCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldQuestionEmbedding]
+	return ok
+}
+
+// ResetQuestionEmbedding resets all changes to the "question_embedding" field.
+func (m *AuditRecordMutation) ResetQuestionEmbedding() {
+	m.question_embedding = nil
+	delete(m.clearedFields, auditrecord.FieldQuestionEmbedding)
+}
+
+// SetIntent sets the "intent" field.
+func (m *AuditRecordMutation) SetIntent(s string) {
+	m.intent = &s
+}
+
+// Intent returns the value of the "intent" field in the mutation.
+func (m *AuditRecordMutation) Intent() (r string, exists bool) {
+	v := m.intent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntent returns the old "intent" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldIntent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntent: %w", err)
+	}
+	return oldValue.Intent, nil
+}
+
+// ClearIntent clears the value of the "intent" field.
+func (m *AuditRecordMutation) ClearIntent() {
+	m.intent = nil
+	m.clearedFields[auditrecord.FieldIntent] = struct{}{}
+}
+
+// IntentCleared returns if the "intent" field was cleared in this mutation.
+func (m *AuditRecordMutation) IntentCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldIntent]
+	return ok
+}
+
+// ResetIntent resets all changes to the "intent" field.
+func (m *AuditRecordMutation) ResetIntent() {
+	m.intent = nil
+	delete(m.clearedFields, auditrecord.FieldIntent)
+}
+
+// SetKnowledgeIds sets the "knowledge_ids" field.
+func (m *AuditRecordMutation) SetKnowledgeIds(i []int) {
+	m.knowledge_ids = &i
+	m.appendknowledge_ids = nil
+}
+
+// KnowledgeIds returns the value of the "knowledge_ids" field in the mutation.
+func (m *AuditRecordMutation) KnowledgeIds() (r []int, exists bool) {
+	v := m.knowledge_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKnowledgeIds returns the old "knowledge_ids" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldKnowledgeIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnowledgeIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnowledgeIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnowledgeIds: %w", err)
+	}
+	return oldValue.KnowledgeIds, nil
+}
+
+// AppendKnowledgeIds adds i to the "knowledge_ids" field.
+func (m *AuditRecordMutation) AppendKnowledgeIds(i []int) {
+	m.appendknowledge_ids = append(m.appendknowledge_ids, i...)
+}
+
+// AppendedKnowledgeIds returns the list of values that were appended to the "knowledge_ids" field in this mutation.
+func (m *AuditRecordMutation) AppendedKnowledgeIds() ([]int, bool) {
+	if len(m.appendknowledge_ids) == 0 {
+		return nil, false
+	}
+	return m.appendknowledge_ids, true
+}
+
+// ClearKnowledgeIds clears the value of the "knowledge_ids" field.
+func (m *AuditRecordMutation) ClearKnowledgeIds() {
+	m.knowledge_ids = nil
+	m.appendknowledge_ids = nil
+	m.clearedFields[auditrecord.FieldKnowledgeIds] = struct{}{}
+}
+
+// KnowledgeIdsCleared returns if the "knowledge_ids" field was cleared in this mutation.
+func (m *AuditRecordMutation) KnowledgeIdsCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldKnowledgeIds]
+	return ok
+}
+
+// ResetKnowledgeIds resets all changes to the "knowledge_ids" field.
+func (m *AuditRecordMutation) ResetKnowledgeIds() {
+	m.knowledge_ids = nil
+	m.appendknowledge_ids = nil
+	delete(m.clearedFields, auditrecord.FieldKnowledgeIds)
+}
+
+// SetSimilarityScores sets the "similarity_scores" field.
+func (m *AuditRecordMutation) SetSimilarityScores(f []float64) {
+	m.similarity_scores = &f
+	m.appendsimilarity_scores = nil
+}
+
+// SimilarityScores returns the value of the "similarity_scores" field in the mutation.
+func (m *AuditRecordMutation) SimilarityScores() (r []float64, exists bool) {
+	v := m.similarity_scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSimilarityScores returns the old "similarity_scores" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldSimilarityScores(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSimilarityScores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSimilarityScores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSimilarityScores: %w", err)
+	}
+	return oldValue.SimilarityScores, nil
+}
+
+// AppendSimilarityScores adds f to the "similarity_scores" field.
+func (m *AuditRecordMutation) AppendSimilarityScores(f []float64) {
+	m.appendsimilarity_scores = append(m.appendsimilarity_scores, f...)
+}
+
+// AppendedSimilarityScores returns the list of values that were appended to the "similarity_scores" field in this mutation.
+func (m *AuditRecordMutation) AppendedSimilarityScores() ([]float64, bool) {
+	if len(m.appendsimilarity_scores) == 0 {
+		return nil, false
+	}
+	return m.appendsimilarity_scores, true
+}
+
+// ClearSimilarityScores clears the value of the "similarity_scores" field.
+func (m *AuditRecordMutation) ClearSimilarityScores() {
+	m.similarity_scores = nil
+	m.appendsimilarity_scores = nil
+	m.clearedFields[auditrecord.FieldSimilarityScores] = struct{}{}
+}
+
+// SimilarityScoresCleared returns if the "similarity_scores" field was cleared in this mutation.
+func (m *AuditRecordMutation) SimilarityScoresCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldSimilarityScores]
+	return ok
+}
+
+// ResetSimilarityScores resets all changes to the "similarity_scores" field.
+func (m *AuditRecordMutation) ResetSimilarityScores() {
+	m.similarity_scores = nil
+	m.appendsimilarity_scores = nil
+	delete(m.clearedFields, auditrecord.FieldSimilarityScores)
+}
+
+// SetTemplateName sets the "template_name" field.
+func (m *AuditRecordMutation) SetTemplateName(s string) {
+	m.template_name = &s
+}
+
+// TemplateName returns the value of the "template_name" field in the mutation.
+func (m *AuditRecordMutation) TemplateName() (r string, exists bool) {
+	v := m.template_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateName returns the old "template_name" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldTemplateName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateName: %w", err)
+	}
+	return oldValue.TemplateName, nil
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (m *AuditRecordMutation) ClearTemplateName() {
+	m.template_name = nil
+	m.clearedFields[auditrecord.FieldTemplateName] = struct{}{}
+}
+
+// TemplateNameCleared returns if the "template_name" field was cleared in this mutation.
+func (m *AuditRecordMutation) TemplateNameCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldTemplateName]
+	return ok
+}
+
+// ResetTemplateName resets all changes to the "template_name" field.
+func (m *AuditRecordMutation) ResetTemplateName() {
+	m.template_name = nil
+	delete(m.clearedFields, auditrecord.FieldTemplateName)
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (m *AuditRecordMutation) SetTemplateVersion(i int) {
+	m.template_version = &i
+	m.addtemplate_version = nil
+}
+
+// TemplateVersion returns the value of the "template_version" field in the mutation.
+func (m *AuditRecordMutation) TemplateVersion() (r int, exists bool) {
+	v := m.template_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateVersion returns the old "template_version" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldTemplateVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateVersion: %w", err)
+	}
+	return oldValue.TemplateVersion, nil
+}
+
+// AddTemplateVersion adds i to the "template_version" field.
+func (m *AuditRecordMutation) AddTemplateVersion(i int) {
+	if m.addtemplate_version != nil {
+		*m.addtemplate_version += i
+	} else {
+		m.addtemplate_version = &i
+	}
+}
+
+// AddedTemplateVersion returns the value that was added to the "template_version" field in this mutation.
+func (m *AuditRecordMutation) AddedTemplateVersion() (r int, exists bool) {
+	v := m.addtemplate_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (m *AuditRecordMutation) ClearTemplateVersion() {
+	m.template_version = nil
+	m.addtemplate_version = nil
+	m.clearedFields[auditrecord.FieldTemplateVersion] = struct{}{}
+}
+
+// TemplateVersionCleared returns if the "template_version" field was cleared in this mutation.
+func (m *AuditRecordMutation) TemplateVersionCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldTemplateVersion]
+	return ok
+}
+
+// ResetTemplateVersion resets all changes to the "template_version" field.
+func (m *AuditRecordMutation) ResetTemplateVersion() {
+	m.template_version = nil
+	m.addtemplate_version = nil
+	delete(m.clearedFields, auditrecord.FieldTemplateVersion)
+}
+
+// SetModel sets the "model" field.
+func (m *AuditRecordMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *AuditRecordMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *AuditRecordMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[auditrecord.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *AuditRecordMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *AuditRecordMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, auditrecord.FieldModel)
+}
+
+// SetExperiment sets the "experiment" field.
+func (m *AuditRecordMutation) SetExperiment(s string) {
+	m.experiment = &s
+}
+
+// Experiment returns the value of the "experiment" field in the mutation.
+func (m *AuditRecordMutation) Experiment() (r string, exists bool) {
+	v := m.experiment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperiment returns the old "experiment" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldExperiment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperiment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperiment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperiment: %w", err)
+	}
+	return oldValue.Experiment, nil
+}
+
+// ClearExperiment clears the value of the "experiment" field.
+func (m *AuditRecordMutation) ClearExperiment() {
+	m.experiment = nil
+	m.clearedFields[auditrecord.FieldExperiment] = struct{}{}
+}
+
+// ExperimentCleared returns if the "experiment" field was cleared in this mutation.
+func (m *AuditRecordMutation) ExperimentCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldExperiment]
+	return ok
+}
+
+// ResetExperiment resets all changes to the "experiment" field.
+func (m *AuditRecordMutation) ResetExperiment() {
+	m.experiment = nil
+	delete(m.clearedFields, auditrecord.FieldExperiment)
+}
+
+// SetVariant sets the "variant" field.
+func (m *AuditRecordMutation) SetVariant(s string) {
+	m.variant = &s
+}
+
+// Variant returns the value of the "variant" field in the mutation.
+func (m *AuditRecordMutation) Variant() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariant returns the old "variant" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldVariant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariant: %w", err)
+	}
+	return oldValue.Variant, nil
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (m *AuditRecordMutation) ClearVariant() {
+	m.variant = nil
+	m.clearedFields[auditrecord.FieldVariant] = struct{}{}
+}
+
+// VariantCleared returns if the "variant" field was cleared in this mutation.
+func (m *AuditRecordMutation) VariantCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldVariant]
+	return ok
+}
+
+// ResetVariant resets all changes to the "variant" field.
+func (m *AuditRecordMutation) ResetVariant() {
+	m.variant = nil
+	delete(m.clearedFields, auditrecord.FieldVariant)
+}
+
+// SetRawOutput sets the "raw_output" field.
+func (m *AuditRecordMutation) SetRawOutput(s string) {
+	m.raw_output = &s
+}
+
+// RawOutput returns the value of the "raw_output" field in the mutation.
+func (m *AuditRecordMutation) RawOutput() (r string, exists bool) {
+	v := m.raw_output
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawOutput returns the old "raw_output" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldRawOutput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawOutput: %w", err)
+	}
+	return oldValue.RawOutput, nil
+}
+
+// ClearRawOutput clears the value of the "raw_output" field.
+func (m *AuditRecordMutation) ClearRawOutput() {
+	m.raw_output = nil
+	m.clearedFields[auditrecord.FieldRawOutput] = struct{}{}
+}
+
+// RawOutputCleared returns if the "raw_output" field was cleared in this mutation.
+func (m *AuditRecordMutation) RawOutputCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldRawOutput]
+	return ok
+}
+
+// ResetRawOutput resets all changes to the "raw_output" field.
+func (m *AuditRecordMutation) ResetRawOutput() {
+	m.raw_output = nil
+	delete(m.clearedFields, auditrecord.FieldRawOutput)
+}
+
+// SetAnswer sets the "answer" field.
+func (m *AuditRecordMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *AuditRecordMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *AuditRecordMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[auditrecord.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *AuditRecordMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *AuditRecordMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, auditrecord.FieldAnswer)
+}
+
+// SetHandoff sets the "handoff" field.
+func (m *AuditRecordMutation) SetHandoff(b bool) {
+	m.handoff = &b
+}
+
+// Handoff returns the value of the "handoff" field in the mutation.
+func (m *AuditRecordMutation) Handoff() (r bool, exists bool) {
+	v := m.handoff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandoff returns the old "handoff" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldHandoff(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandoff is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandoff requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandoff: %w", err)
+	}
+	return oldValue.Handoff, nil
+}
+
+// ResetHandoff resets all changes to the "handoff" field.
+func (m *AuditRecordMutation) ResetHandoff() {
+	m.handoff = nil
+}
+
+// SetEmbeddingLatencyMs sets the "embedding_latency_ms" field.
+func (m *AuditRecordMutation) SetEmbeddingLatencyMs(i int64) {
+	m.embedding_latency_ms = &i
+	m.addembedding_latency_ms = nil
+}
+
+// EmbeddingLatencyMs returns the value of the "embedding_latency_ms" field in the mutation.
+func (m *AuditRecordMutation) EmbeddingLatencyMs() (r int64, exists bool) {
+	v := m.embedding_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingLatencyMs returns the old "embedding_latency_ms" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldEmbeddingLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingLatencyMs: %w", err)
+	}
+	return oldValue.EmbeddingLatencyMs, nil
+}
+
+// AddEmbeddingLatencyMs adds i to the "embedding_latency_ms" field.
+func (m *AuditRecordMutation) AddEmbeddingLatencyMs(i int64) {
+	if m.addembedding_latency_ms != nil {
+		*m.addembedding_latency_ms += i
+	} else {
+		m.addembedding_latency_ms = &i
+	}
+}
+
+// AddedEmbeddingLatencyMs returns the value that was added to the "embedding_latency_ms" field in this mutation.
+func (m *AuditRecordMutation) AddedEmbeddingLatencyMs() (r int64, exists bool) {
+	v := m.addembedding_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEmbeddingLatencyMs resets all changes to the "embedding_latency_ms" field.
+func (m *AuditRecordMutation) ResetEmbeddingLatencyMs() {
+	m.embedding_latency_ms = nil
+	m.addembedding_latency_ms = nil
+}
+
+// SetVectorSearchLatencyMs sets the "vector_search_latency_ms" field.
+func (m *AuditRecordMutation) SetVectorSearchLatencyMs(i int64) {
+	m.vector_search_latency_ms = &i
+	m.addvector_search_latency_ms = nil
+}
+
+// VectorSearchLatencyMs returns the value of the "vector_search_latency_ms" field in the mutation.
+func (m *AuditRecordMutation) VectorSearchLatencyMs() (r int64, exists bool) {
+	v := m.vector_search_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVectorSearchLatencyMs returns the old "vector_search_latency_ms" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldVectorSearchLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVectorSearchLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVectorSearchLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVectorSearchLatencyMs: %w", err)
+	}
+	return oldValue.VectorSearchLatencyMs, nil
+}
+
+// AddVectorSearchLatencyMs adds i to the "vector_search_latency_ms" field.
+func (m *AuditRecordMutation) AddVectorSearchLatencyMs(i int64) {
+	if m.addvector_search_latency_ms != nil {
+		*m.addvector_search_latency_ms += i
+	} else {
+		m.addvector_search_latency_ms = &i
+	}
+}
+
+// AddedVectorSearchLatencyMs returns the value that was added to the "vector_search_latency_ms" field in this mutation.
+func (m *AuditRecordMutation) AddedVectorSearchLatencyMs() (r int64, exists bool) {
+	v := m.addvector_search_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVectorSearchLatencyMs resets all changes to the "vector_search_latency_ms" field.
+func (m *AuditRecordMutation) ResetVectorSearchLatencyMs() {
+	m.vector_search_latency_ms = nil
+	m.addvector_search_latency_ms = nil
+}
+
+// SetLlmLatencyMs sets the "llm_latency_ms" field.
+func (m *AuditRecordMutation) SetLlmLatencyMs(i int64) {
+	m.llm_latency_ms = &i
+	m.addllm_latency_ms = nil
+}
+
+// LlmLatencyMs returns the value of the "llm_latency_ms" field in the mutation.
+func (m *AuditRecordMutation) LlmLatencyMs() (r int64, exists bool) {
+	v := m.llm_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLlmLatencyMs returns the old "llm_latency_ms" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldLlmLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmLatencyMs: %w", err)
+	}
+	return oldValue.LlmLatencyMs, nil
+}
+
+// AddLlmLatencyMs adds i to the "llm_latency_ms" field.
+func (m *AuditRecordMutation) AddLlmLatencyMs(i int64) {
+	if m.addllm_latency_ms != nil {
+		*m.addllm_latency_ms += i
+	} else {
+		m.addllm_latency_ms = &i
+	}
+}
+
+// AddedLlmLatencyMs returns the value that was added to the "llm_latency_ms" field in this mutation.
+func (m *AuditRecordMutation) AddedLlmLatencyMs() (r int64, exists bool) {
+	v := m.addllm_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLlmLatencyMs resets all changes to the "llm_latency_ms" field.
+func (m *AuditRecordMutation) ResetLlmLatencyMs() {
+	m.llm_latency_ms = nil
+	m.addllm_latency_ms = nil
+}
+
+// SetTotalLatencyMs sets the "total_latency_ms" field.
+func (m *AuditRecordMutation) SetTotalLatencyMs(i int64) {
+	m.total_latency_ms = &i
+	m.addtotal_latency_ms = nil
+}
+
+// TotalLatencyMs returns the value of the "total_latency_ms" field in the mutation.
+func (m *AuditRecordMutation) TotalLatencyMs() (r int64, exists bool) {
+	v := m.total_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalLatencyMs returns the old "total_latency_ms" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldTotalLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalLatencyMs: %w", err)
+	}
+	return oldValue.TotalLatencyMs, nil
+}
+
+// AddTotalLatencyMs adds i to the "total_latency_ms" field.
+func (m *AuditRecordMutation) AddTotalLatencyMs(i int64) {
+	if m.addtotal_latency_ms != nil {
+		*m.addtotal_latency_ms += i
+	} else {
+		m.addtotal_latency_ms = &i
+	}
+}
+
+// AddedTotalLatencyMs returns the value that was added to the "total_latency_ms" field in this mutation.
+func (m *AuditRecordMutation) AddedTotalLatencyMs() (r int64, exists bool) {
+	v := m.addtotal_latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalLatencyMs resets all changes to the "total_latency_ms" field.
+func (m *AuditRecordMutation) ResetTotalLatencyMs() {
+	m.total_latency_ms = nil
+	m.addtotal_latency_ms = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *AuditRecordMutation) SetErrorCode(s string) {
+	m.error_code = &s
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *AuditRecordMutation) ErrorCode() (r string, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *AuditRecordMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.clearedFields[auditrecord.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *AuditRecordMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *AuditRecordMutation) ResetErrorCode() {
+	m.error_code = nil
+	delete(m.clearedFields, auditrecord.FieldErrorCode)
+}
+
+// SetError sets the "error" field.
+func (m *AuditRecordMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditRecordMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditRecordMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditrecord.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditRecordMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *AuditRecordMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditrecord.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditRecordMutation builder.
+func (m *AuditRecordMutation) Where(ps ...predicate.AuditRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditRecord).
+func (m *AuditRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditRecordMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.trid != nil {
+		fields = append(fields, auditrecord.FieldTrid)
+	}
+	if m.user_id != nil {
+		fields = append(fields, auditrecord.FieldUserID)
+	}
+	if m.api_key_id != nil {
+		fields = append(fields, auditrecord.FieldAPIKeyID)
+	}
+	if m.question != nil {
+		fields = append(fields, auditrecord.FieldQuestion)
+	}
+	if m.question_embedding != nil {
+		fields = append(fields, auditrecord.FieldQuestionEmbedding)
+	}
+	if m.intent != nil {
+		fields = append(fields, auditrecord.FieldIntent)
+	}
+	if m.knowledge_ids != nil {
+		fields = append(fields, auditrecord.FieldKnowledgeIds)
+	}
+	if m.similarity_scores != nil {
+		fields = append(fields, auditrecord.FieldSimilarityScores)
+	}
+	if m.template_name != nil {
+		fields = append(fields, auditrecord.FieldTemplateName)
+	}
+	if m.template_version != nil {
+		fields = append(fields, auditrecord.FieldTemplateVersion)
+	}
+	if m.model != nil {
+		fields = append(fields, auditrecord.FieldModel)
+	}
+	if m.experiment != nil {
+		fields = append(fields, auditrecord.FieldExperiment)
+	}
+	if m.variant != nil {
+		fields = append(fields, auditrecord.FieldVariant)
+	}
+	if m.raw_output != nil {
+		fields = append(fields, auditrecord.FieldRawOutput)
+	}
+	if m.answer != nil {
+		fields = append(fields, auditrecord.FieldAnswer)
+	}
+	if m.handoff != nil {
+		fields = append(fields, auditrecord.FieldHandoff)
+	}
+	if m.embedding_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldEmbeddingLatencyMs)
+	}
+	if m.vector_search_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldVectorSearchLatencyMs)
+	}
+	if m.llm_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldLlmLatencyMs)
+	}
+	if m.total_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldTotalLatencyMs)
+	}
+	if m.error_code != nil {
+		fields = append(fields, auditrecord.FieldErrorCode)
+	}
+	if m.error != nil {
+		fields = append(fields, auditrecord.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditrecord.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditrecord.FieldTrid:
+		return m.Trid()
+	case auditrecord.FieldUserID:
+		return m.UserID()
+	case auditrecord.FieldAPIKeyID:
+		return m.APIKeyID()
+	case auditrecord.FieldQuestion:
+		return m.Question()
+	case auditrecord.FieldQuestionEmbedding:
+		return m.QuestionEmbedding()
+	case auditrecord.FieldIntent:
+		return m.Intent()
+	case auditrecord.FieldKnowledgeIds:
+		return m.KnowledgeIds()
+	case auditrecord.FieldSimilarityScores:
+		return m.SimilarityScores()
+	case auditrecord.FieldTemplateName:
+		return m.TemplateName()
+	case auditrecord.FieldTemplateVersion:
+		return m.TemplateVersion()
+	case auditrecord.FieldModel:
+		return m.Model()
+	case auditrecord.FieldExperiment:
+		return m.Experiment()
+	case auditrecord.FieldVariant:
+		return m.Variant()
+	case auditrecord.FieldRawOutput:
+		return m.RawOutput()
+	case auditrecord.FieldAnswer:
+		return m.Answer()
+	case auditrecord.FieldHandoff:
+		return m.Handoff()
+	case auditrecord.FieldEmbeddingLatencyMs:
+		return m.EmbeddingLatencyMs()
+	case auditrecord.FieldVectorSearchLatencyMs:
+		return m.VectorSearchLatencyMs()
+	case auditrecord.FieldLlmLatencyMs:
+		return m.LlmLatencyMs()
+	case auditrecord.FieldTotalLatencyMs:
+		return m.TotalLatencyMs()
+	case auditrecord.FieldErrorCode:
+		return m.ErrorCode()
+	case auditrecord.FieldError:
+		return m.Error()
+	case auditrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditrecord.FieldTrid:
+		return m.OldTrid(ctx)
+	case auditrecord.FieldUserID:
+		return m.OldUserID(ctx)
+	case auditrecord.FieldAPIKeyID:
+		return m.OldAPIKeyID(ctx)
+	case auditrecord.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case auditrecord.FieldQuestionEmbedding:
+		return m.OldQuestionEmbedding(ctx)
+	case auditrecord.FieldIntent:
+		return m.OldIntent(ctx)
+	case auditrecord.FieldKnowledgeIds:
+		return m.OldKnowledgeIds(ctx)
+	case auditrecord.FieldSimilarityScores:
+		return m.OldSimilarityScores(ctx)
+	case auditrecord.FieldTemplateName:
+		return m.OldTemplateName(ctx)
+	case auditrecord.FieldTemplateVersion:
+		return m.OldTemplateVersion(ctx)
+	case auditrecord.FieldModel:
+		return m.OldModel(ctx)
+	case auditrecord.FieldExperiment:
+		return m.OldExperiment(ctx)
+	case auditrecord.FieldVariant:
+		return m.OldVariant(ctx)
+	case auditrecord.FieldRawOutput:
+		return m.OldRawOutput(ctx)
+	case auditrecord.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case auditrecord.FieldHandoff:
+		return m.OldHandoff(ctx)
+	case auditrecord.FieldEmbeddingLatencyMs:
+		return m.OldEmbeddingLatencyMs(ctx)
+	case auditrecord.FieldVectorSearchLatencyMs:
+		return m.OldVectorSearchLatencyMs(ctx)
+	case auditrecord.FieldLlmLatencyMs:
+		return m.OldLlmLatencyMs(ctx)
+	case auditrecord.FieldTotalLatencyMs:
+		return m.OldTotalLatencyMs(ctx)
+	case auditrecord.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case auditrecord.FieldError:
+		return m.OldError(ctx)
+	case auditrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditrecord.FieldTrid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrid(v)
+		return nil
+	case auditrecord.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyID(v)
+		return nil
+	case auditrecord.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case auditrecord.FieldQuestionEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionEmbedding(v)
+		return nil
+	case auditrecord.FieldIntent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntent(v)
+		return nil
+	case auditrecord.FieldKnowledgeIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKnowledgeIds(v)
+		return nil
+	case auditrecord.FieldSimilarityScores:
+		v, ok := value.([]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSimilarityScores(v)
+		return nil
+	case auditrecord.FieldTemplateName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateName(v)
+		return nil
+	case auditrecord.FieldTemplateVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateVersion(v)
+		return nil
+	case auditrecord.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case auditrecord.FieldExperiment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperiment(v)
+		return nil
+	case auditrecord.FieldVariant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariant(v)
+		return nil
+	case auditrecord.FieldRawOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawOutput(v)
+		return nil
+	case auditrecord.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case auditrecord.FieldHandoff:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandoff(v)
+		return nil
+	case auditrecord.FieldEmbeddingLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingLatencyMs(v)
+		return nil
+	case auditrecord.FieldVectorSearchLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVectorSearchLatencyMs(v)
+		return nil
+	case auditrecord.FieldLlmLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmLatencyMs(v)
+		return nil
+	case auditrecord.FieldTotalLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalLatencyMs(v)
+		return nil
+	case auditrecord.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case auditrecord.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, auditrecord.FieldUserID)
+	}
+	if m.addtemplate_version != nil {
+		fields = append(fields, auditrecord.FieldTemplateVersion)
+	}
+	if m.addembedding_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldEmbeddingLatencyMs)
+	}
+	if m.addvector_search_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldVectorSearchLatencyMs)
+	}
+	if m.addllm_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldLlmLatencyMs)
+	}
+	if m.addtotal_latency_ms != nil {
+		fields = append(fields, auditrecord.FieldTotalLatencyMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditrecord.FieldUserID:
+		return m.AddedUserID()
+	case auditrecord.FieldTemplateVersion:
+		return m.AddedTemplateVersion()
+	case auditrecord.FieldEmbeddingLatencyMs:
+		return m.AddedEmbeddingLatencyMs()
+	case auditrecord.FieldVectorSearchLatencyMs:
+		return m.AddedVectorSearchLatencyMs()
+	case auditrecord.FieldLlmLatencyMs:
+		return m.AddedLlmLatencyMs()
+	case auditrecord.FieldTotalLatencyMs:
+		return m.AddedTotalLatencyMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditrecord.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case auditrecord.FieldTemplateVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTemplateVersion(v)
+		return nil
+	case auditrecord.FieldEmbeddingLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEmbeddingLatencyMs(v)
+		return nil
+	case auditrecord.FieldVectorSearchLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVectorSearchLatencyMs(v)
+		return nil
+	case auditrecord.FieldLlmLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLlmLatencyMs(v)
+		return nil
+	case auditrecord.FieldTotalLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalLatencyMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditrecord.FieldTrid) {
+		fields = append(fields, auditrecord.FieldTrid)
+	}
+	if m.FieldCleared(auditrecord.FieldUserID) {
+		fields = append(fields, auditrecord.FieldUserID)
+	}
+	if m.FieldCleared(auditrecord.FieldAPIKeyID) {
+		fields = append(fields, auditrecord.FieldAPIKeyID)
+	}
+	if m.FieldCleared(auditrecord.FieldQuestionEmbedding) {
+		fields = append(fields, auditrecord.FieldQuestionEmbedding)
+	}
+	if m.FieldCleared(auditrecord.FieldIntent) {
+		fields = append(fields, auditrecord.FieldIntent)
+	}
+	if m.FieldCleared(auditrecord.FieldKnowledgeIds) {
+		fields = append(fields, auditrecord.FieldKnowledgeIds)
+	}
+	if m.FieldCleared(auditrecord.FieldSimilarityScores) {
+		fields = append(fields, auditrecord.FieldSimilarityScores)
+	}
+	if m.FieldCleared(auditrecord.FieldTemplateName) {
+		fields = append(fields, auditrecord.FieldTemplateName)
+	}
+	if m.FieldCleared(auditrecord.FieldTemplateVersion) {
+		fields = append(fields, auditrecord.FieldTemplateVersion)
+	}
+	if m.FieldCleared(auditrecord.FieldModel) {
+		fields = append(fields, auditrecord.FieldModel)
+	}
+	if m.FieldCleared(auditrecord.FieldExperiment) {
+		fields = append(fields, auditrecord.FieldExperiment)
+	}
+	if m.FieldCleared(auditrecord.FieldVariant) {
+		fields = append(fields, auditrecord.FieldVariant)
+	}
+	if m.FieldCleared(auditrecord.FieldRawOutput) {
+		fields = append(fields, auditrecord.FieldRawOutput)
+	}
+	if m.FieldCleared(auditrecord.FieldAnswer) {
+		fields = append(fields, auditrecord.FieldAnswer)
+	}
+	if m.FieldCleared(auditrecord.FieldErrorCode) {
+		fields = append(fields, auditrecord.FieldErrorCode)
+	}
+	if m.FieldCleared(auditrecord.FieldError) {
+		fields = append(fields, auditrecord.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditRecordMutation) ClearField(name string) error {
+	switch name {
+	case auditrecord.FieldTrid:
+		m.ClearTrid()
+		return nil
+	case auditrecord.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		m.ClearAPIKeyID()
+		return nil
+	case auditrecord.FieldQuestionEmbedding:
+		m.ClearQuestionEmbedding()
+		return nil
+	case auditrecord.FieldIntent:
+		m.ClearIntent()
+		return nil
+	case auditrecord.FieldKnowledgeIds:
+		m.ClearKnowledgeIds()
+		return nil
+	case auditrecord.FieldSimilarityScores:
+		m.ClearSimilarityScores()
+		return nil
+	case auditrecord.FieldTemplateName:
+		m.ClearTemplateName()
+		return nil
+	case auditrecord.FieldTemplateVersion:
+		m.ClearTemplateVersion()
+		return nil
+	case auditrecord.FieldModel:
+		m.ClearModel()
+		return nil
+	case auditrecord.FieldExperiment:
+		m.ClearExperiment()
+		return nil
+	case auditrecord.FieldVariant:
+		m.ClearVariant()
+		return nil
+	case auditrecord.FieldRawOutput:
+		m.ClearRawOutput()
+		return nil
+	case auditrecord.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	case auditrecord.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case auditrecord.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditRecordMutation) ResetField(name string) error {
+	switch name {
+	case auditrecord.FieldTrid:
+		m.ResetTrid()
+		return nil
+	case auditrecord.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		m.ResetAPIKeyID()
+		return nil
+	case auditrecord.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case auditrecord.FieldQuestionEmbedding:
+		m.ResetQuestionEmbedding()
+		return nil
+	case auditrecord.FieldIntent:
+		m.ResetIntent()
+		return nil
+	case auditrecord.FieldKnowledgeIds:
+		m.ResetKnowledgeIds()
+		return nil
+	case auditrecord.FieldSimilarityScores:
+		m.ResetSimilarityScores()
+		return nil
+	case auditrecord.FieldTemplateName:
+		m.ResetTemplateName()
+		return nil
+	case auditrecord.FieldTemplateVersion:
+		m.ResetTemplateVersion()
+		return nil
+	case auditrecord.FieldModel:
+		m.ResetModel()
+		return nil
+	case auditrecord.FieldExperiment:
+		m.ResetExperiment()
+		return nil
+	case auditrecord.FieldVariant:
+		m.ResetVariant()
+		return nil
+	case auditrecord.FieldRawOutput:
+		m.ResetRawOutput()
+		return nil
+	case auditrecord.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case auditrecord.FieldHandoff:
+		m.ResetHandoff()
+		return nil
+	case auditrecord.FieldEmbeddingLatencyMs:
+		m.ResetEmbeddingLatencyMs()
+		return nil
+	case auditrecord.FieldVectorSearchLatencyMs:
+		m.ResetVectorSearchLatencyMs()
+		return nil
+	case auditrecord.FieldLlmLatencyMs:
+		m.ResetLlmLatencyMs()
+		return nil
+	case auditrecord.FieldTotalLatencyMs:
+		m.ResetTotalLatencyMs()
+		return nil
+	case auditrecord.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case auditrecord.FieldError:
+		m.ResetError()
+		return nil
+	case auditrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord edge %s", name)
+}
+
+// ConversationTurnMutation represents an operation that mutates the ConversationTurn nodes in the graph.
+type ConversationTurnMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	trid          *string
+	question      *string
+	answer        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ConversationTurn, error)
+	predicates    []predicate.ConversationTurn
+}
+
+var _ ent.Mutation = (*ConversationTurnMutation)(nil)
+
+// conversationturnOption allows management of the mutation configuration using functional options.
+type conversationturnOption func(*ConversationTurnMutation)
+
+// newConversationTurnMutation creates new mutation for the ConversationTurn entity.
+func newConversationTurnMutation(c config, op Op, opts ...conversationturnOption) *ConversationTurnMutation {
+	m := &ConversationTurnMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConversationTurn,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConversationTurnID sets the ID field of the mutation.
+func withConversationTurnID(id int) conversationturnOption {
+	return func(m *ConversationTurnMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConversationTurn
+		)
+		m.oldValue = func(ctx context.Context) (*ConversationTurn, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConversationTurn.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConversationTurn sets the old ConversationTurn of the mutation.
+func withConversationTurn(node *ConversationTurn) conversationturnOption {
+	return func(m *ConversationTurnMutation) {
+		m.oldValue = func(context.Context) (*ConversationTurn, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConversationTurnMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConversationTurnMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConversationTurn entities.
+func (m *ConversationTurnMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConversationTurnMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConversationTurnMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConversationTurn.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ConversationTurnMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConversationTurnMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ConversationTurn entity.
+// If the ConversationTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTurnMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConversationTurnMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetTrid sets the "trid" field.
+func (m *ConversationTurnMutation) SetTrid(s string) {
+	m.trid = &s
+}
+
+// Trid returns the value of the "trid" field in the mutation.
+func (m *ConversationTurnMutation) Trid() (r string, exists bool) {
+	v := m.trid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrid returns the old "trid" field's value of the ConversationTurn entity.
+// If the ConversationTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTurnMutation) OldTrid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrid: %w", err)
+	}
+	return oldValue.Trid, nil
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (m *ConversationTurnMutation) ClearTrid() {
+	m.trid = nil
+	m.clearedFields[conversationturn.FieldTrid] = struct{}{}
+}
+
+// TridCleared returns if the "trid" field was cleared in this mutation.
+func (m *ConversationTurnMutation) TridCleared() bool {
+	_, ok := m.clearedFields[conversationturn.FieldTrid]
+	return ok
+}
+
+// ResetTrid resets all changes to the "trid" field.
+func (m *ConversationTurnMutation) ResetTrid() {
+	m.trid = nil
+	delete(m.clearedFields, conversationturn.FieldTrid)
+}
+
+// SetQuestion sets the "question" field.
+func (m *ConversationTurnMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *ConversationTurnMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the ConversationTurn entity.
+// If the ConversationTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTurnMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *ConversationTurnMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *ConversationTurnMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *ConversationTurnMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the ConversationTurn entity.
+// If the ConversationTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTurnMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *ConversationTurnMutation) ResetAnswer() {
+	m.answer = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConversationTurnMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConversationTurnMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ConversationTurn entity.
+// If the ConversationTurn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationTurnMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConversationTurnMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ConversationTurnMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[conversationturn.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ConversationTurnMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ConversationTurnMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ConversationTurnMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ConversationTurnMutation builder.
+func (m *ConversationTurnMutation) Where(ps ...predicate.ConversationTurn) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConversationTurnMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConversationTurnMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConversationTurn, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConversationTurnMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConversationTurnMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ConversationTurn).
+func (m *ConversationTurnMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConversationTurnMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, conversationturn.FieldUserID)
+	}
+	if m.trid != nil {
+		fields = append(fields, conversationturn.FieldTrid)
+	}
+	if m.question != nil {
+		fields = append(fields, conversationturn.FieldQuestion)
+	}
+	if m.answer != nil {
+		fields = append(fields, conversationturn.FieldAnswer)
+	}
+	if m.created_at != nil {
+		fields = append(fields, conversationturn.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConversationTurnMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case conversationturn.FieldUserID:
+		return m.UserID()
+	case conversationturn.FieldTrid:
+		return m.Trid()
+	case conversationturn.FieldQuestion:
+		return m.Question()
+	case conversationturn.FieldAnswer:
+		return m.Answer()
+	case conversationturn.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConversationTurnMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case conversationturn.FieldUserID:
+		return m.OldUserID(ctx)
+	case conversationturn.FieldTrid:
+		return m.OldTrid(ctx)
+	case conversationturn.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case conversationturn.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case conversationturn.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConversationTurn field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationTurnMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case conversationturn.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case conversationturn.FieldTrid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrid(v)
+		return nil
+	case conversationturn.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case conversationturn.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case conversationturn.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConversationTurn field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConversationTurnMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConversationTurnMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationTurnMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ConversationTurn numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConversationTurnMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(conversationturn.FieldTrid) {
+		fields = append(fields, conversationturn.FieldTrid)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConversationTurnMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConversationTurnMutation) ClearField(name string) error {
+	switch name {
+	case conversationturn.FieldTrid:
+		m.ClearTrid()
+		return nil
+	}
+	return fmt.Errorf("unknown ConversationTurn nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConversationTurnMutation) ResetField(name string) error {
+	switch name {
+	case conversationturn.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case conversationturn.FieldTrid:
+		m.ResetTrid()
+		return nil
+	case conversationturn.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case conversationturn.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case conversationturn.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConversationTurn field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConversationTurnMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, conversationturn.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConversationTurnMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case conversationturn.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConversationTurnMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConversationTurnMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConversationTurnMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, conversationturn.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConversationTurnMutation) EdgeCleared(name string) bool {
+	switch name {
+	case conversationturn.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConversationTurnMutation) ClearEdge(name string) error {
+	switch name {
+	case conversationturn.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ConversationTurn unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConversationTurnMutation) ResetEdge(name string) error {
+	switch name {
+	case conversationturn.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ConversationTurn edge %s", name)
+}
+
+// ExperimentExposureMutation represents an operation that mutates the ExperimentExposure nodes in the graph.
+type ExperimentExposureMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	experiment         *string
+	variant            *string
+	trid               *string
+	audit_record_id    *int
+	addaudit_record_id *int
+	latency_ms         *int64
+	addlatency_ms      *int64
+	feedback_score     *float64
+	addfeedback_score  *float64
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ExperimentExposure, error)
+	predicates         []predicate.ExperimentExposure
+}
+
+var _ ent.Mutation = (*ExperimentExposureMutation)(nil)
+
+// experimentexposureOption allows management of the mutation configuration using functional options.
+type experimentexposureOption func(*ExperimentExposureMutation)
+
+// newExperimentExposureMutation creates new mutation for the ExperimentExposure entity.
+func newExperimentExposureMutation(c config, op Op, opts ...experimentexposureOption) *ExperimentExposureMutation {
+	m := &ExperimentExposureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExperimentExposure,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExperimentExposureID sets the ID field of the mutation.
+func withExperimentExposureID(id int) experimentexposureOption {
+	return func(m *ExperimentExposureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExperimentExposure
+		)
+		m.oldValue = func(ctx context.Context) (*ExperimentExposure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExperimentExposure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExperimentExposure sets the old ExperimentExposure of the mutation.
+func withExperimentExposure(node *ExperimentExposure) experimentexposureOption {
+	return func(m *ExperimentExposureMutation) {
+		m.oldValue = func(context.Context) (*ExperimentExposure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExperimentExposureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExperimentExposureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExperimentExposure entities.
+func (m *ExperimentExposureMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExperimentExposureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExperimentExposureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExperimentExposure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExperiment sets the "experiment" field.
+func (m *ExperimentExposureMutation) SetExperiment(s string) {
+	m.experiment = &s
+}
+
+// Experiment returns the value of the "experiment" field in the mutation.
+func (m *ExperimentExposureMutation) Experiment() (r string, exists bool) {
+	v := m.experiment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperiment returns the old "experiment" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldExperiment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperiment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperiment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperiment: %w", err)
+	}
+	return oldValue.Experiment, nil
+}
+
+// ResetExperiment resets all changes to the "experiment" field.
+func (m *ExperimentExposureMutation) ResetExperiment() {
+	m.experiment = nil
+}
+
+// SetVariant sets the "variant" field.
+func (m *ExperimentExposureMutation) SetVariant(s string) {
+	m.variant = &s
+}
+
+// Variant returns the value of the "variant" field in the mutation.
+func (m *ExperimentExposureMutation) Variant() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariant returns the old "variant" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldVariant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariant: %w", err)
+	}
+	return oldValue.Variant, nil
+}
+
+// ResetVariant resets all changes to the "variant" field.
+func (m *ExperimentExposureMutation) ResetVariant() {
+	m.variant = nil
+}
+
+// SetTrid sets the "trid" field.
+func (m *ExperimentExposureMutation) SetTrid(s string) {
+	m.trid = &s
+}
+
+// Trid returns the value of the "trid" field in the mutation.
+func (m *ExperimentExposureMutation) Trid() (r string, exists bool) {
+	v := m.trid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrid returns the old "trid" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldTrid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrid: %w", err)
+	}
+	return oldValue.Trid, nil
+}
+
+// ClearTrid clears the value of the "trid" field.
+func (m *ExperimentExposureMutation) ClearTrid() {
+	m.trid = nil
+	m.clearedFields[experimentexposure.FieldTrid] = struct{}{}
+}
+
+// TridCleared returns if the "trid" field was cleared in this mutation.
+func (m *ExperimentExposureMutation) TridCleared() bool {
+	_, ok := m.clearedFields[experimentexposure.FieldTrid]
+	return ok
+}
+
+// ResetTrid resets all changes to the "trid" field.
+func (m *ExperimentExposureMutation) ResetTrid() {
+	m.trid = nil
+	delete(m.clearedFields, experimentexposure.FieldTrid)
+}
+
+// SetAuditRecordID sets the "audit_record_id" field.
+func (m *ExperimentExposureMutation) SetAuditRecordID(i int) {
+	m.audit_record_id = &i
+	m.addaudit_record_id = nil
+}
+
+// AuditRecordID returns the value of the "audit_record_id" field in the mutation.
+func (m *ExperimentExposureMutation) AuditRecordID() (r int, exists bool) {
+	v := m.audit_record_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuditRecordID returns the old "audit_record_id" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldAuditRecordID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuditRecordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuditRecordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuditRecordID: %w", err)
+	}
+	return oldValue.AuditRecordID, nil
+}
+
+// AddAuditRecordID adds i to the "audit_record_id" field.
+func (m *ExperimentExposureMutation) AddAuditRecordID(i int) {
+	if m.addaudit_record_id != nil {
+		*m.addaudit_record_id += i
+	} else {
+		m.addaudit_record_id = &i
+	}
+}
+
+// AddedAuditRecordID returns the value that was added to the "audit_record_id" field in this mutation.
+func (m *ExperimentExposureMutation) AddedAuditRecordID() (r int, exists bool) {
+	v := m.addaudit_record_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAuditRecordID clears the value of the "audit_record_id" field.
+func (m *ExperimentExposureMutation) ClearAuditRecordID() {
+	m.audit_record_id = nil
+	m.addaudit_record_id = nil
+	m.clearedFields[experimentexposure.FieldAuditRecordID] = struct{}{}
+}
+
+// AuditRecordIDCleared returns if the "audit_record_id" field was cleared in this mutation.
+func (m *ExperimentExposureMutation) AuditRecordIDCleared() bool {
+	_, ok := m.clearedFields[experimentexposure.FieldAuditRecordID]
+	return ok
+}
+
+// ResetAuditRecordID resets all changes to the "audit_record_id" field.
+func (m *ExperimentExposureMutation) ResetAuditRecordID() {
+	m.audit_record_id = nil
+	m.addaudit_record_id = nil
+	delete(m.clearedFields, experimentexposure.FieldAuditRecordID)
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *ExperimentExposureMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *ExperimentExposureMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *ExperimentExposureMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *ExperimentExposureMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *ExperimentExposureMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetFeedbackScore sets the "feedback_score" field.
+func (m *ExperimentExposureMutation) SetFeedbackScore(f float64) {
+	m.feedback_score = &f
+	m.addfeedback_score = nil
+}
+
+// FeedbackScore returns the value of the "feedback_score" field in the mutation.
+func (m *ExperimentExposureMutation) FeedbackScore() (r float64, exists bool) {
+	v := m.feedback_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeedbackScore returns the old "feedback_score" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldFeedbackScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeedbackScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeedbackScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeedbackScore: %w", err)
+	}
+	return oldValue.FeedbackScore, nil
+}
+
+// AddFeedbackScore adds f to the "feedback_score" field.
+func (m *ExperimentExposureMutation) AddFeedbackScore(f float64) {
+	if m.addfeedback_score != nil {
+		*m.addfeedback_score += f
+	} else {
+		m.addfeedback_score = &f
+	}
+}
+
+// AddedFeedbackScore returns the value that was added to the "feedback_score" field in this mutation.
+func (m *ExperimentExposureMutation) AddedFeedbackScore() (r float64, exists bool) {
+	v := m.addfeedback_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFeedbackScore clears the value of the "feedback_score" field.
+func (m *ExperimentExposureMutation) ClearFeedbackScore() {
+	m.feedback_score = nil
+	m.addfeedback_score = nil
+	m.clearedFields[experimentexposure.FieldFeedbackScore] = struct{}{}
+}
+
+// FeedbackScoreCleared returns if the "feedback_score" field was cleared in this mutation.
+func (m *ExperimentExposureMutation) FeedbackScoreCleared() bool {
+	_, ok := m.clearedFields[experimentexposure.FieldFeedbackScore]
+	return ok
+}
+
+// ResetFeedbackScore resets all changes to the "feedback_score" field.
+func (m *ExperimentExposureMutation) ResetFeedbackScore() {
+	m.feedback_score = nil
+	m.addfeedback_score = nil
+	delete(m.clearedFields, experimentexposure.FieldFeedbackScore)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExperimentExposureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExperimentExposureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExperimentExposure entity.
+// If the ExperimentExposure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperimentExposureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExperimentExposureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ExperimentExposureMutation builder.
+func (m *ExperimentExposureMutation) Where(ps ...predicate.ExperimentExposure) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExperimentExposureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExperimentExposureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExperimentExposure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExperimentExposureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExperimentExposureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExperimentExposure).
+func (m *ExperimentExposureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExperimentExposureMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.experiment != nil {
+		fields = append(fields, experimentexposure.FieldExperiment)
+	}
+	if m.variant != nil {
+		fields = append(fields, experimentexposure.FieldVariant)
+	}
+	if m.trid != nil {
+		fields = append(fields, experimentexposure.FieldTrid)
+	}
+	if m.audit_record_id != nil {
+		fields = append(fields, experimentexposure.FieldAuditRecordID)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, experimentexposure.FieldLatencyMs)
+	}
+	if m.feedback_score != nil {
+		fields = append(fields, experimentexposure.FieldFeedbackScore)
+	}
+	if m.created_at != nil {
+		fields = append(fields, experimentexposure.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExperimentExposureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case experimentexposure.FieldExperiment:
+		return m.Experiment()
+	case experimentexposure.FieldVariant:
+		return m.Variant()
+	case experimentexposure.FieldTrid:
+		return m.Trid()
+	case experimentexposure.FieldAuditRecordID:
+		return m.AuditRecordID()
+	case experimentexposure.FieldLatencyMs:
+		return m.LatencyMs()
+	case experimentexposure.FieldFeedbackScore:
+		return m.FeedbackScore()
+	case experimentexposure.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExperimentExposureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case experimentexposure.FieldExperiment:
+		return m.OldExperiment(ctx)
+	case experimentexposure.FieldVariant:
+		return m.OldVariant(ctx)
+	case experimentexposure.FieldTrid:
+		return m.OldTrid(ctx)
+	case experimentexposure.FieldAuditRecordID:
+		return m.OldAuditRecordID(ctx)
+	case experimentexposure.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case experimentexposure.FieldFeedbackScore:
+		return m.OldFeedbackScore(ctx)
+	case experimentexposure.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExperimentExposure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperimentExposureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case experimentexposure.FieldExperiment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperiment(v)
+		return nil
+	case experimentexposure.FieldVariant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariant(v)
+		return nil
+	case experimentexposure.FieldTrid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrid(v)
+		return nil
+	case experimentexposure.FieldAuditRecordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuditRecordID(v)
+		return nil
+	case experimentexposure.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case experimentexposure.FieldFeedbackScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeedbackScore(v)
+		return nil
+	case experimentexposure.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExperimentExposure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExperimentExposureMutation) AddedFields() []string {
+	var fields []string
+	if m.addaudit_record_id != nil {
+		fields = append(fields, experimentexposure.FieldAuditRecordID)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, experimentexposure.FieldLatencyMs)
+	}
+	if m.addfeedback_score != nil {
+		fields = append(fields, experimentexposure.FieldFeedbackScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExperimentExposureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case experimentexposure.FieldAuditRecordID:
+		return m.AddedAuditRecordID()
+	case experimentexposure.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case experimentexposure.FieldFeedbackScore:
+		return m.AddedFeedbackScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperimentExposureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case experimentexposure.FieldAuditRecordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAuditRecordID(v)
+		return nil
+	case experimentexposure.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case experimentexposure.FieldFeedbackScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFeedbackScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExperimentExposure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExperimentExposureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(experimentexposure.FieldTrid) {
+		fields = append(fields, experimentexposure.FieldTrid)
+	}
+	if m.FieldCleared(experimentexposure.FieldAuditRecordID) {
+		fields = append(fields, experimentexposure.FieldAuditRecordID)
+	}
+	if m.FieldCleared(experimentexposure.FieldFeedbackScore) {
+		fields = append(fields, experimentexposure.FieldFeedbackScore)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExperimentExposureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExperimentExposureMutation) ClearField(name string) error {
+	switch name {
+	case experimentexposure.FieldTrid:
+		m.ClearTrid()
+		return nil
+	case experimentexposure.FieldAuditRecordID:
+		m.ClearAuditRecordID()
+		return nil
+	case experimentexposure.FieldFeedbackScore:
+		m.ClearFeedbackScore()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperimentExposure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExperimentExposureMutation) ResetField(name string) error {
+	switch name {
+	case experimentexposure.FieldExperiment:
+		m.ResetExperiment()
+		return nil
+	case experimentexposure.FieldVariant:
+		m.ResetVariant()
+		return nil
+	case experimentexposure.FieldTrid:
+		m.ResetTrid()
+		return nil
+	case experimentexposure.FieldAuditRecordID:
+		m.ResetAuditRecordID()
+		return nil
+	case experimentexposure.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case experimentexposure.FieldFeedbackScore:
+		m.ResetFeedbackScore()
+		return nil
+	case experimentexposure.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperimentExposure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExperimentExposureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExperimentExposureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExperimentExposureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExperimentExposureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExperimentExposureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExperimentExposureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExperimentExposureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExperimentExposure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExperimentExposureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExperimentExposure edge %s", name)
+}
+
+// InquiryKnowledgeMutation represents an operation that mutates the InquiryKnowledge nodes in the graph.
+type InquiryKnowledgeMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	instruction           *string
+	instruction_embedding *pgvector.Vector
+	response              *string
+	category              *string
+	intent                *string
+	flags                 *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*InquiryKnowledge, error)
+	predicates            []predicate.InquiryKnowledge
+}
+
+var _ ent.Mutation = (*InquiryKnowledgeMutation)(nil)
+
+// inquiryknowledgeOption allows management of the mutation configuration using functional options.
+type inquiryknowledgeOption func(*InquiryKnowledgeMutation)
+
+// newInquiryKnowledgeMutation creates new mutation for the InquiryKnowledge entity.
+func newInquiryKnowledgeMutation(c config, op Op, opts ...inquiryknowledgeOption) *InquiryKnowledgeMutation {
+	m := &InquiryKnowledgeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInquiryKnowledge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInquiryKnowledgeID sets the ID field of the mutation.
+func withInquiryKnowledgeID(id int) inquiryknowledgeOption {
+	return func(m *InquiryKnowledgeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InquiryKnowledge
+		)
+		m.oldValue = func(ctx context.Context) (*InquiryKnowledge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InquiryKnowledge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInquiryKnowledge sets the old InquiryKnowledge of the mutation.
+func withInquiryKnowledge(node *InquiryKnowledge) inquiryknowledgeOption {
+	return func(m *InquiryKnowledgeMutation) {
+		m.oldValue = func(context.Context) (*InquiryKnowledge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InquiryKnowledgeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InquiryKnowledgeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InquiryKnowledge entities.
+func (m *InquiryKnowledgeMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InquiryKnowledgeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InquiryKnowledgeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InquiryKnowledge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetInstruction sets the "instruction" field.
+func (m *InquiryKnowledgeMutation) SetInstruction(s string) {
+	m.instruction = &s
+}
+
+// Instruction returns the value of the "instruction" field in the mutation.
+func (m *InquiryKnowledgeMutation) Instruction() (r string, exists bool) {
+	v := m.instruction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstruction returns the old "instruction" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldInstruction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstruction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstruction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstruction: %w", err)
+	}
+	return oldValue.Instruction, nil
+}
+
+// ResetInstruction resets all changes to the "instruction" field.
+func (m *InquiryKnowledgeMutation) ResetInstruction() {
+	m.instruction = nil
+}
+
+// SetInstructionEmbedding sets the "instruction_embedding" field.
+func (m *InquiryKnowledgeMutation) SetInstructionEmbedding(pg pgvector.Vector) {
+	m.instruction_embedding = &pg
+}
+
+// InstructionEmbedding returns the value of the "instruction_embedding" field in the mutation.
+func (m *InquiryKnowledgeMutation) InstructionEmbedding() (r pgvector.Vector, exists bool) {
+	v := m.instruction_embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstructionEmbedding returns the old "instruction_embedding" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldInstructionEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstructionEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstructionEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstructionEmbedding: %w", err)
+	}
+	return oldValue.InstructionEmbedding, nil
+}
+
+// ClearInstructionEmbedding clears the value of the "instruction_embedding" field.
+func (m *InquiryKnowledgeMutation) ClearInstructionEmbedding() {
+	m.instruction_embedding = nil
+	m.clearedFields[inquiryknowledge.FieldInstructionEmbedding] = struct{}{}
+}
+
+// InstructionEmbeddingCleared returns if the "instruction_embedding" field was cleared in this mutation.
+func (m *InquiryKnowledgeMutation) InstructionEmbeddingCleared() bool {
+	_, ok := m.clearedFields[inquiryknowledge.FieldInstructionEmbedding]
+	return ok
+}
+
+// ResetInstructionEmbedding resets all changes to the "instruction_embedding" field.
+func (m *InquiryKnowledgeMutation) ResetInstructionEmbedding() {
+	m.instruction_embedding = nil
+	delete(m.clearedFields, inquiryknowledge.FieldInstructionEmbedding)
+}
+
+// SetResponse sets the "response" field.
+func (m *InquiryKnowledgeMutation) SetResponse(s string) {
+	m.response = &s
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *InquiryKnowledgeMutation) Response() (r string, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldResponse(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *InquiryKnowledgeMutation) ResetResponse() {
+	m.response = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *InquiryKnowledgeMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *InquiryKnowledgeMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *InquiryKnowledgeMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[inquiryknowledge.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *InquiryKnowledgeMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[inquiryknowledge.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *InquiryKnowledgeMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, inquiryknowledge.FieldCategory)
+}
+
+// SetIntent sets the "intent" field.
+func (m *InquiryKnowledgeMutation) SetIntent(s string) {
+	m.intent = &s
+}
+
+// Intent returns the value of the "intent" field in the mutation.
+func (m *InquiryKnowledgeMutation) Intent() (r string, exists bool) {
+	v := m.intent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntent returns the old "intent" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldIntent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntent: %w", err)
+	}
+	return oldValue.Intent, nil
+}
+
+// ClearIntent clears the value of the "intent" field.
+func (m *InquiryKnowledgeMutation) ClearIntent() {
+	m.intent = nil
+	m.clearedFields[inquiryknowledge.FieldIntent] = struct{}{}
+}
+
+// IntentCleared returns if the "intent" field was cleared in this mutation.
+func (m *InquiryKnowledgeMutation) IntentCleared() bool {
+	_, ok := m.clearedFields[inquiryknowledge.FieldIntent]
+	return ok
+}
+
+// ResetIntent resets all changes to the "intent" field.
+func (m *InquiryKnowledgeMutation) ResetIntent() {
+	m.intent = nil
+	delete(m.clearedFields, inquiryknowledge.FieldIntent)
+}
+
+// SetFlags sets the "flags" field.
+func (m *InquiryKnowledgeMutation) SetFlags(s string) {
+	m.flags = &s
+}
+
+// Flags returns the value of the "flags" field in the mutation.
+func (m *InquiryKnowledgeMutation) Flags() (r string, exists bool) {
+	v := m.flags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlags returns the old "flags" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldFlags(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlags: %w", err)
+	}
+	return oldValue.Flags, nil
+}
+
+// ClearFlags clears the value of the "flags" field.
+func (m *InquiryKnowledgeMutation) ClearFlags() {
+	m.flags = nil
+	m.clearedFields[inquiryknowledge.FieldFlags] = struct{}{}
+}
+
+// FlagsCleared returns if the "flags" field was cleared in this mutation.
+func (m *InquiryKnowledgeMutation) FlagsCleared() bool {
+	_, ok := m.clearedFields[inquiryknowledge.FieldFlags]
+	return ok
+}
+
+// ResetFlags resets all changes to the "flags" field.
+func (m *InquiryKnowledgeMutation) ResetFlags() {
+	m.flags = nil
+	delete(m.clearedFields, inquiryknowledge.FieldFlags)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InquiryKnowledgeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InquiryKnowledgeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InquiryKnowledgeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *InquiryKnowledgeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *InquiryKnowledgeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the InquiryKnowledge entity.
+// If the InquiryKnowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryKnowledgeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *InquiryKnowledgeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the InquiryKnowledgeMutation builder.
+func (m *InquiryKnowledgeMutation) Where(ps ...predicate.InquiryKnowledge) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InquiryKnowledgeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InquiryKnowledgeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InquiryKnowledge, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InquiryKnowledgeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InquiryKnowledgeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InquiryKnowledge).
+func (m *InquiryKnowledgeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InquiryKnowledgeMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.instruction != nil {
+		fields = append(fields, inquiryknowledge.FieldInstruction)
+	}
+	if m.instruction_embedding != nil {
+		fields = append(fields, inquiryknowledge.FieldInstructionEmbedding)
+	}
+	if m.response != nil {
+		fields = append(fields, inquiryknowledge.FieldResponse)
+	}
+	if m.category != nil {
+		fields = append(fields, inquiryknowledge.FieldCategory)
+	}
+	if m.intent != nil {
+		fields = append(fields, inquiryknowledge.FieldIntent)
+	}
+	if m.flags != nil {
+		fields = append(fields, inquiryknowledge.FieldFlags)
+	}
+	if m.created_at != nil {
+		fields = append(fields, inquiryknowledge.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, inquiryknowledge.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InquiryKnowledgeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inquiryknowledge.FieldInstruction:
+		return m.Instruction()
+	case inquiryknowledge.FieldInstructionEmbedding:
+		return m.InstructionEmbedding()
+	case inquiryknowledge.FieldResponse:
+		return m.Response()
+	case inquiryknowledge.FieldCategory:
+		return m.Category()
+	case inquiryknowledge.FieldIntent:
+		return m.Intent()
+	case inquiryknowledge.FieldFlags:
+		return m.Flags()
+	case inquiryknowledge.FieldCreatedAt:
+		return m.CreatedAt()
+	case inquiryknowledge.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InquiryKnowledgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case inquiryknowledge.FieldInstruction:
+		return m.OldInstruction(ctx)
+	case inquiryknowledge.FieldInstructionEmbedding:
+		return m.OldInstructionEmbedding(ctx)
+	case inquiryknowledge.FieldResponse:
+		return m.OldResponse(ctx)
+	case inquiryknowledge.FieldCategory:
+		return m.OldCategory(ctx)
+	case inquiryknowledge.FieldIntent:
+		return m.OldIntent(ctx)
+	case inquiryknowledge.FieldFlags:
+		return m.OldFlags(ctx)
+	case inquiryknowledge.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case inquiryknowledge.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InquiryKnowledge field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InquiryKnowledgeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case inquiryknowledge.FieldInstruction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstruction(v)
+		return nil
+	case inquiryknowledge.FieldInstructionEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstructionEmbedding(v)
+		return nil
+	case inquiryknowledge.FieldResponse:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case inquiryknowledge.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case inquiryknowledge.FieldIntent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntent(v)
+		return nil
+	case inquiryknowledge.FieldFlags:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlags(v)
+		return nil
+	case inquiryknowledge.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case inquiryknowledge.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InquiryKnowledge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InquiryKnowledgeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InquiryKnowledgeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InquiryKnowledgeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown InquiryKnowledge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InquiryKnowledgeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(inquiryknowledge.FieldInstructionEmbedding) {
+		fields = append(fields, inquiryknowledge.FieldInstructionEmbedding)
+	}
+	if m.FieldCleared(inquiryknowledge.FieldCategory) {
+		fields = append(fields, inquiryknowledge.FieldCategory)
+	}
+	if m.FieldCleared(inquiryknowledge.FieldIntent) {
+		fields = append(fields, inquiryknowledge.FieldIntent)
+	}
+	if m.FieldCleared(inquiryknowledge.FieldFlags) {
+		fields = append(fields, inquiryknowledge.FieldFlags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InquiryKnowledgeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InquiryKnowledgeMutation) ClearField(name string) error {
+	switch name {
+	case inquiryknowledge.FieldInstructionEmbedding:
+		m.ClearInstructionEmbedding()
+		return nil
+	case inquiryknowledge.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case inquiryknowledge.FieldIntent:
+		m.ClearIntent()
+		return nil
+	case inquiryknowledge.FieldFlags:
+		m.ClearFlags()
+		return nil
+	}
+	return fmt.Errorf("unknown InquiryKnowledge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InquiryKnowledgeMutation) ResetField(name string) error {
+	switch name {
+	case inquiryknowledge.FieldInstruction:
+		m.ResetInstruction()
+		return nil
+	case inquiryknowledge.FieldInstructionEmbedding:
+		m.ResetInstructionEmbedding()
+		return nil
+	case inquiryknowledge.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case inquiryknowledge.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case inquiryknowledge.FieldIntent:
+		m.ResetIntent()
+		return nil
+	case inquiryknowledge.FieldFlags:
+		m.ResetFlags()
+		return nil
+	case inquiryknowledge.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case inquiryknowledge.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InquiryKnowledge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InquiryKnowledgeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InquiryKnowledgeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InquiryKnowledgeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InquiryKnowledgeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InquiryKnowledgeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InquiryKnowledgeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InquiryKnowledgeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown InquiryKnowledge unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InquiryKnowledgeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown InquiryKnowledge edge %s", name)
+}
+
+// RateLimitBucketMutation represents an operation that mutates the RateLimitBucket nodes in the graph.
+type RateLimitBucketMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	tokens        *float64
+	addtokens     *float64
+	allowed       *bool
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RateLimitBucket, error)
+	predicates    []predicate.RateLimitBucket
+}
+
+var _ ent.Mutation = (*RateLimitBucketMutation)(nil)
+
+// ratelimitbucketOption allows management of the mutation configuration using functional options.
+type ratelimitbucketOption func(*RateLimitBucketMutation)
+
+// newRateLimitBucketMutation creates new mutation for the RateLimitBucket entity.
+func newRateLimitBucketMutation(c config, op Op, opts ...ratelimitbucketOption) *RateLimitBucketMutation {
+	m := &RateLimitBucketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRateLimitBucket,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRateLimitBucketID sets the ID field of the mutation.
+func withRateLimitBucketID(id string) ratelimitbucketOption {
+	return func(m *RateLimitBucketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RateLimitBucket
+		)
+		m.oldValue = func(ctx context.Context) (*RateLimitBucket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RateLimitBucket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRateLimitBucket sets the old RateLimitBucket of the mutation.
+func withRateLimitBucket(node *RateLimitBucket) ratelimitbucketOption {
+	return func(m *RateLimitBucketMutation) {
+		m.oldValue = func(context.Context) (*RateLimitBucket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RateLimitBucketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RateLimitBucketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RateLimitBucket entities.
+func (m *RateLimitBucketMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RateLimitBucketMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RateLimitBucketMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RateLimitBucket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokens sets the "tokens" field.
+func (m *RateLimitBucketMutation) SetTokens(f float64) {
+	m.tokens = &f
+	m.addtokens = nil
+}
+
+// Tokens returns the value of the "tokens" field in the mutation.
+func (m *RateLimitBucketMutation) Tokens() (r float64, exists bool) {
+	v := m.tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokens returns the old "tokens" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldTokens(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokens: %w", err)
+	}
+	return oldValue.Tokens, nil
+}
+
+// AddTokens adds f to the "tokens" field.
+func (m *RateLimitBucketMutation) AddTokens(f float64) {
+	if m.addtokens != nil {
+		*m.addtokens += f
+	} else {
+		m.addtokens = &f
+	}
+}
+
+// AddedTokens returns the value that was added to the "tokens" field in this mutation.
+func (m *RateLimitBucketMutation) AddedTokens() (r float64, exists bool) {
+	v := m.addtokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokens resets all changes to the "tokens" field.
+func (m *RateLimitBucketMutation) ResetTokens() {
+	m.tokens = nil
+	m.addtokens = nil
+}
+
+// SetAllowed sets the "allowed" field.
+func (m *RateLimitBucketMutation) SetAllowed(b bool) {
+	m.allowed = &b
+}
+
+// Allowed returns the value of the "allowed" field in the mutation.
+func (m *RateLimitBucketMutation) Allowed() (r bool, exists bool) {
+	v := m.allowed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowed returns the old "allowed" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldAllowed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowed: %w", err)
+	}
+	return oldValue.Allowed, nil
+}
+
+// ResetAllowed resets all changes to the "allowed" field.
+func (m *RateLimitBucketMutation) ResetAllowed() {
+	m.allowed = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RateLimitBucketMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RateLimitBucketMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RateLimitBucketMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RateLimitBucketMutation builder.
+func (m *RateLimitBucketMutation) Where(ps ...predicate.RateLimitBucket) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RateLimitBucketMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RateLimitBucketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RateLimitBucket, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RateLimitBucketMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RateLimitBucketMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RateLimitBucket).
+func (m *RateLimitBucketMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RateLimitBucketMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tokens != nil {
+		fields = append(fields, ratelimitbucket.FieldTokens)
+	}
+	if m.allowed != nil {
+		fields = append(fields, ratelimitbucket.FieldAllowed)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ratelimitbucket.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RateLimitBucketMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ratelimitbucket.FieldTokens:
+		return m.Tokens()
+	case ratelimitbucket.FieldAllowed:
+		return m.Allowed()
+	case ratelimitbucket.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RateLimitBucketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ratelimitbucket.FieldTokens:
+		return m.OldTokens(ctx)
+	case ratelimitbucket.FieldAllowed:
+		return m.OldAllowed(ctx)
+	case ratelimitbucket.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown InquiryKnowledge field %s", name)
+	return nil, fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InquiryKnowledgeMutation) SetField(name string, value ent.Value) error {
+func (m *RateLimitBucketMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case inquiryknowledge.FieldInstruction:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInstruction(v)
-		return nil
-	case inquiryknowledge.FieldInstructionEmbedding:
-		v, ok := value.(pgvector.Vector)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInstructionEmbedding(v)
-		return nil
-	case inquiryknowledge.FieldResponse:
-		v, ok := value.(string)
+	case ratelimitbucket.FieldTokens:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResponse(v)
-		return nil
-	case inquiryknowledge.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case inquiryknowledge.FieldIntent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntent(v)
-		return nil
-	case inquiryknowledge.FieldFlags:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFlags(v)
+		m.SetTokens(v)
 		return nil
-	case inquiryknowledge.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case ratelimitbucket.FieldAllowed:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAllowed(v)
 		return nil
-	case inquiryknowledge.FieldUpdatedAt:
+	case ratelimitbucket.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -668,170 +6302,148 @@ func (m *InquiryKnowledgeMutation) SetField(name string, value ent.Value) error
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown InquiryKnowledge field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *InquiryKnowledgeMutation) AddedFields() []string {
-	return nil
+func (m *RateLimitBucketMutation) AddedFields() []string {
+	var fields []string
+	if m.addtokens != nil {
+		fields = append(fields, ratelimitbucket.FieldTokens)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *InquiryKnowledgeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RateLimitBucketMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ratelimitbucket.FieldTokens:
+		return m.AddedTokens()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InquiryKnowledgeMutation) AddField(name string, value ent.Value) error {
+func (m *RateLimitBucketMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case ratelimitbucket.FieldTokens:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokens(v)
+		return nil
 	}
-	return fmt.Errorf("unknown InquiryKnowledge numeric field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *InquiryKnowledgeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(inquiryknowledge.FieldInstructionEmbedding) {
-		fields = append(fields, inquiryknowledge.FieldInstructionEmbedding)
-	}
-	if m.FieldCleared(inquiryknowledge.FieldCategory) {
-		fields = append(fields, inquiryknowledge.FieldCategory)
-	}
-	if m.FieldCleared(inquiryknowledge.FieldIntent) {
-		fields = append(fields, inquiryknowledge.FieldIntent)
-	}
-	if m.FieldCleared(inquiryknowledge.FieldFlags) {
-		fields = append(fields, inquiryknowledge.FieldFlags)
-	}
-	return fields
+func (m *RateLimitBucketMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *InquiryKnowledgeMutation) FieldCleared(name string) bool {
+func (m *RateLimitBucketMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *InquiryKnowledgeMutation) ClearField(name string) error {
-	switch name {
-	case inquiryknowledge.FieldInstructionEmbedding:
-		m.ClearInstructionEmbedding()
-		return nil
-	case inquiryknowledge.FieldCategory:
-		m.ClearCategory()
-		return nil
-	case inquiryknowledge.FieldIntent:
-		m.ClearIntent()
-		return nil
-	case inquiryknowledge.FieldFlags:
-		m.ClearFlags()
-		return nil
-	}
-	return fmt.Errorf("unknown InquiryKnowledge nullable field %s", name)
+func (m *RateLimitBucketMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *InquiryKnowledgeMutation) ResetField(name string) error {
+func (m *RateLimitBucketMutation) ResetField(name string) error {
 	switch name {
-	case inquiryknowledge.FieldInstruction:
-		m.ResetInstruction()
-		return nil
-	case inquiryknowledge.FieldInstructionEmbedding:
-		m.ResetInstructionEmbedding()
+	case ratelimitbucket.FieldTokens:
+		m.ResetTokens()
 		return nil
-	case inquiryknowledge.FieldResponse:
-		m.ResetResponse()
-		return nil
-	case inquiryknowledge.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case inquiryknowledge.FieldIntent:
-		m.ResetIntent()
+	case ratelimitbucket.FieldAllowed:
+		m.ResetAllowed()
 		return nil
-	case inquiryknowledge.FieldFlags:
-		m.ResetFlags()
-		return nil
-	case inquiryknowledge.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case inquiryknowledge.FieldUpdatedAt:
+	case ratelimitbucket.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown InquiryKnowledge field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InquiryKnowledgeMutation) AddedEdges() []string {
+func (m *RateLimitBucketMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *InquiryKnowledgeMutation) AddedIDs(name string) []ent.Value {
+func (m *RateLimitBucketMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InquiryKnowledgeMutation) RemovedEdges() []string {
+func (m *RateLimitBucketMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *InquiryKnowledgeMutation) RemovedIDs(name string) []ent.Value {
+func (m *RateLimitBucketMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InquiryKnowledgeMutation) ClearedEdges() []string {
+func (m *RateLimitBucketMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *InquiryKnowledgeMutation) EdgeCleared(name string) bool {
+func (m *RateLimitBucketMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *InquiryKnowledgeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown InquiryKnowledge unique edge %s", name)
+func (m *RateLimitBucketMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *InquiryKnowledgeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown InquiryKnowledge edge %s", name)
+func (m *RateLimitBucketMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	email         *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                        Op
+	typ                       string
+	id                        *int
+	issuer                    *string
+	subject                   *string
+	name                      *string
+	email                     *string
+	created_at                *time.Time
+	clearedFields             map[string]struct{}
+	conversation_turns        map[int]struct{}
+	removedconversation_turns map[int]struct{}
+	clearedconversation_turns bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -938,6 +6550,78 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	}
 }
 
+// SetIssuer sets the "issuer" field.
+func (m *UserMutation) SetIssuer(s string) {
+	m.issuer = &s
+}
+
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *UserMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuer returns the old "issuer" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
+	}
+	return oldValue.Issuer, nil
+}
+
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *UserMutation) ResetIssuer() {
+	m.issuer = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *UserMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *UserMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *UserMutation) ResetSubject() {
+	m.subject = nil
+}
+
 // SetName sets the "name" field.
 func (m *UserMutation) SetName(s string) {
 	m.name = &s
@@ -991,7 +6675,7 @@ func (m *UserMutation) Email() (r string, exists bool) {
 // OldEmail returns the old "email" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
@@ -1005,9 +6689,22 @@ func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	return oldValue.Email, nil
 }
 
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
 // ResetEmail resets all changes to the "email" field.
 func (m *UserMutation) ResetEmail() {
 	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -1046,6 +6743,60 @@ func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// AddConversationTurnIDs adds the "conversation_turns" edge to the ConversationTurn entity by ids.
+func (m *UserMutation) AddConversationTurnIDs(ids ...int) {
+	if m.conversation_turns == nil {
+		m.conversation_turns = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.conversation_turns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConversationTurns clears the "conversation_turns" edge to the ConversationTurn entity.
+func (m *UserMutation) ClearConversationTurns() {
+	m.clearedconversation_turns = true
+}
+
+// ConversationTurnsCleared reports if the "conversation_turns" edge to the ConversationTurn entity was cleared.
+func (m *UserMutation) ConversationTurnsCleared() bool {
+	return m.clearedconversation_turns
+}
+
+// RemoveConversationTurnIDs removes the "conversation_turns" edge to the ConversationTurn entity by IDs.
+func (m *UserMutation) RemoveConversationTurnIDs(ids ...int) {
+	if m.removedconversation_turns == nil {
+		m.removedconversation_turns = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.conversation_turns, ids[i])
+		m.removedconversation_turns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConversationTurns returns the removed IDs of the "conversation_turns" edge to the ConversationTurn entity.
+func (m *UserMutation) RemovedConversationTurnsIDs() (ids []int) {
+	for id := range m.removedconversation_turns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConversationTurnsIDs returns the "conversation_turns" edge IDs in the mutation.
+func (m *UserMutation) ConversationTurnsIDs() (ids []int) {
+	for id := range m.conversation_turns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConversationTurns resets all changes to the "conversation_turns" edge.
+func (m *UserMutation) ResetConversationTurns() {
+	m.conversation_turns = nil
+	m.clearedconversation_turns = false
+	m.removedconversation_turns = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -1080,7 +6831,13 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 5)
+	if m.issuer != nil {
+		fields = append(fields, user.FieldIssuer)
+	}
+	if m.subject != nil {
+		fields = append(fields, user.FieldSubject)
+	}
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -1098,6 +6855,10 @@ func (m *UserMutation) Fields() []string {
 // schema.
 func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case user.FieldIssuer:
+		return m.Issuer()
+	case user.FieldSubject:
+		return m.Subject()
 	case user.FieldName:
 		return m.Name()
 	case user.FieldEmail:
@@ -1113,6 +6874,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case user.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case user.FieldSubject:
+		return m.OldSubject(ctx)
 	case user.FieldName:
 		return m.OldName(ctx)
 	case user.FieldEmail:
@@ -1128,6 +6893,20 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 // type.
 func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuer(v)
+		return nil
+	case user.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
 	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -1178,7 +6957,11 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -1191,6 +6974,11 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -1198,6 +6986,12 @@ func (m *UserMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *UserMutation) ResetField(name string) error {
 	switch name {
+	case user.FieldIssuer:
+		m.ResetIssuer()
+		return nil
+	case user.FieldSubject:
+		m.ResetSubject()
+		return nil
 	case user.FieldName:
 		m.ResetName()
 		return nil
@@ -1213,48 +7007,84 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.conversation_turns != nil {
+		edges = append(edges, user.EdgeConversationTurns)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeConversationTurns:
+		ids := make([]ent.Value, 0, len(m.conversation_turns))
+		for id := range m.conversation_turns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.removedconversation_turns != nil {
+		edges = append(edges, user.EdgeConversationTurns)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeConversationTurns:
+		ids := make([]ent.Value, 0, len(m.removedconversation_turns))
+		for id := range m.removedconversation_turns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.clearedconversation_turns {
+		edges = append(edges, user.EdgeConversationTurns)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeConversationTurns:
+		return m.clearedconversation_turns
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeConversationTurns:
+		m.ResetConversationTurns()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
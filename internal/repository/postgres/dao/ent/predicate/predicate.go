@@ -6,8 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AnswerFeedback is the predicate function for answerfeedback builders.
+type AnswerFeedback func(*sql.Selector)
+
+// AuditRecord is the predicate function for auditrecord builders.
+type AuditRecord func(*sql.Selector)
+
+// ConversationTurn is the predicate function for conversationturn builders.
+type ConversationTurn func(*sql.Selector)
+
+// ExperimentExposure is the predicate function for experimentexposure builders.
+type ExperimentExposure func(*sql.Selector)
+
 // InquiryKnowledge is the predicate function for inquiryknowledge builders.
 type InquiryKnowledge func(*sql.Selector)
 
+// RateLimitBucket is the predicate function for ratelimitbucket builders.
+type RateLimitBucket func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -5,7 +5,13 @@ package ent
 import (
 	"time"
 
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/answerfeedback"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/apikey"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/auditrecord"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/conversationturn"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/inquiryknowledge"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/ratelimitbucket"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/schema"
 )
@@ -14,6 +20,88 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescKeyID is the schema descriptor for key_id field.
+	apikeyDescKeyID := apikeyFields[1].Descriptor()
+	// apikey.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	apikey.KeyIDValidator = apikeyDescKeyID.Validators[0].(func(string) error)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[3].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = apikeyDescSecretHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[5].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	answerfeedbackFields := schema.AnswerFeedback{}.Fields()
+	_ = answerfeedbackFields
+	// answerfeedbackDescRating is the schema descriptor for rating field.
+	answerfeedbackDescRating := answerfeedbackFields[2].Descriptor()
+	// answerfeedback.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	answerfeedback.RatingValidator = answerfeedbackDescRating.Validators[0].(func(string) error)
+	// answerfeedbackDescCreatedAt is the schema descriptor for created_at field.
+	answerfeedbackDescCreatedAt := answerfeedbackFields[8].Descriptor()
+	// answerfeedback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	answerfeedback.DefaultCreatedAt = answerfeedbackDescCreatedAt.Default.(func() time.Time)
+	auditrecordFields := schema.AuditRecord{}.Fields()
+	_ = auditrecordFields
+	// auditrecordDescHandoff is the schema descriptor for handoff field.
+	auditrecordDescHandoff := auditrecordFields[16].Descriptor()
+	// auditrecord.DefaultHandoff holds the default value on creation for the handoff field.
+	auditrecord.DefaultHandoff = auditrecordDescHandoff.Default.(bool)
+	// auditrecordDescEmbeddingLatencyMs is the schema descriptor for embedding_latency_ms field.
+	auditrecordDescEmbeddingLatencyMs := auditrecordFields[17].Descriptor()
+	// auditrecord.EmbeddingLatencyMsValidator is a validator for the "embedding_latency_ms" field. It is called by the builders before save.
+	auditrecord.EmbeddingLatencyMsValidator = auditrecordDescEmbeddingLatencyMs.Validators[0].(func(int64) error)
+	// auditrecordDescVectorSearchLatencyMs is the schema descriptor for vector_search_latency_ms field.
+	auditrecordDescVectorSearchLatencyMs := auditrecordFields[18].Descriptor()
+	// auditrecord.VectorSearchLatencyMsValidator is a validator for the "vector_search_latency_ms" field. It is called by the builders before save.
+	auditrecord.VectorSearchLatencyMsValidator = auditrecordDescVectorSearchLatencyMs.Validators[0].(func(int64) error)
+	// auditrecordDescLlmLatencyMs is the schema descriptor for llm_latency_ms field.
+	auditrecordDescLlmLatencyMs := auditrecordFields[19].Descriptor()
+	// auditrecord.LlmLatencyMsValidator is a validator for the "llm_latency_ms" field. It is called by the builders before save.
+	auditrecord.LlmLatencyMsValidator = auditrecordDescLlmLatencyMs.Validators[0].(func(int64) error)
+	// auditrecordDescTotalLatencyMs is the schema descriptor for total_latency_ms field.
+	auditrecordDescTotalLatencyMs := auditrecordFields[20].Descriptor()
+	// auditrecord.TotalLatencyMsValidator is a validator for the "total_latency_ms" field. It is called by the builders before save.
+	auditrecord.TotalLatencyMsValidator = auditrecordDescTotalLatencyMs.Validators[0].(func(int64) error)
+	// auditrecordDescCreatedAt is the schema descriptor for created_at field.
+	auditrecordDescCreatedAt := auditrecordFields[23].Descriptor()
+	// auditrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditrecord.DefaultCreatedAt = auditrecordDescCreatedAt.Default.(func() time.Time)
+	conversationturnFields := schema.ConversationTurn{}.Fields()
+	_ = conversationturnFields
+	// conversationturnDescQuestion is the schema descriptor for question field.
+	conversationturnDescQuestion := conversationturnFields[3].Descriptor()
+	// conversationturn.QuestionValidator is a validator for the "question" field. It is called by the builders before save.
+	conversationturn.QuestionValidator = conversationturnDescQuestion.Validators[0].(func(string) error)
+	// conversationturnDescCreatedAt is the schema descriptor for created_at field.
+	conversationturnDescCreatedAt := conversationturnFields[5].Descriptor()
+	// conversationturn.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationturn.DefaultCreatedAt = conversationturnDescCreatedAt.Default.(func() time.Time)
+	experimentexposureFields := schema.ExperimentExposure{}.Fields()
+	_ = experimentexposureFields
+	// experimentexposureDescExperiment is the schema descriptor for experiment field.
+	experimentexposureDescExperiment := experimentexposureFields[1].Descriptor()
+	// experimentexposure.ExperimentValidator is a validator for the "experiment" field. It is called by the builders before save.
+	experimentexposure.ExperimentValidator = experimentexposureDescExperiment.Validators[0].(func(string) error)
+	// experimentexposureDescVariant is the schema descriptor for variant field.
+	experimentexposureDescVariant := experimentexposureFields[2].Descriptor()
+	// experimentexposure.VariantValidator is a validator for the "variant" field. It is called by the builders before save.
+	experimentexposure.VariantValidator = experimentexposureDescVariant.Validators[0].(func(string) error)
+	// experimentexposureDescLatencyMs is the schema descriptor for latency_ms field.
+	experimentexposureDescLatencyMs := experimentexposureFields[5].Descriptor()
+	// experimentexposure.LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	experimentexposure.LatencyMsValidator = experimentexposureDescLatencyMs.Validators[0].(func(int64) error)
+	// experimentexposureDescCreatedAt is the schema descriptor for created_at field.
+	experimentexposureDescCreatedAt := experimentexposureFields[7].Descriptor()
+	// experimentexposure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	experimentexposure.DefaultCreatedAt = experimentexposureDescCreatedAt.Default.(func() time.Time)
 	inquiryknowledgeFields := schema.InquiryKnowledge{}.Fields()
 	_ = inquiryknowledgeFields
 	// inquiryknowledgeDescInstruction is the schema descriptor for instruction field.
@@ -34,18 +122,36 @@ func init() {
 	inquiryknowledge.DefaultUpdatedAt = inquiryknowledgeDescUpdatedAt.Default.(func() time.Time)
 	// inquiryknowledge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	inquiryknowledge.UpdateDefaultUpdatedAt = inquiryknowledgeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescAllowed is the schema descriptor for allowed field.
+	ratelimitbucketDescAllowed := ratelimitbucketFields[2].Descriptor()
+	// ratelimitbucket.DefaultAllowed holds the default value on creation for the allowed field.
+	ratelimitbucket.DefaultAllowed = ratelimitbucketDescAllowed.Default.(bool)
+	// ratelimitbucketDescUpdatedAt is the schema descriptor for updated_at field.
+	ratelimitbucketDescUpdatedAt := ratelimitbucketFields[3].Descriptor()
+	// ratelimitbucket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratelimitbucket.DefaultUpdatedAt = ratelimitbucketDescUpdatedAt.Default.(func() time.Time)
+	// ratelimitbucketDescID is the schema descriptor for id field.
+	ratelimitbucketDescID := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ratelimitbucket.IDValidator = ratelimitbucketDescID.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescIssuer is the schema descriptor for issuer field.
+	userDescIssuer := userFields[1].Descriptor()
+	// user.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	user.IssuerValidator = userDescIssuer.Validators[0].(func(string) error)
+	// userDescSubject is the schema descriptor for subject field.
+	userDescSubject := userFields[2].Descriptor()
+	// user.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	user.SubjectValidator = userDescSubject.Validators[0].(func(string) error)
 	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[1].Descriptor()
+	userDescName := userFields[3].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
-	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[2].Descriptor()
-	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[5].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
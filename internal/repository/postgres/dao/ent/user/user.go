@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -13,19 +14,34 @@ const (
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgeConversationTurns holds the string denoting the conversation_turns edge name in mutations.
+	EdgeConversationTurns = "conversation_turns"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// ConversationTurnsTable is the table that holds the conversation_turns relation/edge.
+	ConversationTurnsTable = "conversation_turns"
+	// ConversationTurnsInverseTable is the table name for the ConversationTurn entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationturn" package.
+	ConversationTurnsInverseTable = "conversation_turns"
+	// ConversationTurnsColumn is the table column denoting the conversation_turns relation/edge.
+	ConversationTurnsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
+	FieldIssuer,
+	FieldSubject,
 	FieldName,
 	FieldEmail,
 	FieldCreatedAt,
@@ -42,10 +58,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	IssuerValidator func(string) error
+	// SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	SubjectValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
-	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	EmailValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -58,6 +76,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -72,3 +100,24 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByConversationTurnsCount orders the results by conversation_turns count.
+func ByConversationTurnsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationTurnsStep(), opts...)
+	}
+}
+
+// ByConversationTurns orders the results by conversation_turns terms.
+func ByConversationTurns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationTurnsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConversationTurnsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationTurnsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationTurnsTable, ConversationTurnsColumn),
+	)
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AnswerFeedback holds the schema definition for the AnswerFeedback entity.
+type AnswerFeedback struct {
+	ent.Schema
+}
+
+// Annotations of the AnswerFeedback.
+func (AnswerFeedback) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "answer_feedbacks"},
+	}
+}
+
+// Fields of the AnswerFeedback.
+func (AnswerFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("answer_id"),
+		field.String("rating").
+			NotEmpty(),
+		field.Text("reason").
+			Optional(),
+		field.Text("correction").
+			Optional(),
+		field.Ints("knowledge_ids").
+			Optional(),
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.String("api_key_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AnswerFeedback.
+func (AnswerFeedback) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("answer_id").
+			Unique(),
+		index.Fields("rating", "created_at"),
+	}
+}
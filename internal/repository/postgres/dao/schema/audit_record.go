@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/pgvector/pgvector-go"
+)
+
+// AuditRecord holds the schema definition for the AuditRecord entity.
+type AuditRecord struct {
+	ent.Schema
+}
+
+// Annotations of the AuditRecord.
+func (AuditRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_records"},
+	}
+}
+
+// Fields of the AuditRecord.
+func (AuditRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("trid").
+			Optional(),
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.String("api_key_id").
+			Optional(),
+		field.Text("question"),
+		field.Other("question_embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector(1536)",
+			}).
+			Optional(),
+		field.String("intent").
+			Optional(),
+		field.Ints("knowledge_ids").
+			Optional(),
+		field.Floats("similarity_scores").
+			Optional(),
+		field.String("template_name").
+			Optional(),
+		field.Int("template_version").
+			Optional(),
+		field.String("model").
+			Optional(),
+		field.String("experiment").
+			Optional(),
+		field.String("variant").
+			Optional(),
+		field.Text("raw_output").
+			Optional(),
+		field.Text("answer").
+			Optional(),
+		field.Bool("handoff").
+			Default(false),
+		field.Int64("embedding_latency_ms").
+			NonNegative(),
+		field.Int64("vector_search_latency_ms").
+			NonNegative(),
+		field.Int64("llm_latency_ms").
+			NonNegative(),
+		field.Int64("total_latency_ms").
+			NonNegative(),
+		field.String("error_code").
+			Optional(),
+		field.Text("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditRecord.
+func (AuditRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("intent", "created_at"),
+		index.Fields("error_code", "created_at"),
+		index.Fields("trid"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConversationTurn holds the schema definition for the ConversationTurn entity.
+type ConversationTurn struct {
+	ent.Schema
+}
+
+// Annotations of the ConversationTurn.
+func (ConversationTurn) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "conversation_turns"},
+	}
+}
+
+// Fields of the ConversationTurn.
+func (ConversationTurn) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("user_id"),
+		field.String("trid").
+			Optional(),
+		field.Text("question").
+			NotEmpty(),
+		field.Text("answer"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ConversationTurn.
+func (ConversationTurn) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("conversation_turns").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ConversationTurn.
+func (ConversationTurn) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
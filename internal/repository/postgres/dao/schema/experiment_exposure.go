@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ExperimentExposure holds the schema definition for the ExperimentExposure entity.
+type ExperimentExposure struct {
+	ent.Schema
+}
+
+// Annotations of the ExperimentExposure.
+func (ExperimentExposure) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "experiment_exposures"},
+	}
+}
+
+// Fields of the ExperimentExposure.
+func (ExperimentExposure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("experiment").
+			NotEmpty(),
+		field.String("variant").
+			NotEmpty(),
+		field.String("trid").
+			Optional(),
+		field.Int("audit_record_id").
+			Optional().
+			Nillable(),
+		field.Int64("latency_ms").
+			NonNegative(),
+		field.Float("feedback_score").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ExperimentExposure.
+func (ExperimentExposure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("experiment", "variant"),
+		index.Fields("trid"),
+		index.Fields("audit_record_id"),
+	}
+}
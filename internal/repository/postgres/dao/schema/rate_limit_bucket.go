@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitBucket holds the schema definition for the RateLimitBucket entity.
+// Each row is the token bucket of one client under one rate limit policy.
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+// Annotations of the RateLimitBucket.
+func (RateLimitBucket) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "rate_limit_buckets"},
+	}
+}
+
+// Fields of the RateLimitBucket.
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the bucket key: <policy>:<client key>
+		field.String("id").
+			NotEmpty().
+			Immutable(),
+		field.Float("tokens"),
+		// allowed records whether the last request taking from the bucket was admitted
+		field.Bool("allowed").
+			Default(true),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the RateLimitBucket.
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("updated_at"),
+	}
+}
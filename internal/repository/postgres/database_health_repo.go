@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+const (
+	// vectorExtensionQuery returns the installed pgvector version
+	vectorExtensionQuery = `SELECT extversion FROM pg_extension WHERE extname = 'vector'`
+	// migrationTableQuery checks for the golang-migrate version table
+	migrationTableQuery = `SELECT to_regclass('schema_migrations') IS NOT NULL`
+	// migrationStateQuery returns the version recorded by golang-migrate
+	migrationStateQuery = `SELECT version, dirty FROM schema_migrations LIMIT 1`
+	// embeddingDimensionsQuery returns the declared dimensions of the vector column
+	// (pgvector stores them as the column's type modifier)
+	embeddingDimensionsQuery = `
+SELECT atttypmod FROM pg_attribute
+WHERE attrelid = to_regclass('inquiry_knowledges') AND attname = 'instruction_embedding'`
+)
+
+type databaseHealthRepo struct {
+	client *ent.Client
+}
+
+// NewDatabaseHealthRepository creates a repository inspecting the database for readiness checks
+func NewDatabaseHealthRepository(client *ent.Client) repository.DatabaseHealthRepository {
+	return &databaseHealthRepo{client: client}
+}
+
+// PingDatabase checks that the database accepts queries
+func (r *databaseHealthRepo) PingDatabase(ctx context.Context) error {
+	var one int
+	if err := r.queryRow(ctx, `SELECT 1`, &one); err != nil {
+		return errors.Wrap(err, "failed to ping database")
+	}
+	return nil
+}
+
+// GetVectorExtensionVersion returns the installed pgvector version
+func (r *databaseHealthRepo) GetVectorExtensionVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := r.queryRow(ctx, vectorExtensionQuery, &version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New(constants.NotFound, "pgvector extension is not installed", nil)
+		}
+		return "", errors.Wrap(err, "failed to get pgvector version")
+	}
+	return version, nil
+}
+
+// GetMigrationState returns the applied schema migration version
+func (r *databaseHealthRepo) GetMigrationState(ctx context.Context) (*domain.MigrationState, error) {
+	var exists bool
+	if err := r.queryRow(ctx, migrationTableQuery, &exists); err != nil {
+		return nil, errors.Wrap(err, "failed to find schema migrations")
+	}
+	if !exists {
+		return nil, errors.New(constants.NotFound, "no schema migrations applied", nil)
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	if err := r.queryRow(ctx, migrationStateQuery, &version, &dirty); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New(constants.NotFound, "no schema migrations applied", nil)
+		}
+		return nil, errors.Wrap(err, "failed to get schema migration version")
+	}
+	return &domain.MigrationState{Version: uint(version), Dirty: dirty}, nil
+}
+
+// GetEmbeddingDimensions returns the dimensions of the knowledge embedding column
+func (r *databaseHealthRepo) GetEmbeddingDimensions(ctx context.Context) (int, error) {
+	var dimensions int
+	if err := r.queryRow(ctx, embeddingDimensionsQuery, &dimensions); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.New(constants.NotFound, "embedding column does not exist", nil)
+		}
+		return 0, errors.Wrap(err, "failed to get embedding dimensions")
+	}
+	return dimensions, nil
+}
+
+// queryRow runs a query returning a single row and scans it into dest.
+// It returns sql.ErrNoRows when the query returns no row.
+func (r *databaseHealthRepo) queryRow(ctx context.Context, query string, dest ...any) error {
+	rows, err := r.client.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return rows.Scan(dest...)
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+)
+
+// variantStatRow is the aggregated row of experiment exposures per variant
+type variantStatRow struct {
+	Variant          string   `json:"variant"`
+	Exposures        int      `json:"exposures"`
+	RatedExposures   int      `json:"rated_exposures"`
+	AvgFeedbackScore *float64 `json:"avg_feedback_score"`
+	AvgLatencyMs     float64  `json:"avg_latency_ms"`
+	P95LatencyMs     float64  `json:"p95_latency_ms"`
+}
+
+// toDomainVariantStats converts aggregated rows to domain.VariantStats
+func toDomainVariantStats(experiment string, rows []variantStatRow) domain.VariantStats {
+	stats := make(domain.VariantStats, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, &domain.VariantStat{
+			Experiment:       experiment,
+			Variant:          row.Variant,
+			Exposures:        row.Exposures,
+			RatedExposures:   row.RatedExposures,
+			AvgFeedbackScore: row.AvgFeedbackScore,
+			AvgLatency:       time.Duration(row.AvgLatencyMs * float64(time.Millisecond)),
+			P95Latency:       time.Duration(row.P95LatencyMs * float64(time.Millisecond)),
+		})
+	}
+	return stats
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/experimentexposure"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type experimentExposureRepo struct {
+	client *ent.Client
+}
+
+// NewExperimentExposureRepository creates a new EntGo-based experiment exposure repository
+func NewExperimentExposureRepository(client *ent.Client) repository.ExperimentExposureRepository {
+	return &experimentExposureRepo{client: client}
+}
+
+// SaveExperimentExposure records that an answer was generated under a variant
+func (r *experimentExposureRepo) SaveExperimentExposure(
+	ctx context.Context,
+	exposure *domain.ExperimentExposure,
+) error {
+	_, err := r.client.ExperimentExposure.Create().
+		SetExperiment(exposure.Experiment).
+		SetVariant(exposure.Variant).
+		SetTrid(exposure.TrID).
+		SetNillableAuditRecordID(exposure.AnswerID).
+		SetLatencyMs(exposure.Latency.Milliseconds()).
+		SetNillableFeedbackScore(exposure.FeedbackScore).
+		SetCreatedAt(exposure.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to save experiment exposure")
+	}
+	return nil
+}
+
+// ScoreExperimentExposure sets the feedback score of the exposure of an audited answer; an
+// answer given outside an experiment has no exposure and is left alone
+func (r *experimentExposureRepo) ScoreExperimentExposure(
+	ctx context.Context,
+	answerID int,
+	score float64,
+) error {
+	_, err := r.client.ExperimentExposure.Update().
+		Where(experimentexposure.AuditRecordID(answerID)).
+		SetFeedbackScore(score).
+		Save(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to score experiment exposure")
+	}
+	return nil
+}
+
+// GetVariantStats aggregates feedback score and latency per variant of an experiment
+func (r *experimentExposureRepo) GetVariantStats(
+	ctx context.Context,
+	experiment string,
+) (domain.VariantStats, error) {
+	var rows []variantStatRow
+	err := r.client.ExperimentExposure.Query().
+		Where(experimentexposure.Experiment(experiment)).
+		GroupBy(experimentexposure.FieldVariant).
+		Aggregate(
+			ent.As(ent.Count(), "exposures"),
+			func(s *entsql.Selector) string {
+				return entsql.As(
+					entsql.Count(s.C(experimentexposure.FieldFeedbackScore)),
+					"rated_exposures",
+				)
+			},
+			ent.As(ent.Mean(experimentexposure.FieldFeedbackScore), "avg_feedback_score"),
+			ent.As(ent.Mean(experimentexposure.FieldLatencyMs), "avg_latency_ms"),
+			func(s *entsql.Selector) string {
+				return entsql.As(
+					fmt.Sprintf(
+						"percentile_cont(0.95) WITHIN GROUP (ORDER BY %s)",
+						s.C(experimentexposure.FieldLatencyMs),
+					),
+					"p95_latency_ms",
+				)
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate experiment exposures")
+	}
+
+	return toDomainVariantStats(experiment, rows), nil
+}
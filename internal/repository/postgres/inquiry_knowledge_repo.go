@@ -6,11 +6,15 @@ import (
 
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/pgvector/pgvector-go"
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
 	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	"github.com/wonjinsin/simple-chatbot/internal/repository"
 	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/inquiryknowledge"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
 	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
@@ -95,13 +99,17 @@ func (r *inquiryKnowledgeRepo) BatchSaveInquiryKnowledge(
 	return nil
 }
 
-// FindSimilars finds inquiry knowledge entries similar to the given embedding vector with similarity
-// scores
+// FindSimilars finds inquiry knowledge entries matching the filter that are similar to the given
+// embedding vector with similarity scores
 func (r *inquiryKnowledgeRepo) FindSimilars(
 	ctx context.Context,
 	embedding domain.Embedding,
 	limit int,
-) (domain.InquirySimilarityResults, error) {
+	filter domain.KnowledgeFilter,
+) (_ domain.InquirySimilarityResults, err error) {
+	ctx, span := tracing.Start(ctx, "FindSimilars", attribute.Int("limit", limit))
+	defer func() { tracing.End(span, err) }()
+
 	if limit <= 0 {
 		return nil, errors.New(
 			constants.InvalidParameter,
@@ -119,10 +127,15 @@ func (r *inquiryKnowledgeRepo) FindSimilars(
 
 	// Query using pgvector's cosine distance operator (<=>)
 	// First, get the most similar entries ordered by distance
-	entResults, err := r.client.InquiryKnowledge.Query().
+	query := r.client.InquiryKnowledge.Query().
 		Where(func(s *entsql.Selector) {
 			s.Where(entsql.NotNull("instruction_embedding"))
-		}).
+		})
+	if filter.Category != "" {
+		query = query.Where(inquiryknowledge.CategoryEqualFold(filter.Category))
+	}
+
+	entResults, err := query.
 		Order(func(s *entsql.Selector) {
 			// Order by cosine distance (smaller distance = more similar)
 			s.OrderExpr(entsql.Expr(fmt.Sprintf(
@@ -167,3 +180,14 @@ func (r *inquiryKnowledgeRepo) FindSimilars(
 
 	return domainResults, nil
 }
+
+// CountEmbeddedInquiryKnowledge returns the number of entries that have an embedding
+func (r *inquiryKnowledgeRepo) CountEmbeddedInquiryKnowledge(ctx context.Context) (int, error) {
+	count, err := r.client.InquiryKnowledge.Query().
+		Where(inquiryknowledge.InstructionEmbeddingNotNil()).
+		Count(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count inquiry knowledge")
+	}
+	return count, nil
+}
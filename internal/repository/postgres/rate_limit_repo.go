@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/ratelimitbucket"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// takeTokenQuery refills and takes a token from a bucket in a single atomic statement.
+// $1 = bucket key, $2 = capacity, $3 = refill per second.
+// On conflict all SET expressions see the old row, so "allowed" and "tokens" agree.
+const takeTokenQuery = `
+INSERT INTO rate_limit_buckets AS b (id, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, $2::float8 >= 1, now())
+ON CONFLICT (id) DO UPDATE SET
+	allowed = LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::float8) >= 1,
+	tokens = CASE
+		WHEN LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::float8) >= 1
+		THEN LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::float8) - 1
+		ELSE LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::float8)
+	END,
+	updated_at = now()
+RETURNING tokens, allowed`
+
+type rateLimitRepo struct {
+	client *ent.Client
+}
+
+// NewRateLimitRepository creates a Postgres-backed token bucket store shared by all instances
+func NewRateLimitRepository(client *ent.Client) repository.RateLimitRepository {
+	return &rateLimitRepo{client: client}
+}
+
+// TakeRateLimitToken takes one token from the client's bucket under the policy
+func (r *rateLimitRepo) TakeRateLimitToken(
+	ctx context.Context,
+	policy *domain.RateLimitPolicy,
+	clientKey string,
+) (*domain.RateLimitDecision, error) {
+	rows, err := r.client.QueryContext(
+		ctx,
+		takeTokenQuery,
+		policy.BucketKey(clientKey),
+		policy.Capacity(),
+		policy.RefillPerSecond(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to take rate limit token")
+	}
+	defer rows.Close()
+
+	var (
+		tokens  float64
+		allowed bool
+	)
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrap(err, "failed to take rate limit token")
+		}
+		return nil, errors.New(constants.DatabaseError, "rate limit bucket not returned", nil)
+	}
+	if err := rows.Scan(&tokens, &allowed); err != nil {
+		return nil, errors.Wrap(err, "failed to scan rate limit bucket")
+	}
+
+	return domain.NewRateLimitDecision(policy, allowed, tokens), nil
+}
+
+// PruneRateLimitBuckets deletes buckets that have been idle for longer than idleFor
+func (r *rateLimitRepo) PruneRateLimitBuckets(ctx context.Context, idleFor time.Duration) error {
+	_, err := r.client.RateLimitBucket.Delete().
+		Where(ratelimitbucket.UpdatedAtLT(time.Now().Add(-idleFor))).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to prune rate limit buckets")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+)
+
+// toDomainUser converts ent.User to domain.User
+func toDomainUser(entUser *ent.User) *domain.User {
+	user := &domain.User{
+		ID:        entUser.ID,
+		Issuer:    entUser.Issuer,
+		Subject:   entUser.Subject,
+		Name:      entUser.Name,
+		CreatedAt: entUser.CreatedAt,
+	}
+	if entUser.Email != nil {
+		user.Email = *entUser.Email
+	}
+	return user
+}
+
+// toNillableEmail converts an empty email to nil so it is stored as NULL
+func toNillableEmail(email string) *string {
+	if email == "" {
+		return nil
+	}
+	return &email
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent"
+	"github.com/wonjinsin/simple-chatbot/internal/repository/postgres/dao/ent/user"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type userRepo struct {
+	client *ent.Client
+}
+
+// NewUserRepository creates a new EntGo-based user repository
+func NewUserRepository(client *ent.Client) repository.UserRepository {
+	return &userRepo{client: client}
+}
+
+// GetUserByIdentity returns the user with the given identity provider issuer and subject
+func (r *userRepo) GetUserByIdentity(
+	ctx context.Context,
+	issuer, subject string,
+) (*domain.User, error) {
+	entUser, err := r.client.User.Query().
+		Where(user.Issuer(issuer), user.Subject(subject)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New(constants.NotFound, "user not found", nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user by identity")
+	}
+	return toDomainUser(entUser), nil
+}
+
+// GetUser returns the user with the given ID
+func (r *userRepo) GetUser(ctx context.Context, id int) (*domain.User, error) {
+	entUser, err := r.client.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, errors.New(constants.NotFound, fmt.Sprintf("user %d not found", id), nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	return toDomainUser(entUser), nil
+}
+
+// CreateUser stores a new user
+func (r *userRepo) CreateUser(ctx context.Context, u *domain.User) (*domain.User, error) {
+	entUser, err := r.client.User.Create().
+		SetIssuer(u.Issuer).
+		SetSubject(u.Subject).
+		SetName(u.Name).
+		SetNillableEmail(toNillableEmail(u.Email)).
+		SetCreatedAt(u.CreatedAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, errors.New(constants.ConstraintError, "user already exists", err)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+	return toDomainUser(entUser), nil
+}
+
+// UpdateUserProfile updates the name and email of a user
+func (r *userRepo) UpdateUserProfile(ctx context.Context, u *domain.User) (*domain.User, error) {
+	update := r.client.User.UpdateOneID(u.ID).
+		SetName(u.Name)
+	if u.Email == "" {
+		update = update.ClearEmail()
+	} else {
+		update = update.SetEmail(u.Email)
+	}
+
+	entUser, err := update.Save(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update user profile")
+	}
+	return toDomainUser(entUser), nil
+}
@@ -2,13 +2,159 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/wonjinsin/simple-chatbot/internal/domain"
 )
 
 // AnswerRefineRepository defines the interface for refining answers based on context
 type AnswerRefineRepository interface {
-	RefineAnswer(ctx context.Context, contextStr string) (string, error)
+	// RefineAnswer generates an answer from the retrieved context
+	RefineAnswer(
+		ctx context.Context,
+		contextStr string,
+		opts domain.GenerationOptions,
+	) (*domain.Answer, error)
+	// AnswerConversation answers the question using the retrieved context and chat history
+	AnswerConversation(
+		ctx context.Context,
+		history domain.ChatMessages,
+		question, contextStr string,
+	) (*domain.Answer, error)
+	// StreamConversation answers like AnswerConversation but emits the answer chunk by chunk.
+	// The returned answer has no text; it holds the metadata and rendered prompt.
+	StreamConversation(
+		ctx context.Context,
+		history domain.ChatMessages,
+		question, contextStr string,
+		onChunk func(chunk string) error,
+	) (*domain.Answer, error)
+}
+
+// AnswerJudgeRepository defines the interface for scoring answers with an LLM judge
+type AnswerJudgeRepository interface {
+	// JudgeAnswer scores the answer to the question against the retrieved context it was
+	// generated from and a reference answer
+	JudgeAnswer(
+		ctx context.Context,
+		question, contextStr, reference, answer string,
+	) (*domain.AnswerJudgement, error)
+}
+
+// InjectionClassifierRepository defines the interface for classifying prompt injections with an LLM
+type InjectionClassifierRepository interface {
+	// ClassifyInjection reports whether text tries to override the assistant's instructions
+	ClassifyInjection(ctx context.Context, text string) (*domain.InjectionClassification, error)
+}
+
+// APIKeyRepository defines the interface for API key storage
+type APIKeyRepository interface {
+	// CreateAPIKey stores a new API key
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error)
+	// GetAPIKeyByKeyID returns the API key with the given public key ID
+	GetAPIKeyByKeyID(ctx context.Context, keyID string) (*domain.APIKey, error)
+	// ListAPIKeys returns every API key, newest first
+	ListAPIKeys(ctx context.Context) (domain.APIKeys, error)
+	// RevokeAPIKey marks the API key as revoked
+	RevokeAPIKey(ctx context.Context, keyID string, revokedAt time.Time) error
+	// ReplaceAPIKey atomically revokes the old API key and stores its replacement
+	ReplaceAPIKey(
+		ctx context.Context,
+		oldKeyID string,
+		newKey *domain.APIKey,
+	) (*domain.APIKey, error)
+	// TouchAPIKey records when the API key was last used
+	TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error
+}
+
+// BasicChatRepository defines the interface for direct LLM chat without retrieval
+type BasicChatRepository interface {
+	// Chat sends the message directly to the LLM and returns its reply
+	Chat(ctx context.Context, msg string) (*domain.Answer, error)
+	// ChatWithTemplate renders the named prompt template with variables and returns the LLM reply
+	ChatWithTemplate(
+		ctx context.Context,
+		templateName string,
+		variables map[string]any,
+	) (*domain.Answer, error)
+}
+
+// IdentityTokenRepository defines the interface for verifying end-user identity tokens
+type IdentityTokenRepository interface {
+	// VerifyIdentityToken validates the token signature, issuer, audience and expiry and returns
+	// its identity claims
+	VerifyIdentityToken(ctx context.Context, token string) (*domain.IdentityClaims, error)
+}
+
+// UserRepository defines the interface for user database operations
+type UserRepository interface {
+	// GetUserByIdentity returns the user with the given identity provider issuer and subject
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error)
+	// GetUser returns the user with the given ID
+	GetUser(ctx context.Context, id int) (*domain.User, error)
+	// CreateUser stores a new user
+	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
+	// UpdateUserProfile updates the name and email of a user
+	UpdateUserProfile(ctx context.Context, user *domain.User) (*domain.User, error)
+}
+
+// ConversationRepository defines the interface for per-user conversation history storage
+type ConversationRepository interface {
+	// SaveConversationTurn stores a question and its answer for a user
+	SaveConversationTurn(ctx context.Context, turn *domain.ConversationTurn) error
+	// ListConversationTurns returns a page of the user's turns, newest first, and the total count
+	ListConversationTurns(
+		ctx context.Context,
+		userID, offset, limit int,
+	) (domain.ConversationTurns, int, error)
+}
+
+// AuditRepository defines the interface for storing and searching the audit trail of answers
+type AuditRepository interface {
+	// SaveAuditRecord stores the audit record of a single question and sets its ID
+	SaveAuditRecord(ctx context.Context, record *domain.AuditRecord) error
+	// GetAuditRecord returns the audit record with the given ID
+	GetAuditRecord(ctx context.Context, id int) (*domain.AuditRecord, error)
+	// ListAuditRecords returns a page of records matching the filter, newest first, and the total count
+	ListAuditRecords(
+		ctx context.Context,
+		filter *domain.AuditFilter,
+	) (domain.AuditRecords, int, error)
+	// DeleteAuditRecordsBefore deletes records created before cutoff and returns how many were deleted
+	DeleteAuditRecordsBefore(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// AnswerFeedbackRepository defines the interface for answer feedback storage and reports
+type AnswerFeedbackRepository interface {
+	// SaveAnswerFeedback stores the feedback on an answer and sets its ID
+	SaveAnswerFeedback(ctx context.Context, feedback *domain.AnswerFeedback) error
+	// RankKnowledgeByNegativeFeedback returns knowledge entries with negative feedback since the
+	// given time, most rated down first
+	RankKnowledgeByNegativeFeedback(
+		ctx context.Context,
+		since time.Time,
+		limit int,
+	) (domain.KnowledgeFeedbackStats, error)
+	// ListKnowledgeGapCandidates returns recent questions whose best retrieved entry was less
+	// similar than maxSimilarity or whose answer was rated down, newest first
+	ListKnowledgeGapCandidates(
+		ctx context.Context,
+		since time.Time,
+		maxSimilarity float64,
+		limit int,
+	) (domain.KnowledgeGapCandidates, error)
+}
+
+// RateLimitRepository defines the interface for per-client token bucket storage
+type RateLimitRepository interface {
+	// TakeRateLimitToken takes one token from the client's bucket under the policy
+	TakeRateLimitToken(
+		ctx context.Context,
+		policy *domain.RateLimitPolicy,
+		clientKey string,
+	) (*domain.RateLimitDecision, error)
+	// PruneRateLimitBuckets deletes buckets that have been idle for longer than idleFor
+	PruneRateLimitBuckets(ctx context.Context, idleFor time.Duration) error
 }
 
 // EmbeddingRepository defines the interface for text embedding operations
@@ -19,15 +165,60 @@ type EmbeddingRepository interface {
 	EmbedStrings(ctx context.Context, texts []string) (domain.Embeddings, error)
 }
 
+// PromptTemplateRepository defines the interface for looking up named, versioned prompt templates
+type PromptTemplateRepository interface {
+	// GetPromptTemplate returns the named prompt template at the given version (0 = latest)
+	GetPromptTemplate(
+		ctx context.Context,
+		name string,
+		version int,
+	) (*domain.PromptTemplate, error)
+	// ListPromptTemplates returns every loaded prompt template version
+	ListPromptTemplates(ctx context.Context) (domain.PromptTemplates, error)
+}
+
+// ExperimentRepository defines the interface for looking up configured prompt experiments
+type ExperimentRepository interface {
+	// GetActiveExperiment returns the enabled experiment, or nil when none is running
+	GetActiveExperiment(ctx context.Context) (*domain.Experiment, error)
+	// ListExperiments returns every configured experiment
+	ListExperiments(ctx context.Context) (domain.Experiments, error)
+}
+
+// ExperimentExposureRepository defines the interface for experiment outcome storage
+type ExperimentExposureRepository interface {
+	// SaveExperimentExposure records that an answer was generated under a variant
+	SaveExperimentExposure(ctx context.Context, exposure *domain.ExperimentExposure) error
+	// ScoreExperimentExposure sets the feedback score of the exposure of an audited answer
+	ScoreExperimentExposure(ctx context.Context, answerID int, score float64) error
+	// GetVariantStats aggregates feedback score and latency per variant of an experiment
+	GetVariantStats(ctx context.Context, experiment string) (domain.VariantStats, error)
+}
+
 // InquiryKnowledgeRepository defines the interface for inquiry knowledge database operations
 type InquiryKnowledgeRepository interface {
 	// BatchSaveInquiryKnowledge saves multiple inquiry knowledge entries to database
 	BatchSaveInquiryKnowledge(ctx context.Context, items domain.InquiryKnowledges) error
-	// FindSimilar finds inquiry knowledge entries similar to the given embedding vector with
-	// similarity scores
+	// FindSimilar finds inquiry knowledge entries matching the filter that are similar to the
+	// given embedding vector with similarity scores
 	FindSimilars(
 		ctx context.Context,
 		embedding domain.Embedding,
 		limit int,
+		filter domain.KnowledgeFilter,
 	) (domain.InquirySimilarityResults, error)
+	// CountEmbeddedInquiryKnowledge returns the number of entries that have an embedding
+	CountEmbeddedInquiryKnowledge(ctx context.Context) (int, error)
+}
+
+// DatabaseHealthRepository defines the interface for inspecting the database a service needs
+type DatabaseHealthRepository interface {
+	// PingDatabase checks that the database accepts queries
+	PingDatabase(ctx context.Context) error
+	// GetVectorExtensionVersion returns the installed pgvector version
+	GetVectorExtensionVersion(ctx context.Context) (string, error)
+	// GetMigrationState returns the applied schema migration version
+	GetMigrationState(ctx context.Context) (*domain.MigrationState, error)
+	// GetEmbeddingDimensions returns the dimensions of the knowledge embedding column
+	GetEmbeddingDimensions(ctx context.Context) (int, error)
 }
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// apiKeyTouchInterval limits how often the last-used time of a key is written
+const apiKeyTouchInterval = time.Minute
+
+type APIKeyServiceImpl struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+func NewAPIKeyServiceImpl(apiKeyRepo repository.APIKeyRepository) *APIKeyServiceImpl {
+	return &APIKeyServiceImpl{apiKeyRepo: apiKeyRepo}
+}
+
+// CreateAPIKey generates and stores a new API key, returning it with its plaintext token
+func (s *APIKeyServiceImpl) CreateAPIKey(
+	ctx context.Context,
+	name string,
+	scopes []string,
+) (*domain.APIKey, string, error) {
+	// Step 1: Validate scopes and generate the key
+	parsedScopes, err := domain.ParseAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, token, err := domain.NewAPIKey(name, parsedScopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Step 2: Store the key (only the secret hash is persisted)
+	created, err := s.apiKeyRepo.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create api key")
+	}
+
+	return created, token, nil
+}
+
+// RotateAPIKey revokes an API key and replaces it with a new key of the same name and scopes
+func (s *APIKeyServiceImpl) RotateAPIKey(
+	ctx context.Context,
+	keyID string,
+) (*domain.APIKey, string, error) {
+	// Step 1: Find the key to rotate
+	old, err := s.apiKeyRepo.GetAPIKeyByKeyID(ctx, keyID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get api key")
+	}
+	if old.IsRevoked() {
+		return nil, "", errors.New(constants.ConstraintError, "api key is already revoked", nil)
+	}
+
+	// Step 2: Generate the replacement and swap it in atomically
+	key, token, err := domain.NewAPIKey(old.Name, old.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	created, err := s.apiKeyRepo.ReplaceAPIKey(ctx, old.KeyID, key)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to rotate api key")
+	}
+
+	return created, token, nil
+}
+
+// RevokeAPIKey revokes an API key so it can no longer authenticate
+func (s *APIKeyServiceImpl) RevokeAPIKey(ctx context.Context, keyID string) error {
+	if err := s.apiKeyRepo.RevokeAPIKey(ctx, keyID, time.Now()); err != nil {
+		return errors.Wrap(err, "failed to revoke api key")
+	}
+	return nil
+}
+
+// ListAPIKeys returns every API key, newest first
+func (s *APIKeyServiceImpl) ListAPIKeys(ctx context.Context) (domain.APIKeys, error) {
+	keys, err := s.apiKeyRepo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list api keys")
+	}
+	return keys, nil
+}
+
+// AuthenticateAPIKey resolves a bearer token to an active API key
+func (s *APIKeyServiceImpl) AuthenticateAPIKey(
+	ctx context.Context,
+	token string,
+) (*domain.APIKey, error) {
+	// Step 1: Split the token and look up the key by its public ID
+	keyID, secret, err := domain.ParseAPIKeyToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.apiKeyRepo.GetAPIKeyByKeyID(ctx, keyID)
+	if errors.HasCode(err, constants.NotFound) {
+		return nil, errors.New(constants.Unauthorized, "invalid api key", nil)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get api key")
+	}
+
+	// Step 2: Verify the secret and that the key is still active
+	if !key.VerifySecret(secret) {
+		return nil, errors.New(constants.Unauthorized, "invalid api key", nil)
+	}
+	if key.IsRevoked() {
+		return nil, errors.New(constants.Unauthorized, "api key has been revoked", nil)
+	}
+
+	// Step 3: Record usage at most once per interval; failing to do so does not deny access
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.apiKeyRepo.TouchAPIKey(ctx, key.KeyID, now); err == nil {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
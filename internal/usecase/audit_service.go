@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// AuditConfig controls how long the audit trail is kept
+type AuditConfig struct {
+	Retention time.Duration // How long records are kept (0 = forever)
+}
+
+type AuditServiceImpl struct {
+	auditRepo repository.AuditRepository
+	cfg       AuditConfig
+}
+
+func NewAuditServiceImpl(auditRepo repository.AuditRepository, cfg AuditConfig) *AuditServiceImpl {
+	return &AuditServiceImpl{
+		auditRepo: auditRepo,
+		cfg:       cfg,
+	}
+}
+
+// SearchAuditRecords returns a page of audit records matching the filter, newest first, and the
+// total count
+func (s *AuditServiceImpl) SearchAuditRecords(
+	ctx context.Context,
+	filter *domain.AuditFilter,
+) (domain.AuditRecords, int, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	records, total, err := s.auditRepo.ListAuditRecords(ctx, filter)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to search audit records")
+	}
+	return records, total, nil
+}
+
+// PruneExpiredRecords deletes records older than the retention period and returns how many
+// were deleted
+func (s *AuditServiceImpl) PruneExpiredRecords(ctx context.Context) (int, error) {
+	if s.cfg.Retention <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := s.auditRepo.DeleteAuditRecordsBefore(ctx, time.Now().Add(-s.cfg.Retention))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prune audit records")
+	}
+	return deleted, nil
+}
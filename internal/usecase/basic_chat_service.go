@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+type BasicChatServiceImpl struct {
+	basicChatRepo repository.BasicChatRepository
+}
+
+func NewBasicChatServiceImpl(basicChatRepo repository.BasicChatRepository) *BasicChatServiceImpl {
+	return &BasicChatServiceImpl{basicChatRepo: basicChatRepo}
+}
+
+// AskBasicChat answers a message directly with the LLM without retrieval
+func (s *BasicChatServiceImpl) AskBasicChat(
+	ctx context.Context,
+	msg string,
+) (*domain.Answer, error) {
+	// Step 1: Validate input message
+	msg = strings.TrimSpace(msg)
+	if utils.IsEmptyOrWhitespace(msg) {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"message cannot be empty",
+			nil,
+		)
+	}
+
+	// Step 2: Ask the LLM directly
+	answer, err := s.basicChatRepo.Chat(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to ask basic chat")
+	}
+
+	return answer, nil
+}
+
+// AskBasicPromptTemplateChat renders the named prompt template with variables and asks the LLM
+func (s *BasicChatServiceImpl) AskBasicPromptTemplateChat(
+	ctx context.Context,
+	templateName string,
+	variables map[string]any,
+) (*domain.Answer, error) {
+	// Step 1: Validate template name
+	templateName = strings.TrimSpace(templateName)
+	if utils.IsEmptyOrWhitespace(templateName) {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"template name cannot be empty",
+			nil,
+		)
+	}
+
+	if variables == nil {
+		variables = map[string]any{}
+	}
+
+	// Step 2: Ask the LLM with the rendered template
+	answer, err := s.basicChatRepo.ChatWithTemplate(ctx, templateName, variables)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to ask basic prompt template chat")
+	}
+
+	return answer, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type EvaluationServiceImpl struct {
+	embeddingRepo repository.EmbeddingRepository
+	knowledgeRepo repository.InquiryKnowledgeRepository
+}
+
+// NewEvaluationServiceImpl creates a new offline evaluation service.
+// knowledgeRepo must be an empty index dedicated to the run (e.g. the in-memory repository):
+// the training split is saved into it.
+func NewEvaluationServiceImpl(
+	embeddingRepo repository.EmbeddingRepository,
+	knowledgeRepo repository.InquiryKnowledgeRepository,
+) *EvaluationServiceImpl {
+	return &EvaluationServiceImpl{
+		embeddingRepo: embeddingRepo,
+		knowledgeRepo: knowledgeRepo,
+	}
+}
+
+// EvaluateRetrieval holds out a share of the labelled knowledge as queries, indexes the rest and
+// scores what is retrieved for each query
+func (s *EvaluationServiceImpl) EvaluateRetrieval(
+	ctx context.Context,
+	items domain.InquiryKnowledges,
+	cfg domain.RetrievalEvalConfig,
+) (*domain.RetrievalReport, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Step 1: Split the knowledge into an index and held-out queries
+	index, queries := domain.SplitForRetrievalEval(items, cfg)
+	if len(queries) == 0 {
+		return nil, errors.New(
+			constants.InvalidParameter,
+			"holdout is too small to hold out any query",
+			nil,
+		)
+	}
+
+	// Step 2: Embed and save the index
+	for batch := range slices.Chunk(index, batchSize) {
+		embeddings, err := s.embeddingRepo.EmbedStrings(ctx, batch.Instructions())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to embed evaluation index")
+		}
+		batch.SetEmbeddings(embeddings)
+
+		if err := s.knowledgeRepo.BatchSaveInquiryKnowledge(ctx, batch); err != nil {
+			return nil, errors.Wrap(err, "failed to save evaluation index")
+		}
+	}
+
+	// Step 3: Run every query through retrieval
+	results := make([]domain.RetrievalQueryResult, 0, len(queries))
+	for _, query := range queries {
+		embedding, err := s.embeddingRepo.EmbedString(ctx, query.Instruction)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to embed evaluation query")
+		}
+
+		similar, err := s.knowledgeRepo.FindSimilars(
+			ctx, embedding, cfg.K, domain.KnowledgeFilter{},
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve evaluation query")
+		}
+		results = append(results, domain.RetrievalQueryResult{Query: query, Results: similar})
+	}
+
+	// Step 4: Score the results
+	return domain.NewRetrievalReport(cfg, index, results), nil
+}
+
+type AnswerEvaluationServiceImpl struct {
+	inquirySvc InquiryService
+	judgeRepo  repository.AnswerJudgeRepository
+}
+
+// NewAnswerEvaluationServiceImpl creates a new answer quality evaluation service.
+// Golden questions are answered by inquirySvc, so the whole answering pipeline is evaluated.
+func NewAnswerEvaluationServiceImpl(
+	inquirySvc InquiryService,
+	judgeRepo repository.AnswerJudgeRepository,
+) *AnswerEvaluationServiceImpl {
+	return &AnswerEvaluationServiceImpl{
+		inquirySvc: inquirySvc,
+		judgeRepo:  judgeRepo,
+	}
+}
+
+// EvaluateAnswers answers every golden question and has the judge score each answer against its
+// retrieved context and reference answer. A question that cannot be answered or judged is
+// reported as failed rather than stopping the run.
+func (s *AnswerEvaluationServiceImpl) EvaluateAnswers(
+	ctx context.Context,
+	golden domain.GoldenQuestions,
+) (*domain.AnswerEvalReport, error) {
+	if len(golden) == 0 {
+		return nil, errors.New(constants.InvalidParameter, "golden set is empty", nil)
+	}
+
+	results := make([]domain.AnswerEvalResult, 0, len(golden))
+	for _, question := range golden {
+		result := domain.AnswerEvalResult{Golden: question}
+
+		// Step 1: Answer the question through the full pipeline
+		answer, err := s.inquirySvc.Ask(ctx, question.Question, nil)
+		if err != nil {
+			result.Error = errors.Wrap(err, "failed to answer golden question").Error()
+			results = append(results, result)
+			continue
+		}
+		result.Answer = answer.Text
+		result.Sources = answer.Sources
+
+		// Step 2: Judge the answer against what it was generated from
+		judgement, err := s.judgeRepo.JudgeAnswer(
+			ctx,
+			question.Question,
+			answer.Sources.ContextText(),
+			question.ReferenceAnswer,
+			answer.Text,
+		)
+		if err != nil {
+			result.Error = errors.Wrap(err, "failed to judge answer").Error()
+			results = append(results, result)
+			continue
+		}
+		result.Judgement = judgement
+		results = append(results, result)
+	}
+
+	// Step 3: Aggregate the judgements
+	return domain.NewAnswerEvalReport(results), nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type ExperimentServiceImpl struct {
+	experimentRepo repository.ExperimentRepository
+	exposureRepo   repository.ExperimentExposureRepository
+}
+
+func NewExperimentServiceImpl(
+	experimentRepo repository.ExperimentRepository,
+	exposureRepo repository.ExperimentExposureRepository,
+) *ExperimentServiceImpl {
+	return &ExperimentServiceImpl{
+		experimentRepo: experimentRepo,
+		exposureRepo:   exposureRepo,
+	}
+}
+
+// ListExperiments returns every configured experiment
+func (s *ExperimentServiceImpl) ListExperiments(ctx context.Context) (domain.Experiments, error) {
+	experiments, err := s.experimentRepo.ListExperiments(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list experiments")
+	}
+	return experiments, nil
+}
+
+// CompareVariants returns feedback score and latency statistics for every variant of an
+// experiment, including variants that have not been exposed yet
+func (s *ExperimentServiceImpl) CompareVariants(
+	ctx context.Context,
+	name string,
+) (domain.VariantStats, error) {
+	// Step 1: Find the experiment
+	experiments, err := s.experimentRepo.ListExperiments(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list experiments")
+	}
+
+	var experiment *domain.Experiment
+	for _, e := range experiments {
+		if e.Name == name {
+			experiment = e
+			break
+		}
+	}
+	if experiment == nil {
+		return nil, errors.New(
+			constants.NotFound,
+			fmt.Sprintf("experiment %q not found", name),
+			nil,
+		)
+	}
+
+	// Step 2: Aggregate recorded outcomes per variant
+	recorded, err := s.exposureRepo.GetVariantStats(ctx, experiment.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get variant stats")
+	}
+
+	byVariant := make(map[string]*domain.VariantStat, len(recorded))
+	for _, stat := range recorded {
+		byVariant[stat.Variant] = stat
+	}
+
+	// Step 3: Order stats by configured variants, filling in unexposed variants
+	stats := make(domain.VariantStats, 0, len(experiment.Variants))
+	for _, variant := range experiment.Variants {
+		stat, ok := byVariant[variant.Name]
+		if !ok {
+			stat = &domain.VariantStat{Experiment: experiment.Name, Variant: variant.Name}
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// knowledgeGapCandidateLimit caps how many recent questions are embedded and clustered
+const knowledgeGapCandidateLimit = 500
+
+// FeedbackConfig controls which questions are reported as knowledge gaps
+type FeedbackConfig struct {
+	GapMaxSimilarity     float64 // Questions whose best match is less similar are gap candidates
+	GapClusterSimilarity float64 // Minimum similarity of questions grouped in one gap
+}
+
+type FeedbackServiceImpl struct {
+	auditRepo     repository.AuditRepository
+	feedbackRepo  repository.AnswerFeedbackRepository
+	exposureRepo  repository.ExperimentExposureRepository
+	embeddingRepo repository.EmbeddingRepository
+	cfg           FeedbackConfig
+}
+
+func NewFeedbackServiceImpl(
+	auditRepo repository.AuditRepository,
+	feedbackRepo repository.AnswerFeedbackRepository,
+	exposureRepo repository.ExperimentExposureRepository,
+	embeddingRepo repository.EmbeddingRepository,
+	cfg FeedbackConfig,
+) *FeedbackServiceImpl {
+	return &FeedbackServiceImpl{
+		auditRepo:     auditRepo,
+		feedbackRepo:  feedbackRepo,
+		exposureRepo:  exposureRepo,
+		embeddingRepo: embeddingRepo,
+		cfg:           cfg,
+	}
+}
+
+// SubmitFeedback stores the caller's rating of an answer they were given, along with the
+// knowledge entries the answer was generated from. When the answer was generated under an
+// experiment variant, the rating also scores the variant; failing to score it does not fail the
+// feedback, the error is added to its warnings.
+func (s *FeedbackServiceImpl) SubmitFeedback(
+	ctx context.Context,
+	answerID int,
+	rating, reason, correction string,
+) (*domain.AnswerFeedback, error) {
+	// Step 1: Find the audited answer and check it was given to the caller
+	answer, err := s.auditRepo.GetAuditRecord(ctx, answerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get answer")
+	}
+
+	var userID *int
+	if id, ok := utils.GetUserID(ctx); ok {
+		userID = &id
+	}
+	apiKeyID := utils.GetAPIKeyID(ctx)
+	if !answer.AskedBy(userID, apiKeyID) {
+		return nil, errors.New(
+			constants.Forbidden,
+			"feedback can only be given on your own answers",
+			nil,
+		)
+	}
+
+	// Step 2: Validate and store the feedback
+	feedback, err := domain.NewAnswerFeedback(answer, rating, reason, correction, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	feedback.UserID = userID
+	feedback.APIKeyID = apiKeyID
+
+	if err := s.feedbackRepo.SaveAnswerFeedback(ctx, feedback); err != nil {
+		return nil, errors.Wrap(err, "failed to save answer feedback")
+	}
+
+	// Step 3: Score the experiment exposure of the answer without failing the stored feedback
+	if err := s.exposureRepo.ScoreExperimentExposure(
+		ctx,
+		answerID,
+		feedback.Rating.Score(),
+	); err != nil {
+		feedback.Warnings = append(
+			feedback.Warnings,
+			errors.Wrap(err, "failed to score experiment exposure"),
+		)
+	}
+	return feedback, nil
+}
+
+// RankKnowledgeByNegativeFeedback returns the knowledge entries whose answers were rated down
+// most often since the given time
+func (s *FeedbackServiceImpl) RankKnowledgeByNegativeFeedback(
+	ctx context.Context,
+	since time.Time,
+	limit int,
+) (domain.KnowledgeFeedbackStats, error) {
+	stats, err := s.feedbackRepo.RankKnowledgeByNegativeFeedback(ctx, since, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to rank knowledge by negative feedback")
+	}
+	return stats, nil
+}
+
+// FindKnowledgeGaps clusters recent questions that were poorly matched or rated down into
+// gaps the content team can write new knowledge entries for, largest first
+func (s *FeedbackServiceImpl) FindKnowledgeGaps(
+	ctx context.Context,
+	since time.Time,
+	limit int,
+) (domain.KnowledgeGaps, error) {
+	// Step 1: Find the questions the knowledge base may not cover
+	candidates, err := s.feedbackRepo.ListKnowledgeGapCandidates(
+		ctx,
+		since,
+		s.cfg.GapMaxSimilarity,
+		knowledgeGapCandidateLimit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list knowledge gap candidates")
+	}
+	if len(candidates) == 0 {
+		return domain.KnowledgeGaps{}, nil
+	}
+
+	// Step 2: Use the stored question embeddings, embedding in batches only the questions
+	// recorded without one
+	embeddings := make(domain.Embeddings, len(candidates))
+	var missing []int
+	for i, candidate := range candidates {
+		if candidate.QuestionEmbedding.IsEmpty() {
+			missing = append(missing, i)
+			continue
+		}
+		embeddings[i] = candidate.QuestionEmbedding
+	}
+	for batch := range slices.Chunk(missing, batchSize) {
+		questions := make([]string, len(batch))
+		for i, index := range batch {
+			questions[i] = candidates[index].Question
+		}
+		batchEmbeddings, err := s.embeddingRepo.EmbedStrings(ctx, questions)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to embed knowledge gap questions")
+		}
+		if len(batchEmbeddings) != len(batch) {
+			return nil, errors.New(
+				constants.InternalError,
+				"embedding generation returned an unexpected number of results",
+				nil,
+			)
+		}
+		for i, index := range batch {
+			embeddings[index] = batchEmbeddings[i]
+		}
+	}
+
+	// Step 3: Cluster similar questions
+	gaps := domain.ClusterKnowledgeGaps(candidates, embeddings, s.cfg.GapClusterSimilarity)
+	if len(gaps) > limit {
+		gaps = gaps[:limit]
+	}
+	return gaps, nil
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
+)
+
+const (
+	// healthCheckTimeout bounds each readiness check
+	healthCheckTimeout = 3 * time.Second
+	// llmProbeTimeout bounds the optional LLM probe
+	llmProbeTimeout = 15 * time.Second
+	// llmProbeInterval limits how often the paid LLM probe runs; results are reused in between
+	llmProbeInterval = time.Minute
+	// embeddingProbeText is embedded once to learn the embedding model's dimensions
+	embeddingProbeText = "readiness probe"
+
+	// embeddingDimensionsCacheName and llmProbeCacheName label the readiness caches in metrics
+	embeddingDimensionsCacheName = "embedding_dimensions"
+	llmProbeCacheName            = "llm_probe"
+)
+
+// HealthConfig controls which readiness checks run
+type HealthConfig struct {
+	MigrationVersion uint // Required schema migration version (0 = any clean version)
+	ProbeLLM         bool // Send a short prompt to the LLM provider
+}
+
+type HealthServiceImpl struct {
+	dbHealthRepo  repository.DatabaseHealthRepository
+	knowledgeRepo repository.InquiryKnowledgeRepository
+	embeddingRepo repository.EmbeddingRepository
+	basicChatRepo repository.BasicChatRepository
+	cfg           HealthConfig
+
+	mu sync.Mutex
+	// modelDimensions caches the embedding model's output size (it never changes)
+	modelDimensions int
+	// lastLLMProbe caches the last LLM probe result until llmProbeInterval has passed
+	lastLLMProbe *domain.ComponentHealth
+}
+
+func NewHealthServiceImpl(
+	dbHealthRepo repository.DatabaseHealthRepository,
+	knowledgeRepo repository.InquiryKnowledgeRepository,
+	embeddingRepo repository.EmbeddingRepository,
+	basicChatRepo repository.BasicChatRepository,
+	cfg HealthConfig,
+) *HealthServiceImpl {
+	return &HealthServiceImpl{
+		dbHealthRepo:  dbHealthRepo,
+		knowledgeRepo: knowledgeRepo,
+		embeddingRepo: embeddingRepo,
+		basicChatRepo: basicChatRepo,
+		cfg:           cfg,
+	}
+}
+
+// CheckReadiness checks every dependency the service needs to answer questions.
+// Checks that need the database are skipped when it is unreachable.
+func (s *HealthServiceImpl) CheckReadiness(ctx context.Context) *domain.HealthReport {
+	report := &domain.HealthReport{CheckedAt: time.Now()}
+
+	// Step 1: Check the database first; everything else but the LLM depends on it
+	database := runHealthCheck(ctx, domain.ComponentDatabase, healthCheckTimeout,
+		func(ctx context.Context) (string, error) {
+			return "", s.dbHealthRepo.PingDatabase(ctx)
+		},
+	)
+
+	// Step 2: Run the remaining checks concurrently
+	checks := []func() *domain.ComponentHealth{
+		s.dbCheck(ctx, database, domain.ComponentPgvector, s.checkPgvector),
+		s.dbCheck(ctx, database, domain.ComponentMigrations, s.checkMigrations),
+		s.dbCheck(ctx, database, domain.ComponentKnowledge, s.checkKnowledge),
+		s.dbCheck(ctx, database, domain.ComponentEmbedding, s.checkEmbedding),
+		func() *domain.ComponentHealth { return s.checkLLM(ctx) },
+	}
+	results := make([]*domain.ComponentHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = check()
+		}()
+	}
+	wg.Wait()
+
+	report.Components = append([]*domain.ComponentHealth{database}, results...)
+	return report
+}
+
+// dbCheck returns a check that is skipped when the database is down
+func (s *HealthServiceImpl) dbCheck(
+	ctx context.Context,
+	database *domain.ComponentHealth,
+	name string,
+	fn func(ctx context.Context) (string, error),
+) func() *domain.ComponentHealth {
+	return func() *domain.ComponentHealth {
+		if database.Status != domain.ComponentStatusUp {
+			return &domain.ComponentHealth{
+				Name:   name,
+				Status: domain.ComponentStatusSkipped,
+				Detail: "database is down",
+			}
+		}
+		return runHealthCheck(ctx, name, healthCheckTimeout, fn)
+	}
+}
+
+// checkPgvector verifies the pgvector extension is installed
+func (s *HealthServiceImpl) checkPgvector(ctx context.Context) (string, error) {
+	version, err := s.dbHealthRepo.GetVectorExtensionVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+	return "version " + version, nil
+}
+
+// checkMigrations verifies the schema is migrated cleanly to the required version
+func (s *HealthServiceImpl) checkMigrations(ctx context.Context) (string, error) {
+	state, err := s.dbHealthRepo.GetMigrationState(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	detail := "version " + strconv.FormatUint(uint64(state.Version), 10)
+	if state.Dirty {
+		return detail, errors.New(constants.ServiceUnavailable, detail+" is dirty", nil)
+	}
+	if s.cfg.MigrationVersion > 0 && state.Version != s.cfg.MigrationVersion {
+		return detail, errors.New(
+			constants.ServiceUnavailable,
+			fmt.Sprintf("%s, expected version %d", detail, s.cfg.MigrationVersion),
+			nil,
+		)
+	}
+	return detail, nil
+}
+
+// checkKnowledge verifies the knowledge base has embedded entries to answer from
+func (s *HealthServiceImpl) checkKnowledge(ctx context.Context) (string, error) {
+	count, err := s.knowledgeRepo.CountEmbeddedInquiryKnowledge(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	detail := strconv.Itoa(count) + " embedded entries"
+	if count == 0 {
+		return detail, errors.New(constants.ServiceUnavailable, "knowledge base is empty", nil)
+	}
+	return detail, nil
+}
+
+// checkEmbedding verifies the embedding model produces vectors that fit the knowledge column
+func (s *HealthServiceImpl) checkEmbedding(ctx context.Context) (string, error) {
+	columnDimensions, err := s.dbHealthRepo.GetEmbeddingDimensions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	modelDimensions, err := s.embeddingModelDimensions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	detail := fmt.Sprintf("model %d, column %d dimensions", modelDimensions, columnDimensions)
+	if modelDimensions != columnDimensions {
+		return detail, errors.New(
+			constants.ServiceUnavailable,
+			"embedding dimensions mismatch: "+detail,
+			nil,
+		)
+	}
+	return detail, nil
+}
+
+// embeddingModelDimensions embeds a probe text once and caches the vector size
+func (s *HealthServiceImpl) embeddingModelDimensions(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	dimensions := s.modelDimensions
+	s.mu.Unlock()
+	if dimensions > 0 {
+		metrics.ObserveCache(embeddingDimensionsCacheName, metrics.CacheHit)
+		return dimensions, nil
+	}
+	metrics.ObserveCache(embeddingDimensionsCacheName, metrics.CacheMiss)
+
+	embedding, err := s.embeddingRepo.EmbedString(ctx, embeddingProbeText)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.modelDimensions = len(embedding)
+	s.mu.Unlock()
+	return len(embedding), nil
+}
+
+// checkLLM probes the LLM provider when enabled, reusing a recent result
+func (s *HealthServiceImpl) checkLLM(ctx context.Context) *domain.ComponentHealth {
+	if !s.cfg.ProbeLLM {
+		return &domain.ComponentHealth{
+			Name:   domain.ComponentLLM,
+			Status: domain.ComponentStatusSkipped,
+			Detail: "probe disabled",
+		}
+	}
+
+	s.mu.Lock()
+	last := s.lastLLMProbe
+	s.mu.Unlock()
+	if last != nil && time.Since(last.CheckedAt) < llmProbeInterval {
+		metrics.ObserveCache(llmProbeCacheName, metrics.CacheHit)
+		return last
+	}
+	metrics.ObserveCache(llmProbeCacheName, metrics.CacheMiss)
+
+	result := runHealthCheck(ctx, domain.ComponentLLM, llmProbeTimeout,
+		func(ctx context.Context) (string, error) {
+			answer, err := s.basicChatRepo.Chat(ctx, "Reply with OK.")
+			if err != nil {
+				return "", err
+			}
+			return "model " + answer.Metadata.Model, nil
+		},
+	)
+
+	s.mu.Lock()
+	s.lastLLMProbe = result
+	s.mu.Unlock()
+	return result
+}
+
+// runHealthCheck runs a check with a timeout and records its status and latency
+func runHealthCheck(
+	ctx context.Context,
+	name string,
+	timeout time.Duration,
+	fn func(ctx context.Context) (string, error),
+) *domain.ComponentHealth {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	detail, err := fn(ctx)
+	result := &domain.ComponentHealth{
+		Name:      name,
+		Status:    domain.ComponentStatusUp,
+		Latency:   time.Since(start),
+		Detail:    detail,
+		CheckedAt: start,
+	}
+	if err != nil {
+		result.Status = domain.ComponentStatusDown
+		result.Detail = errors.PublicMessage(err)
+		result.Err = err
+	}
+	return result
+}
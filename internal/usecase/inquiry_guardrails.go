@@ -0,0 +1,346 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
+	"github.com/wonjinsin/simple-chatbot/pkg/pii"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// GuardrailConfig controls the guardrails that questions, context and answers pass through
+type GuardrailConfig struct {
+	Injection  InjectionGuardConfig
+	PII        PIIConfig
+	Moderation ModerationConfig
+}
+
+// InjectionGuardConfig controls the prompt-injection guard of questions and retrieved context
+type InjectionGuardConfig struct {
+	Enabled bool
+	Action  domain.GuardAction
+	// ClassifyContext also runs the LLM classifier on every retrieved entry (one call each)
+	ClassifyContext bool
+}
+
+// PIIConfig controls the redaction of personal data (emails, phones, cards and IBANs)
+type PIIConfig struct {
+	// Enabled redacts questions and history before the embedding and LLM calls and masks the
+	// audit record and the stored conversation history
+	Enabled bool
+	// RestoreAnswers puts the original values back in place of placeholders the answer repeats
+	RestoreAnswers bool
+}
+
+// ModerationConfig controls the checks of generated answers against the answer policy
+type ModerationConfig struct {
+	Enabled bool
+	Policy  domain.AnswerPolicy
+	Action  domain.ModerationAction
+	// MaxRegenerations bounds the regenerate action; an answer still violating falls back
+	MaxRegenerations int
+	// HandoffMessage replaces the text of an answer flagged for handoff
+	HandoffMessage string
+}
+
+// injectionDetection is a prompt injection found in a question or a retrieved entry
+type injectionDetection struct {
+	detector string
+	reason   string
+	matches  domain.InjectionMatches // Heuristic matches; empty for LLM detections
+}
+
+// detectInjection runs the heuristics on text and, when they find nothing and classify is set,
+// the LLM classifier. It returns nil when text looks clean. A classifier failure is returned as
+// a warning and does not flag the text.
+func (s *InquiryServiceImpl) detectInjection(
+	ctx context.Context,
+	text string,
+	classify bool,
+) (*injectionDetection, error) {
+	if matches := domain.DetectInjection(text); len(matches) > 0 {
+		return &injectionDetection{
+			detector: domain.InjectionDetectorHeuristic,
+			reason:   strings.Join(matches.Rules(), ", "),
+			matches:  matches,
+		}, nil
+	}
+
+	if !classify || s.injectionClassifierRepo == nil {
+		return nil, nil
+	}
+	verdict, err := s.injectionClassifierRepo.ClassifyInjection(ctx, text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to classify prompt injection")
+	}
+	if !verdict.Injection {
+		return nil, nil
+	}
+	return &injectionDetection{detector: domain.InjectionDetectorLLM, reason: verdict.Reason}, nil
+}
+
+// guardQuestion checks the question for prompt injection and applies the configured action.
+// It returns the question to answer (sanitized when the action is sanitize) and the warnings of
+// detections that did not block it. Sanitizing blocks instead when nothing can be removed.
+func (s *InquiryServiceImpl) guardQuestion(
+	ctx context.Context,
+	question string,
+) (string, []error, error) {
+	cfg := s.guardrailCfg.Injection
+	if !cfg.Enabled {
+		return question, nil, nil
+	}
+
+	detection, warning := s.detectInjection(ctx, question, true)
+	var warnings []error
+	if warning != nil {
+		warnings = append(warnings, warning)
+	}
+	if detection == nil {
+		return question, warnings, nil
+	}
+
+	action := cfg.Action
+	sanitized := question
+	if action == domain.GuardActionSanitize {
+		sanitized = detection.matches.Sanitize(question)
+		if len(detection.matches) == 0 || utils.IsEmptyOrWhitespace(sanitized) {
+			action = domain.GuardActionBlock
+		}
+	}
+	metrics.ObservePromptInjection(domain.InjectionSourceQuestion, detection.detector, string(action))
+
+	detail := fmt.Sprintf("prompt injection in question (%s: %s)", detection.detector, detection.reason)
+	switch action {
+	case domain.GuardActionLogOnly:
+		return question, append(warnings, errors.New(constants.PromptInjection, detail, nil)), nil
+	case domain.GuardActionSanitize:
+		return sanitized, append(warnings, errors.New(constants.PromptInjection, detail+", sanitized", nil)), nil
+	default:
+		return "", nil, errors.Wrap(
+			errors.New(constants.PromptInjection, "question was rejected by the prompt-injection guard", nil),
+			detail,
+		)
+	}
+}
+
+// guardContext checks the retrieved entries for prompt injection and applies the configured
+// action: block refuses the request, sanitize drops the flagged entries from the context. It
+// returns the warnings of detections that did not block the request.
+func (s *InquiryServiceImpl) guardContext(
+	ctx context.Context,
+	retrieved *retrievedContext,
+) ([]error, error) {
+	cfg := s.guardrailCfg.Injection
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var warnings []error
+	kept := make(domain.InquirySimilarityResults, 0, len(retrieved.results))
+	for _, result := range retrieved.results {
+		text := result.Knowledge.Instruction + "\n" + result.Knowledge.Response
+		detection, warning := s.detectInjection(ctx, text, cfg.ClassifyContext)
+		if warning != nil {
+			warnings = append(warnings, warning)
+		}
+		if detection == nil {
+			kept = append(kept, result)
+			continue
+		}
+
+		metrics.ObservePromptInjection(domain.InjectionSourceContext, detection.detector, string(cfg.Action))
+		detail := fmt.Sprintf(
+			"prompt injection in knowledge #%d (%s: %s)",
+			result.Knowledge.ID, detection.detector, detection.reason,
+		)
+		switch cfg.Action {
+		case domain.GuardActionLogOnly:
+			kept = append(kept, result)
+			warnings = append(warnings, errors.New(constants.PromptInjection, detail, nil))
+		case domain.GuardActionSanitize:
+			warnings = append(warnings, errors.New(constants.PromptInjection, detail+", dropped", nil))
+		default:
+			return nil, errors.Wrap(
+				errors.New(
+					constants.PromptInjection,
+					"retrieved knowledge was rejected by the prompt-injection guard",
+					nil,
+				),
+				detail,
+			)
+		}
+	}
+
+	if len(kept) < len(retrieved.results) {
+		retrieved.results = kept
+		retrieved.text = kept.ContextText()
+	}
+	return warnings, nil
+}
+
+// redactPII replaces the personal data in text with placeholders kept in vault
+func (s *InquiryServiceImpl) redactPII(vault *pii.Vault, text string) string {
+	if !s.guardrailCfg.PII.Enabled {
+		return text
+	}
+	return vault.Redact(text)
+}
+
+// redactMessagesPII returns copies of the messages with their personal data redacted into vault
+func (s *InquiryServiceImpl) redactMessagesPII(
+	vault *pii.Vault,
+	messages domain.ChatMessages,
+) domain.ChatMessages {
+	if !s.guardrailCfg.PII.Enabled {
+		return messages
+	}
+	redacted := make(domain.ChatMessages, 0, len(messages))
+	for _, msg := range messages {
+		redacted = append(redacted, &domain.ChatMessage{
+			Role:    msg.Role,
+			Content: vault.Redact(msg.Content),
+		})
+	}
+	return redacted
+}
+
+// restorePII puts the values of vault back in place of the placeholders in a generated text
+func (s *InquiryServiceImpl) restorePII(vault *pii.Vault, text string) string {
+	if !s.guardrailCfg.PII.Enabled || !s.guardrailCfg.PII.RestoreAnswers {
+		return text
+	}
+	return vault.Restore(text)
+}
+
+// maskPII masks the personal data of a text that is stored
+func (s *InquiryServiceImpl) maskPII(text string) string {
+	if !s.guardrailCfg.PII.Enabled {
+		return text
+	}
+	return pii.Mask(text)
+}
+
+// maskAuditRecord masks the personal data of the free-text fields of an audit record
+func (s *InquiryServiceImpl) maskAuditRecord(record *domain.AuditRecord) {
+	record.Question = s.maskPII(record.Question)
+	record.RawOutput = s.maskPII(record.RawOutput)
+	record.Answer = s.maskPII(record.Answer)
+	record.Error = s.maskPII(record.Error)
+}
+
+// moderateAnswer checks a generated answer against the answer policy and applies the configured
+// action to a violating one: regenerate it with the violations as feedback, answer with the top
+// retrieved response, or flag it for handoff to a human agent. An answer that still violates
+// after the last regeneration (or fails to regenerate) falls back, and falling back without a
+// compliant retrieved response hands off. The answer's slots are expected filled; slots fill
+// those of regenerated answers, fallback responses and the responses the answer is checked
+// against, so the policy sees the text the customer receives. regenerate generates the answer
+// again with the given feedback appended to the retrieved context. It returns the answer to send
+// and the warnings describing the violations.
+func (s *InquiryServiceImpl) moderateAnswer(
+	answer *domain.Answer,
+	retrieved *retrievedContext,
+	slots domain.Slots,
+	regenerate func(feedback string) (*domain.Answer, error),
+) (*domain.Answer, []error) {
+	cfg := s.guardrailCfg.Moderation
+	if !cfg.Enabled {
+		return answer, nil
+	}
+
+	sources := make([]string, 0, len(retrieved.results))
+	for _, result := range retrieved.results {
+		sources = append(sources, slots.Resolve(result.Knowledge.Response))
+	}
+
+	var warnings []error
+	for regenerations := 0; ; regenerations++ {
+		violations := cfg.Policy.Check(answer.Text, sources)
+		if len(violations) == 0 {
+			return answer, warnings
+		}
+
+		action := cfg.Action
+		if action == domain.ModerationActionRegenerate && regenerations >= cfg.MaxRegenerations {
+			action = domain.ModerationActionFallback
+		}
+		if action == domain.ModerationActionFallback && len(retrieved.results) == 0 {
+			action = domain.ModerationActionHandoff
+		}
+		for _, rule := range violations.Rules() {
+			metrics.ObserveAnswerPolicyViolation(rule, string(action))
+		}
+		warnings = append(warnings, errors.New(
+			constants.AnswerPolicyViolation,
+			fmt.Sprintf("answer violates policy (%s), action: %s", violations, action),
+			nil,
+		))
+
+		switch action {
+		case domain.ModerationActionRegenerate:
+			regenerated, err := regenerate(policyFeedback(violations))
+			if err == nil {
+				regenerated.Text = slots.Resolve(regenerated.Text)
+				regenerated.Warnings = append(answer.Warnings, regenerated.Warnings...)
+				answer = regenerated
+				continue
+			}
+			warnings = append(warnings, errors.Wrap(err, "failed to regenerate answer"))
+			fallthrough
+		case domain.ModerationActionFallback:
+			answer, warning := s.fallbackAnswer(answer, sources)
+			if warning != nil {
+				warnings = append(warnings, warning)
+			}
+			return answer, warnings
+		default:
+			return s.handoffAnswer(answer), warnings
+		}
+	}
+}
+
+// fallbackAnswer replaces the text of answer with the top retrieved response (the first of the
+// sources, its slots filled), or flags it for handoff when nothing was retrieved or the response
+// itself violates the policy
+func (s *InquiryServiceImpl) fallbackAnswer(
+	answer *domain.Answer,
+	sources []string,
+) (*domain.Answer, error) {
+	if len(sources) == 0 {
+		return s.handoffAnswer(answer), nil
+	}
+	response := sources[0]
+	if violations := s.guardrailCfg.Moderation.Policy.Check(response, sources); len(violations) > 0 {
+		return s.handoffAnswer(answer), errors.New(
+			constants.AnswerPolicyViolation,
+			fmt.Sprintf("fallback response violates policy (%s), action: handoff", violations),
+			nil,
+		)
+	}
+	answer.Text = response
+	return answer, nil
+}
+
+// handoffAnswer replaces the text of answer with the handoff message and flags it for a human agent
+func (s *InquiryServiceImpl) handoffAnswer(answer *domain.Answer) *domain.Answer {
+	answer.Text = s.guardrailCfg.Moderation.HandoffMessage
+	answer.Handoff = true
+	return answer
+}
+
+// policyFeedback formats the violations of a rejected answer as an addition to the LLM context,
+// so the regenerated answer avoids them
+func policyFeedback(violations domain.PolicyViolations) string {
+	var b strings.Builder
+	b.WriteString("\nA previous answer to this question was rejected. The new answer must avoid these problems:\n")
+	for _, v := range violations {
+		b.WriteString(fmt.Sprintf("- %s: %s\n", v.Rule, v.Detail))
+	}
+	return b.String()
+}
@@ -3,14 +3,21 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
 	"github.com/wonjinsin/simple-chatbot/internal/domain"
 	"github.com/wonjinsin/simple-chatbot/internal/repository"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 	"github.com/wonjinsin/simple-chatbot/pkg/file"
+	"github.com/wonjinsin/simple-chatbot/pkg/metrics"
+	"github.com/wonjinsin/simple-chatbot/pkg/pii"
+	"github.com/wonjinsin/simple-chatbot/pkg/ratelimit"
 	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
@@ -19,131 +26,719 @@ const (
 	similarityLimit = 3  // Number of similar entries to retrieve
 )
 
+// IngestionConfig controls how knowledge ingestion calls the embedding model
+type IngestionConfig struct {
+	Concurrency     int // Number of batches embedded in parallel
+	TokensPerMinute int // Embedding token budget per minute (0 = unlimited)
+}
+
 type InquiryServiceImpl struct {
-	embeddingRepo    repository.EmbeddingRepository
-	knowledgeRepo    repository.InquiryKnowledgeRepository
-	answerRefineRepo repository.AnswerRefineRepository
+	embeddingRepo           repository.EmbeddingRepository
+	knowledgeRepo           repository.InquiryKnowledgeRepository
+	answerRefineRepo        repository.AnswerRefineRepository
+	experimentRepo          repository.ExperimentRepository
+	exposureRepo            repository.ExperimentExposureRepository
+	conversationRepo        repository.ConversationRepository
+	auditRepo               repository.AuditRepository
+	injectionClassifierRepo repository.InjectionClassifierRepository // nil = heuristics only
+	ingestionCfg            IngestionConfig
+	guardrailCfg            GuardrailConfig
+	companySlots            domain.Slots // Company-level values of the knowledge base's slots
+}
+
+// InquiryServiceOptions holds the dependencies and settings of InquiryServiceImpl
+type InquiryServiceOptions struct {
+	EmbeddingRepo           repository.EmbeddingRepository
+	KnowledgeRepo           repository.InquiryKnowledgeRepository
+	AnswerRefineRepo        repository.AnswerRefineRepository
+	ExperimentRepo          repository.ExperimentRepository
+	ExposureRepo            repository.ExperimentExposureRepository
+	ConversationRepo        repository.ConversationRepository
+	AuditRepo               repository.AuditRepository
+	InjectionClassifierRepo repository.InjectionClassifierRepository // nil = heuristics only
+	Ingestion               IngestionConfig
+	Guardrails              GuardrailConfig
+	CompanySlots            domain.Slots // Company-level values of the knowledge base's slots
 }
 
-func NewInquiryServiceImpl(
-	embeddingRepo repository.EmbeddingRepository,
-	knowledgeRepo repository.InquiryKnowledgeRepository,
-	answerRefineRepo repository.AnswerRefineRepository,
-) *InquiryServiceImpl {
+func NewInquiryServiceImpl(opts InquiryServiceOptions) *InquiryServiceImpl {
 	return &InquiryServiceImpl{
-		embeddingRepo:    embeddingRepo,
-		knowledgeRepo:    knowledgeRepo,
-		answerRefineRepo: answerRefineRepo,
+		embeddingRepo:           opts.EmbeddingRepo,
+		knowledgeRepo:           opts.KnowledgeRepo,
+		answerRefineRepo:        opts.AnswerRefineRepo,
+		experimentRepo:          opts.ExperimentRepo,
+		exposureRepo:            opts.ExposureRepo,
+		conversationRepo:        opts.ConversationRepo,
+		auditRepo:               opts.AuditRepo,
+		injectionClassifierRepo: opts.InjectionClassifierRepo,
+		ingestionCfg:            opts.Ingestion,
+		guardrailCfg:            opts.Guardrails,
+		companySlots:            opts.CompanySlots,
 	}
 }
 
-// EmbedInquiryOrigins reads CSV data, generates embeddings, and saves to database
-func (s *InquiryServiceImpl) EmbedInquiryOrigins(ctx context.Context) error {
+// EmbedInquiryOrigins reads CSV data, generates embeddings, and saves to database.
+// Batches are embedded concurrently by a bounded worker pool while a single writer saves
+// them in their original order.
+func (s *InquiryServiceImpl) EmbedInquiryOrigins(
+	ctx context.Context,
+) (*domain.IngestionReport, error) {
+	start := time.Now()
+
 	// Step 1: Read CSV file
 	csvRows, err := file.ReadCSVToMapArray("mock_data/data_set.csv")
 	if err != nil {
-		return errors.Wrap(err, "failed to read inquiry origins", constants.InternalError)
+		return nil, errors.Wrap(err, "failed to read inquiry origins", constants.InternalError)
 	}
 
 	// Step 2: Convert CSV rows to domain objects (without embeddings)
 	knowledgeItems, err := domain.NewInquiryKnowledgeFromCSVs(csvRows)
 	if err != nil {
-		return errors.Wrap(
+		return nil, errors.Wrap(
 			err,
 			"failed to convert CSV rows to domain objects",
 			constants.InternalError,
 		)
 	}
 
-	// Step 3: Process in batches
-	i := 0
-	for batch := range slices.Chunk(knowledgeItems, batchSize) {
-		instructions := batch.Instructions()
+	// Step 3: Embed batches in parallel and save them in order
+	batches := slices.Collect(slices.Chunk(knowledgeItems, batchSize))
+	estimatedTokens, err := s.embedAndSaveBatches(ctx, batches)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.IngestionReport{
+		Rows:            len(knowledgeItems),
+		Batches:         len(batches),
+		EstimatedTokens: estimatedTokens,
+		Duration:        time.Since(start),
+	}, nil
+}
+
+// embeddedBatch is the result of embedding a single batch
+type embeddedBatch struct {
+	index  int
+	tokens int
+	err    error
+}
+
+// embedAndSaveBatches embeds batches with a bounded worker pool and saves them in order.
+// The first failure cancels the batches still being embedded, which are waited for before it
+// returns. It returns the estimated number of tokens sent to the embedding model.
+func (s *InquiryServiceImpl) embedAndSaveBatches(
+	ctx context.Context,
+	batches []domain.InquiryKnowledges,
+) (int, error) {
+	// Cancel the producer and workers on return (deferred calls run last-in first-out), then
+	// wait for them so no batch is still being embedded once this returns
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := max(s.ingestionCfg.Concurrency, 1)
+
+	var limiter *ratelimit.TokenBucket
+	if s.ingestionCfg.TokensPerMinute > 0 {
+		limiter = ratelimit.NewPerMinute(s.ingestionCfg.TokensPerMinute)
+	}
+
+	// window bounds the number of batches held in memory awaiting the writer
+	window := make(chan struct{}, concurrency*2)
+	jobs := make(chan int)
+	results := make(chan embeddedBatch, concurrency)
+
+	// Producer: dispatch batch indexes in order
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		for i := range batches {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Workers: embed batches concurrently
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					return
+				}
+				tokens, err := s.embedBatch(ctx, limiter, batches[i])
+				select {
+				case results <- embeddedBatch{index: i, tokens: tokens, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Writer: save batches strictly in their original order
+	pending := make(map[int]embeddedBatch, cap(window))
+	next, totalTokens := 0, 0
+	for res := range results {
+		pending[res.index] = res
+		for {
+			ready, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+
+			if ready.err != nil {
+				return 0, errors.Wrap(
+					ready.err,
+					fmt.Sprintf("failed to generate embeddings for batch %d", next),
+				)
+			}
+
+			if err := s.knowledgeRepo.BatchSaveInquiryKnowledge(ctx, batches[next]); err != nil {
+				return 0, errors.Wrap(
+					err,
+					fmt.Sprintf("failed to save inquiry knowledge for batch %d", next),
+					constants.InternalError,
+				)
+			}
+
+			metrics.AddIngestionRows(len(batches[next]))
+			totalTokens += ready.tokens
+			next++
+			<-window
+		}
+	}
+
+	if next < len(batches) {
+		return 0, errors.Wrap(
+			context.Cause(ctx),
+			fmt.Sprintf("embedding canceled after %d of %d batches", next, len(batches)),
+			constants.InternalError,
+		)
+	}
+
+	return totalTokens, nil
+}
+
+// embedBatch waits for the token budget and generates embeddings for a single batch
+func (s *InquiryServiceImpl) embedBatch(
+	ctx context.Context,
+	limiter *ratelimit.TokenBucket,
+	batch domain.InquiryKnowledges,
+) (int, error) {
+	instructions := batch.Instructions()
+	tokens := utils.EstimateTokens(instructions...)
+
+	if limiter != nil {
+		if err := limiter.Wait(ctx, tokens); err != nil {
+			return 0, errors.Wrap(err, "failed to wait for embedding token budget")
+		}
+	}
+
+	embeddings, err := s.embeddingRepo.EmbedStrings(ctx, instructions)
+	if err != nil {
+		return 0, err
+	}
+	batch.SetEmbeddings(embeddings)
+
+	return tokens, nil
+}
+
+// Ask answers a user question by finding similar inquiry knowledge and refining the answer.
+// When an experiment is running, the request is assigned to a variant whose overrides are applied
+// and the outcome is recorded. The question and retrieved context pass the prompt-injection
+// guard, and personal data is redacted before the models see it. The slots of the answer are
+// resolved with the values found in the question, the given slots, which may only be customer
+// slots, and the company values, which take precedence. Every call, answered or not, is recorded
+// in the audit trail.
+func (s *InquiryServiceImpl) Ask(
+	ctx context.Context,
+	msg string,
+	slots domain.Slots,
+) (*domain.Answer, error) {
+	record := newAuditRecord(ctx, msg)
 
-		embeddings, err := s.embeddingRepo.EmbedStrings(ctx, instructions)
+	answer, err := s.ask(ctx, msg, slots, record)
+	record.Latencies.Total = time.Since(record.CreatedAt)
+	record.SetError(err)
+	s.maskAuditRecord(record)
+
+	if auditErr := s.auditRepo.SaveAuditRecord(ctx, record); auditErr != nil {
 		if err != nil {
-			return errors.Wrap(
+			return nil, errors.Wrap(
 				err,
-				fmt.Sprintf("failed to generate embeddings for batch %d", i),
-				constants.InternalError,
+				"failed to record audit of failed answer ("+auditErr.Error()+")",
 			)
 		}
-		batch.SetEmbeddings(embeddings)
+		answer.Warnings = append(
+			answer.Warnings,
+			errors.Wrap(auditErr, "failed to record audit"),
+		)
+	} else if answer != nil {
+		answer.ID = record.ID
+	}
 
-		if err := s.knowledgeRepo.BatchSaveInquiryKnowledge(ctx, batch); err != nil {
-			return errors.Wrap(
-				err,
-				fmt.Sprintf("failed to save inquiry knowledge for batch %d", i),
-				constants.InternalError,
+	// Record the experiment outcome, linked to the audit record its feedback will score
+	if answer != nil && answer.Metadata.Experiment != "" {
+		exposure := &domain.ExperimentExposure{
+			Experiment: answer.Metadata.Experiment,
+			Variant:    answer.Metadata.Variant,
+			TrID:       record.TrID,
+			Latency:    record.Latencies.Total,
+			CreatedAt:  time.Now(),
+		}
+		if answer.ID != 0 {
+			exposure.AnswerID = &answer.ID
+		}
+		if err := s.exposureRepo.SaveExperimentExposure(ctx, exposure); err != nil {
+			answer.Warnings = append(
+				answer.Warnings,
+				errors.Wrap(err, "failed to record experiment exposure"),
 			)
 		}
-
-		i++
 	}
 
-	return nil
+	return answer, err
 }
 
-// Ask answers a user question by finding similar inquiry knowledge and refining the answer
-func (s *InquiryServiceImpl) Ask(
+// newAuditRecord starts the audit record of a question asked by the caller in ctx
+func newAuditRecord(ctx context.Context, question string) *domain.AuditRecord {
+	record := &domain.AuditRecord{
+		TrID:      utils.GetTrID(ctx),
+		APIKeyID:  utils.GetAPIKeyID(ctx),
+		Question:  question,
+		CreatedAt: time.Now(),
+	}
+	if userID, ok := utils.GetUserID(ctx); ok {
+		record.UserID = &userID
+	}
+	return record
+}
+
+// ask answers a question like Ask and fills in its audit record along the way
+func (s *InquiryServiceImpl) ask(
 	ctx context.Context,
 	msg string,
-) (string, error) {
+	slots domain.Slots,
+	record *domain.AuditRecord,
+) (*domain.Answer, error) {
 	// Step 1: Validate input message
 	msg = strings.TrimSpace(msg)
+	record.Question = msg
 	if utils.IsEmptyOrWhitespace(msg) {
-		return "", errors.New(
+		return nil, errors.New(
 			constants.InvalidParameter,
 			"question cannot be empty",
 			nil,
 		)
 	}
+	for _, name := range slices.Sorted(maps.Keys(slots)) {
+		if !domain.IsCustomerSlot(name) {
+			return nil, errors.New(
+				constants.InvalidParameter,
+				fmt.Sprintf("slot %q cannot be set per request", name),
+				nil,
+			)
+		}
+	}
+
+	// Step 2: Redact personal data before it reaches the models
+	vault := pii.NewVault()
+	redacted := s.redactPII(vault, msg)
 
-	// Step 2: Generate embedding for the user's question
-	embedding, err := s.embeddingRepo.EmbedString(ctx, msg)
+	// Step 3: Guard against prompt injection in the question
+	redacted, warnings, err := s.guardQuestion(ctx, redacted)
 	if err != nil {
-		return "", errors.Wrap(
-			err,
-			"failed to generate embedding for question",
-			constants.InternalError,
-		)
+		return nil, err
 	}
 
-	if embedding.IsEmpty() {
-		return "", errors.New(
-			constants.InternalError,
-			"embedding generation returned empty result",
-			nil,
-		)
+	// Step 4: Assign an experiment variant and apply its overrides
+	experiment, err := s.experimentRepo.GetActiveExperiment(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get active experiment")
+	}
+
+	limit := similarityLimit
+	var opts domain.GenerationOptions
+	var variant *domain.ExperimentVariant
+	if experiment != nil {
+		variant = experiment.Assign(experimentSubject(ctx))
+		limit, opts = applyVariantOverrides(variant.Overrides, limit)
 	}
 
-	// Step 3: Find similar inquiry knowledge entries with similarity scores
-	similarEntries, err := s.knowledgeRepo.FindSimilars(ctx, embedding, similarityLimit)
+	// Step 5: Retrieve similar inquiry knowledge as context
+	retrieved, err := s.retrieveContext(ctx, redacted, limit, domain.KnowledgeFilter{})
 	if err != nil {
-		return "", errors.Wrap(
-			err,
-			"failed to find similar inquiry knowledge",
-			constants.InternalError,
+		return nil, err
+	}
+	record.SetRetrieved(retrieved.results)
+	record.QuestionEmbedding = retrieved.embedding
+	record.Latencies.Embedding = retrieved.embeddingLatency
+	record.Latencies.VectorSearch = retrieved.searchLatency
+
+	// Step 6: Guard against prompt injection in the retrieved context
+	contextWarnings, err := s.guardContext(ctx, retrieved)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, contextWarnings...)
+
+	// Step 7: Refine answer using LLM with context
+	llmStart := time.Now()
+	refinedAnswer, err := s.answerRefineRepo.RefineAnswer(ctx, retrieved.text, opts)
+	record.Latencies.LLM = time.Since(llmStart)
+	metrics.ObserveStage(metrics.StageLLM, record.Latencies.LLM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refine answer")
+	}
+
+	// Step 8: Fill the slots and moderate the filled answer against the answer policy;
+	// regenerations count as LLM time
+	answerSlots := domain.ExtractSlots(msg).Merge(slots, s.companySlots)
+	refinedAnswer.Text = answerSlots.Resolve(refinedAnswer.Text)
+	refinedAnswer, moderationWarnings := s.moderateAnswer(
+		refinedAnswer,
+		retrieved,
+		answerSlots,
+		func(feedback string) (*domain.Answer, error) {
+			llmStart := time.Now()
+			defer func() {
+				elapsed := time.Since(llmStart)
+				record.Latencies.LLM += elapsed
+				metrics.ObserveStage(metrics.StageLLM, elapsed)
+			}()
+			return s.answerRefineRepo.RefineAnswer(ctx, retrieved.text+feedback, opts)
+		},
+	)
+	refinedAnswer.Warnings = append(refinedAnswer.Warnings, warnings...)
+	refinedAnswer.Warnings = append(refinedAnswer.Warnings, moderationWarnings...)
+
+	// Step 9: Personalise the answer with the redacted values
+	refinedAnswer.Text = s.restorePII(vault, refinedAnswer.Text)
+
+	// Step 10: Tag the answer with its experiment variant, recorded once the answer is audited
+	if experiment != nil {
+		refinedAnswer.Metadata.Experiment = experiment.Name
+		refinedAnswer.Metadata.Variant = variant.Name
+	}
+	refinedAnswer.Sources = retrieved.results
+	record.Metadata = refinedAnswer.Metadata
+	record.RawOutput = refinedAnswer.RawOutput
+	record.Answer = refinedAnswer.Text
+	record.Handoff = refinedAnswer.Handoff
+
+	// Step 11: Add the exchange to the user's conversation history
+	s.recordConversationTurn(ctx, msg, refinedAnswer)
+
+	return refinedAnswer, nil
+}
+
+// experimentSubject returns who a request is assigned an experiment variant for: the end user or
+// the API key, so a caller keeps their variant across requests, or the request when anonymous
+func experimentSubject(ctx context.Context) string {
+	if userID, ok := utils.GetUserID(ctx); ok {
+		return "user:" + strconv.Itoa(userID)
+	}
+	if apiKeyID := utils.GetAPIKeyID(ctx); apiKeyID != "" {
+		return "key:" + apiKeyID
+	}
+	return "trid:" + utils.GetTrID(ctx)
+}
+
+// recordConversationTurn stores the exchange for the authenticated end user, if any, with its
+// personal data masked like the audit record. Failing to record it does not fail the answer;
+// the error is added to its warnings.
+func (s *InquiryServiceImpl) recordConversationTurn(
+	ctx context.Context,
+	question string,
+	answer *domain.Answer,
+) {
+	userID, ok := utils.GetUserID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := s.conversationRepo.SaveConversationTurn(ctx, &domain.ConversationTurn{
+		UserID:    userID,
+		TrID:      utils.GetTrID(ctx),
+		Question:  s.maskPII(question),
+		Answer:    s.maskPII(answer.Text),
+		CreatedAt: time.Now(),
+	}); err != nil {
+		answer.Warnings = append(
+			answer.Warnings,
+			errors.Wrap(err, "failed to record conversation turn"),
 		)
 	}
+}
 
-	// Step 4: Build context from similar entries
-	var contextBuilder strings.Builder
-	for _, entry := range similarEntries {
-		contextBuilder.WriteString(fmt.Sprintf("Question: %s\n", entry.Knowledge.Instruction))
-		contextBuilder.WriteString(fmt.Sprintf("Answer: %s\n", entry.Knowledge.Response))
-		contextBuilder.WriteString(fmt.Sprintf("Similarity: %.4f\n\n", entry.SimilarityScore))
+// applyVariantOverrides returns the similarity limit and generation options for a variant
+func applyVariantOverrides(
+	overrides domain.VariantOverrides,
+	defaultLimit int,
+) (int, domain.GenerationOptions) {
+	limit := defaultLimit
+	if overrides.SimilarityLimit > 0 {
+		limit = overrides.SimilarityLimit
 	}
 
-	// Step 5: Refine answer using LLM with context
-	refinedAnswer, err := s.answerRefineRepo.RefineAnswer(ctx, contextBuilder.String())
+	return limit, domain.GenerationOptions{
+		TemplateName:    overrides.TemplateName,
+		TemplateVersion: overrides.TemplateVersion,
+		Model:           overrides.Model,
+	}
+}
+
+// Chat answers the last user message of a conversation using retrieved knowledge matching the
+// filter and the preceding messages as history
+func (s *InquiryServiceImpl) Chat(
+	ctx context.Context,
+	messages domain.ChatMessages,
+	filter domain.KnowledgeFilter,
+) (*domain.Answer, error) {
+	// Step 1: Split the question from the conversation history
+	question, history, err := messages.SplitLastUserMessage()
 	if err != nil {
-		return "", errors.Wrap(
-			err,
-			"failed to refine answer",
+		return nil, err
+	}
+
+	// Step 2: Retrieve similar inquiry knowledge as context, guarded against prompt injection
+	// and with personal data redacted
+	vault := pii.NewVault()
+	redacted, retrieved, warnings, err := s.retrieveGuardedContext(ctx, vault, question, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Generate answer using LLM with context and redacted history
+	generate := s.conversationGenerator(ctx, s.redactMessagesPII(vault, history), redacted, retrieved)
+	answer, err := generate("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to answer conversation")
+	}
+
+	// Step 4: Fill the slots and moderate the filled answer against the answer policy
+	slots := s.conversationSlots(messages)
+	answer.Text = slots.Resolve(answer.Text)
+	answer, moderationWarnings := s.moderateAnswer(answer, retrieved, slots, generate)
+	answer.Text = s.restorePII(vault, answer.Text)
+	answer.Sources = retrieved.results
+	answer.Warnings = append(answer.Warnings, warnings...)
+	answer.Warnings = append(answer.Warnings, moderationWarnings...)
+
+	// Step 5: Add the exchange to the user's conversation history
+	s.recordConversationTurn(ctx, question, answer)
+
+	return answer, nil
+}
+
+// ChatStream answers like Chat but emits the answer chunk by chunk through onChunk.
+// The returned answer holds the full streamed text.
+func (s *InquiryServiceImpl) ChatStream(
+	ctx context.Context,
+	messages domain.ChatMessages,
+	filter domain.KnowledgeFilter,
+	onChunk func(chunk string) error,
+) (*domain.Answer, error) {
+	// Step 1: Split the question from the conversation history
+	question, history, err := messages.SplitLastUserMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Retrieve similar inquiry knowledge as context, guarded against prompt injection
+	// and with personal data redacted
+	vault := pii.NewVault()
+	redacted, retrieved, warnings, err := s.retrieveGuardedContext(ctx, vault, question, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Stream answer using LLM with context and redacted history. Chunks are restored and
+	// their slots resolved as they are emitted. Moderated answers are buffered and only emitted
+	// once they pass the answer policy.
+	moderated := s.guardrailCfg.Moderation.Enabled
+	redactedHistory := s.redactMessagesPII(vault, history)
+	slots := s.conversationSlots(messages)
+	emit, flush := slots.ResolveStream(onChunk)
+	if s.guardrailCfg.PII.Enabled && s.guardrailCfg.PII.RestoreAnswers {
+		resolveFlush := flush
+		var restoreFlush func() error
+		emit, restoreFlush = vault.RestoreStream(emit)
+		flush = func() error {
+			if err := restoreFlush(); err != nil {
+				return err
+			}
+			return resolveFlush()
+		}
+	}
+	var text strings.Builder
+	answer, err := s.answerRefineRepo.StreamConversation(
+		ctx,
+		redactedHistory,
+		redacted,
+		retrieved.text,
+		func(chunk string) error {
+			text.WriteString(chunk)
+			if moderated {
+				return nil
+			}
+			return emit(chunk)
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stream conversation answer")
+	}
+	answer.Text = slots.Resolve(text.String())
+
+	// Step 4: Moderate the buffered answer, its slots filled, against the answer policy before
+	// emitting it
+	var moderationWarnings []error
+	if moderated {
+		generate := s.conversationGenerator(ctx, redactedHistory, redacted, retrieved)
+		answer, moderationWarnings = s.moderateAnswer(answer, retrieved, slots, generate)
+		err = emit(answer.Text)
+	}
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stream conversation answer")
+	}
+
+	// Step 5: Add the exchange to the user's conversation history
+	answer.Text = s.restorePII(vault, answer.Text)
+	answer.Sources = retrieved.results
+	answer.Warnings = append(answer.Warnings, warnings...)
+	answer.Warnings = append(answer.Warnings, moderationWarnings...)
+	s.recordConversationTurn(ctx, question, answer)
+
+	return answer, nil
+}
+
+// conversationGenerator returns a function answering the redacted question of a conversation with
+// the LLM, the given feedback appended to the retrieved context
+func (s *InquiryServiceImpl) conversationGenerator(
+	ctx context.Context,
+	history domain.ChatMessages,
+	question string,
+	retrieved *retrievedContext,
+) func(feedback string) (*domain.Answer, error) {
+	return func(feedback string) (*domain.Answer, error) {
+		llmStart := time.Now()
+		defer func() { metrics.ObserveStage(metrics.StageLLM, time.Since(llmStart)) }()
+		return s.answerRefineRepo.AnswerConversation(ctx, history, question, retrieved.text+feedback)
+	}
+}
+
+// conversationSlots returns the customer slot values the user gave in the messages of a
+// conversation, later messages taking precedence, and the company slot values, which take
+// precedence over them
+func (s *InquiryServiceImpl) conversationSlots(messages domain.ChatMessages) domain.Slots {
+	slots := make(domain.Slots)
+	for _, msg := range messages {
+		if msg.Role == domain.ChatRoleUser {
+			slots = slots.Merge(domain.ExtractSlots(msg.Content))
+		}
+	}
+	return slots.Merge(s.companySlots)
+}
+
+// retrieveGuardedContext redacts the personal data of the question of a conversation into vault
+// and guards it against prompt injection, then retrieves and guards its context. It returns the
+// redacted question to answer and the guard warnings.
+func (s *InquiryServiceImpl) retrieveGuardedContext(
+	ctx context.Context,
+	vault *pii.Vault,
+	question string,
+	filter domain.KnowledgeFilter,
+) (string, *retrievedContext, []error, error) {
+	question, warnings, err := s.guardQuestion(ctx, s.redactPII(vault, question))
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	retrieved, err := s.retrieveContext(ctx, question, similarityLimit, filter)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	contextWarnings, err := s.guardContext(ctx, retrieved)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return question, retrieved, append(warnings, contextWarnings...), nil
+}
+
+// retrievedContext is the inquiry knowledge retrieved for a question and formatted as LLM context
+type retrievedContext struct {
+	text             string
+	results          domain.InquirySimilarityResults
+	embedding        domain.Embedding // Embedding of the question
+	embeddingLatency time.Duration
+	searchLatency    time.Duration
+}
+
+// retrieveContext embeds the question, finds similar inquiry knowledge matching the filter and
+// formats it as LLM context
+func (s *InquiryServiceImpl) retrieveContext(
+	ctx context.Context,
+	question string,
+	limit int,
+	filter domain.KnowledgeFilter,
+) (*retrievedContext, error) {
+	// Step 1: Generate embedding for the user's question
+	stageStart := time.Now()
+	embedding, err := s.embeddingRepo.EmbedString(ctx, question)
+	embeddingLatency := time.Since(stageStart)
+	metrics.ObserveStage(metrics.StageEmbedding, embeddingLatency)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate embedding for question")
+	}
+
+	if embedding.IsEmpty() {
+		return nil, errors.New(
 			constants.InternalError,
+			"embedding generation returned empty result",
+			nil,
 		)
 	}
 
-	return refinedAnswer, nil
+	// Step 2: Find similar inquiry knowledge entries with similarity scores
+	stageStart = time.Now()
+	similarEntries, err := s.knowledgeRepo.FindSimilars(ctx, embedding, limit, filter)
+	searchLatency := time.Since(stageStart)
+	metrics.ObserveStage(metrics.StageVectorSearch, searchLatency)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find similar inquiry knowledge")
+	}
+	if len(similarEntries) > 0 {
+		metrics.ObserveTop1Similarity(similarEntries[0].SimilarityScore)
+	}
+
+	// Step 3: Build context from similar entries
+	return &retrievedContext{
+		text:             similarEntries.ContextText(),
+		results:          similarEntries,
+		embedding:        embedding,
+		embeddingLatency: embeddingLatency,
+		searchLatency:    searchLatency,
+	}, nil
 }
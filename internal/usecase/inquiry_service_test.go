@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/mock"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
+)
+
+// testBatches returns n batches of two knowledge entries, whose instructions name their batch
+func testBatches(n int) []domain.InquiryKnowledges {
+	batches := make([]domain.InquiryKnowledges, n)
+	for i := range batches {
+		batches[i] = domain.InquiryKnowledges{
+			{Instruction: fmt.Sprintf("batch %d row 0", i)},
+			{Instruction: fmt.Sprintf("batch %d row 1", i)},
+		}
+	}
+	return batches
+}
+
+func TestEmbedAndSaveBatches(t *testing.T) {
+	errEmbed := errors.New(constants.UpstreamError, "embedding provider failed", nil)
+
+	tests := []struct {
+		name      string
+		batches   int
+		cfg       IngestionConfig
+		delays    map[int]time.Duration // How long embedding a batch takes (default none)
+		fail      map[int]bool          // Batches whose embedding fails after its delay
+		cancelled bool                  // Cancel the caller's context up front
+		wantSaved []int
+		wantErr   error
+	}{
+		{
+			name:    "saves batches finishing out of order in order",
+			batches: 5,
+			cfg:     IngestionConfig{Concurrency: 3},
+			delays: map[int]time.Duration{
+				0: 40 * time.Millisecond,
+				1: 10 * time.Millisecond,
+				2: 25 * time.Millisecond,
+				4: 5 * time.Millisecond,
+			},
+			wantSaved: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:      "single worker",
+			batches:   3,
+			cfg:       IngestionConfig{},
+			wantSaved: []int{0, 1, 2},
+		},
+		{
+			name:      "within the token budget",
+			batches:   4,
+			cfg:       IngestionConfig{Concurrency: 2, TokensPerMinute: 1000},
+			wantSaved: []int{0, 1, 2, 3},
+		},
+		{
+			name:    "first failure cancels the batches still running",
+			batches: 8,
+			cfg:     IngestionConfig{Concurrency: 3},
+			// Batch 3 would outlive the test unless it is cancelled
+			delays:    map[int]time.Duration{2: 10 * time.Millisecond, 3: time.Hour},
+			fail:      map[int]bool{2: true},
+			wantSaved: []int{0, 1},
+			wantErr:   errEmbed,
+		},
+		{
+			name:    "failure of a slow first batch saves nothing",
+			batches: 6,
+			cfg:     IngestionConfig{Concurrency: 2},
+			delays:  map[int]time.Duration{0: 20 * time.Millisecond},
+			fail:    map[int]bool{0: true},
+			wantErr: errEmbed,
+		},
+		{
+			name:      "cancelled caller",
+			batches:   3,
+			cfg:       IngestionConfig{Concurrency: 2},
+			cancelled: true,
+			wantErr:   context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			embeddingRepo := mock.NewMockEmbeddingRepository(ctrl)
+			knowledgeRepo := mock.NewMockInquiryKnowledgeRepository(ctrl)
+
+			var mu sync.Mutex
+			running := 0
+			var saved []int
+			batchOf := func(instruction string) int {
+				var batch, row int
+				if _, err := fmt.Sscanf(instruction, "batch %d row %d", &batch, &row); err != nil {
+					t.Fatalf("unexpected instruction %q", instruction)
+				}
+				return batch
+			}
+
+			embeddingRepo.EXPECT().EmbedStrings(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, texts []string) (domain.Embeddings, error) {
+					mu.Lock()
+					running++
+					mu.Unlock()
+					defer func() {
+						mu.Lock()
+						running--
+						mu.Unlock()
+					}()
+
+					batch := batchOf(texts[0])
+					select {
+					case <-time.After(tt.delays[batch]):
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+					if tt.fail[batch] {
+						return nil, errEmbed
+					}
+					embeddings := make(domain.Embeddings, len(texts))
+					for i := range embeddings {
+						embeddings[i] = domain.NewEmbedding([]float64{float64(batch)})
+					}
+					return embeddings, nil
+				},
+			).AnyTimes()
+			knowledgeRepo.EXPECT().BatchSaveInquiryKnowledge(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, items domain.InquiryKnowledges) error {
+					mu.Lock()
+					defer mu.Unlock()
+					saved = append(saved, batchOf(items[0].Instruction))
+					return nil
+				},
+			).AnyTimes()
+
+			svc := NewInquiryServiceImpl(InquiryServiceOptions{
+				EmbeddingRepo: embeddingRepo,
+				KnowledgeRepo: knowledgeRepo,
+				Ingestion:     tt.cfg,
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			batches := testBatches(tt.batches)
+			tokens, err := svc.embedAndSaveBatches(ctx, batches)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if running != 0 {
+				t.Errorf("%d batches still being embedded after return", running)
+			}
+			if !slices.Equal(saved, tt.wantSaved) {
+				t.Errorf("saved batches = %v, want %v", saved, tt.wantSaved)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("embedAndSaveBatches() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("embedAndSaveBatches() error = %v", err)
+			}
+
+			wantTokens := 0
+			for _, batch := range batches {
+				wantTokens += utils.EstimateTokens(batch.Instructions()...)
+				if batch[0].InstructionEmbedding.IsEmpty() {
+					t.Errorf("batch %q saved without embeddings", batch[0].Instruction)
+				}
+			}
+			if tokens != wantTokens {
+				t.Errorf("estimated tokens = %d, want %d", tokens, wantTokens)
+			}
+		})
+	}
+}
+
+func TestAskRedactsAndRestoresPII(t *testing.T) {
+	const question = "My email is jane@example.com and my phone +1 555 123 4567, where is my invoice?"
+
+	tests := []struct {
+		name         string
+		cfg          PIIConfig
+		wantEmbedded string
+		wantAnswer   string
+		wantAudited  string
+		// wantAuditedAnswer is the answer kept in the audit trail, masked once restored
+		wantAuditedAnswer string
+	}{
+		{
+			name:              "redacted and restored",
+			cfg:               PIIConfig{Enabled: true, RestoreAnswers: true},
+			wantEmbedded:      "My email is [EMAIL_1] and my phone [PHONE_1], where is my invoice?",
+			wantAnswer:        "We sent the invoice to jane@example.com and will call +1 555 123 4567. [EMAIL_2] stays.",
+			wantAudited:       "My email is [EMAIL] and my phone [PHONE], where is my invoice?",
+			wantAuditedAnswer: "We sent the invoice to [EMAIL] and will call [PHONE]. [EMAIL_2] stays.",
+		},
+		{
+			name:              "redacted without restoring",
+			cfg:               PIIConfig{Enabled: true},
+			wantEmbedded:      "My email is [EMAIL_1] and my phone [PHONE_1], where is my invoice?",
+			wantAnswer:        "We sent the invoice to [EMAIL_1] and will call [PHONE_1]. [EMAIL_2] stays.",
+			wantAudited:       "My email is [EMAIL] and my phone [PHONE], where is my invoice?",
+			wantAuditedAnswer: "We sent the invoice to [EMAIL_1] and will call [PHONE_1]. [EMAIL_2] stays.",
+		},
+		{
+			name:              "disabled",
+			wantEmbedded:      question,
+			wantAnswer:        "We sent the invoice to [EMAIL_1] and will call [PHONE_1]. [EMAIL_2] stays.",
+			wantAudited:       question,
+			wantAuditedAnswer: "We sent the invoice to [EMAIL_1] and will call [PHONE_1]. [EMAIL_2] stays.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			embeddingRepo := mock.NewMockEmbeddingRepository(ctrl)
+			knowledgeRepo := mock.NewMockInquiryKnowledgeRepository(ctrl)
+			answerRefineRepo := mock.NewMockAnswerRefineRepository(ctrl)
+			experimentRepo := mock.NewMockExperimentRepository(ctrl)
+			auditRepo := mock.NewMockAuditRepository(ctrl)
+
+			var embedded string
+			var audited *domain.AuditRecord
+			experimentRepo.EXPECT().GetActiveExperiment(gomock.Any()).Return(nil, nil)
+			embeddingRepo.EXPECT().EmbedString(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, text string) (domain.Embedding, error) {
+					embedded = text
+					return domain.NewEmbedding([]float64{1}), nil
+				},
+			)
+			knowledgeRepo.EXPECT().FindSimilars(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				domain.InquirySimilarityResults{{
+					Knowledge:       &domain.InquiryKnowledge{ID: 1, Instruction: "Where is my invoice?", Response: "We sent it."},
+					SimilarityScore: 0.9,
+				}},
+				nil,
+			)
+			// The model repeats the placeholders it was given, and one it was not
+			answerRefineRepo.EXPECT().RefineAnswer(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&domain.Answer{Text: "We sent the invoice to [EMAIL_1] and will call [PHONE_1]. [EMAIL_2] stays."},
+				nil,
+			)
+			auditRepo.EXPECT().SaveAuditRecord(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, record *domain.AuditRecord) error {
+					audited = record
+					return nil
+				},
+			)
+
+			svc := NewInquiryServiceImpl(InquiryServiceOptions{
+				EmbeddingRepo:    embeddingRepo,
+				KnowledgeRepo:    knowledgeRepo,
+				AnswerRefineRepo: answerRefineRepo,
+				ExperimentRepo:   experimentRepo,
+				AuditRepo:        auditRepo,
+				Guardrails:       GuardrailConfig{PII: tt.cfg},
+			})
+			answer, err := svc.Ask(context.Background(), question, nil)
+			if err != nil {
+				t.Fatalf("Ask() error = %v", err)
+			}
+
+			if embedded != tt.wantEmbedded {
+				t.Errorf("embedded question = %q, want %q", embedded, tt.wantEmbedded)
+			}
+			if answer.Text != tt.wantAnswer {
+				t.Errorf("answer = %q, want %q", answer.Text, tt.wantAnswer)
+			}
+			if audited.Question != tt.wantAudited {
+				t.Errorf("audited question = %q, want %q", audited.Question, tt.wantAudited)
+			}
+			if audited.Answer != tt.wantAuditedAnswer {
+				t.Errorf("audited answer = %q, want %q", audited.Answer, tt.wantAuditedAnswer)
+			}
+		})
+	}
+}
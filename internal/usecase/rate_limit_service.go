@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+// rateLimitIdleTTL is how long an unused bucket is kept before it is pruned (a pruned
+// bucket starts full again on the client's next request)
+const rateLimitIdleTTL = 10 * time.Minute
+
+type RateLimitServiceImpl struct {
+	rateLimitRepo repository.RateLimitRepository
+
+	// In-flight requests per policy and client (always per instance)
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+func NewRateLimitServiceImpl(rateLimitRepo repository.RateLimitRepository) *RateLimitServiceImpl {
+	return &RateLimitServiceImpl{
+		rateLimitRepo: rateLimitRepo,
+		inFlight:      make(map[string]int),
+	}
+}
+
+// Allow takes a token from the client's bucket under the policy
+func (s *RateLimitServiceImpl) Allow(
+	ctx context.Context,
+	policy *domain.RateLimitPolicy,
+	clientKey string,
+) (*domain.RateLimitDecision, error) {
+	if policy.RequestsPerMinute <= 0 {
+		return &domain.RateLimitDecision{Allowed: true}, nil
+	}
+
+	decision, err := s.rateLimitRepo.TakeRateLimitToken(ctx, policy, clientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check rate limit")
+	}
+	return decision, nil
+}
+
+// Acquire reserves an in-flight slot for the client under the policy.
+// The returned release func must be called once the request is done.
+func (s *RateLimitServiceImpl) Acquire(
+	policy *domain.RateLimitPolicy,
+	clientKey string,
+) (func(), bool) {
+	if policy.Concurrency <= 0 {
+		return func() {}, true
+	}
+
+	key := policy.BucketKey(clientKey)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight[key] >= policy.Concurrency {
+		return nil, false
+	}
+	s.inFlight[key]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.inFlight[key] <= 1 {
+				delete(s.inFlight, key)
+			} else {
+				s.inFlight[key]--
+			}
+		})
+	}, true
+}
+
+// PruneIdleBuckets deletes buckets that have not been used recently
+func (s *RateLimitServiceImpl) PruneIdleBuckets(ctx context.Context) error {
+	if err := s.rateLimitRepo.PruneRateLimitBuckets(ctx, rateLimitIdleTTL); err != nil {
+		return errors.Wrap(err, "failed to prune rate limit buckets")
+	}
+	return nil
+}
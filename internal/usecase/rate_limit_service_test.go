@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/mock"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+func TestRateLimitServiceAllow(t *testing.T) {
+	refused := &domain.RateLimitDecision{Limit: 10}
+	tests := []struct {
+		name     string
+		policy   *domain.RateLimitPolicy
+		setup    func(repo *mock.MockRateLimitRepository)
+		want     *domain.RateLimitDecision
+		wantCode constants.ErrorCode
+	}{
+		{
+			name:   "no rate limit skips the store",
+			policy: &domain.RateLimitPolicy{Name: "me", Concurrency: 2},
+			setup:  func(*mock.MockRateLimitRepository) {},
+			want:   &domain.RateLimitDecision{Allowed: true},
+		},
+		{
+			name:   "decision from the store",
+			policy: &domain.RateLimitPolicy{Name: "ask", RequestsPerMinute: 60, Burst: 10},
+			setup: func(repo *mock.MockRateLimitRepository) {
+				repo.EXPECT().TakeRateLimitToken(gomock.Any(), gomock.Any(), "key:abc").Return(refused, nil)
+			},
+			want: refused,
+		},
+		{
+			name:   "store failure",
+			policy: &domain.RateLimitPolicy{Name: "ask", RequestsPerMinute: 60},
+			setup: func(repo *mock.MockRateLimitRepository) {
+				repo.EXPECT().TakeRateLimitToken(gomock.Any(), gomock.Any(), "key:abc").
+					Return(nil, errors.New(constants.InternalError, "connection refused", nil))
+			},
+			wantCode: constants.InternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock.NewMockRateLimitRepository(gomock.NewController(t))
+			tt.setup(repo)
+			svc := NewRateLimitServiceImpl(repo)
+
+			got, err := svc.Allow(context.Background(), tt.policy, "key:abc")
+			if tt.wantCode != "" {
+				if code := errors.GetCode(err); code != tt.wantCode {
+					t.Fatalf("Allow() error code = %q, want %q (error %v)", code, tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Allow() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Allow() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitServiceAcquire(t *testing.T) {
+	ask := &domain.RateLimitPolicy{Name: "ask", Concurrency: 2}
+	me := &domain.RateLimitPolicy{Name: "me", Concurrency: 1}
+	unlimited := &domain.RateLimitPolicy{Name: "admin"}
+
+	type step struct {
+		acquire *domain.RateLimitPolicy // Policy to acquire a slot under; nil releases a slot
+		client  string
+		release int // Index of the acquired slot to release
+		wantOK  bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "up to the concurrency",
+			steps: []step{
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: false},
+			},
+		},
+		{
+			name: "release frees a slot",
+			steps: []step{
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: true},
+				{release: 0},
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: false},
+			},
+		},
+		{
+			name: "releasing twice frees one slot",
+			steps: []step{
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: true},
+				{release: 0},
+				{release: 0},
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: false},
+			},
+		},
+		{
+			name: "clients are limited separately",
+			steps: []step{
+				{acquire: me, client: "a", wantOK: true},
+				{acquire: me, client: "b", wantOK: true},
+				{acquire: me, client: "a", wantOK: false},
+			},
+		},
+		{
+			name: "policies are limited separately",
+			steps: []step{
+				{acquire: me, client: "a", wantOK: true},
+				{acquire: ask, client: "a", wantOK: true},
+				{acquire: me, client: "a", wantOK: false},
+			},
+		},
+		{
+			name: "no concurrency limit",
+			steps: []step{
+				{acquire: unlimited, client: "a", wantOK: true},
+				{acquire: unlimited, client: "a", wantOK: true},
+				{acquire: unlimited, client: "a", wantOK: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewRateLimitServiceImpl(nil)
+			var releases []func()
+			for i, s := range tt.steps {
+				if s.acquire == nil {
+					releases[s.release]()
+					continue
+				}
+				release, ok := svc.Acquire(s.acquire, s.client)
+				if ok != s.wantOK {
+					t.Fatalf("step %d: Acquire(%s, %s) ok = %v, want %v", i, s.acquire.Name, s.client, ok, s.wantOK)
+				}
+				if ok {
+					releases = append(releases, release)
+				}
+			}
+
+			// Releasing every slot leaves no in-flight requests behind
+			for _, release := range releases {
+				release()
+			}
+			if len(svc.inFlight) != 0 {
+				t.Errorf("in-flight requests after releasing every slot = %v, want none", svc.inFlight)
+			}
+		})
+	}
+}
@@ -2,16 +2,116 @@ package usecase
 
 import (
 	"context"
+	"time"
+
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
 )
 
+// APIKeyService defines the interface for API key management and authentication
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, name string, scopes []string) (*domain.APIKey, string, error)
+	RotateAPIKey(ctx context.Context, keyID string) (*domain.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, keyID string) error
+	ListAPIKeys(ctx context.Context) (domain.APIKeys, error)
+	AuthenticateAPIKey(ctx context.Context, token string) (*domain.APIKey, error)
+}
+
+// UserService defines the interface for end-user identity and conversation history
+type UserService interface {
+	AuthenticateUser(ctx context.Context, token string) (*domain.User, error)
+	GetUser(ctx context.Context, id int) (*domain.User, error)
+	ListConversationTurns(
+		ctx context.Context,
+		userID, offset, limit int,
+	) (domain.ConversationTurns, int, error)
+}
+
+// HealthService defines the interface for dependency readiness checks
+type HealthService interface {
+	CheckReadiness(ctx context.Context) *domain.HealthReport
+}
+
+// RateLimitService defines the interface for per-client rate and concurrency limiting
+type RateLimitService interface {
+	Allow(
+		ctx context.Context,
+		policy *domain.RateLimitPolicy,
+		clientKey string,
+	) (*domain.RateLimitDecision, error)
+	Acquire(policy *domain.RateLimitPolicy, clientKey string) (release func(), ok bool)
+	PruneIdleBuckets(ctx context.Context) error
+}
+
 // BasicChatService defines the interface for basic chat business logic
 type BasicChatService interface {
-	AskBasicChat(ctx context.Context, msg string) (string, error)
-	AskBasicPromptTemplateChat(ctx context.Context, msg string) (string, error)
+	AskBasicChat(ctx context.Context, msg string) (*domain.Answer, error)
+	AskBasicPromptTemplateChat(
+		ctx context.Context,
+		templateName string,
+		variables map[string]any,
+	) (*domain.Answer, error)
 }
 
 // InquiryService defines the interface for inquiry business logic
 type InquiryService interface {
-	Ask(ctx context.Context, msg string) (string, error)
-	EmbedInquiryOrigins(ctx context.Context) error
+	Ask(ctx context.Context, msg string, slots domain.Slots) (*domain.Answer, error)
+	EmbedInquiryOrigins(ctx context.Context) (*domain.IngestionReport, error)
+	Chat(
+		ctx context.Context,
+		messages domain.ChatMessages,
+		filter domain.KnowledgeFilter,
+	) (*domain.Answer, error)
+	ChatStream(
+		ctx context.Context,
+		messages domain.ChatMessages,
+		filter domain.KnowledgeFilter,
+		onChunk func(chunk string) error,
+	) (*domain.Answer, error)
+}
+
+// ExperimentService defines the interface for prompt experiment administration
+type ExperimentService interface {
+	ListExperiments(ctx context.Context) (domain.Experiments, error)
+	CompareVariants(ctx context.Context, name string) (domain.VariantStats, error)
+}
+
+// AuditService defines the interface for searching and pruning the audit trail of answers
+type AuditService interface {
+	SearchAuditRecords(
+		ctx context.Context,
+		filter *domain.AuditFilter,
+	) (domain.AuditRecords, int, error)
+	PruneExpiredRecords(ctx context.Context) (int, error)
+}
+
+// FeedbackService defines the interface for answer feedback and knowledge quality reports
+type FeedbackService interface {
+	SubmitFeedback(
+		ctx context.Context,
+		answerID int,
+		rating, reason, correction string,
+	) (*domain.AnswerFeedback, error)
+	RankKnowledgeByNegativeFeedback(
+		ctx context.Context,
+		since time.Time,
+		limit int,
+	) (domain.KnowledgeFeedbackStats, error)
+	FindKnowledgeGaps(ctx context.Context, since time.Time, limit int) (domain.KnowledgeGaps, error)
+}
+
+// EvaluationService defines the interface for offline quality evaluation
+type EvaluationService interface {
+	EvaluateRetrieval(
+		ctx context.Context,
+		items domain.InquiryKnowledges,
+		cfg domain.RetrievalEvalConfig,
+	) (*domain.RetrievalReport, error)
+}
+
+// AnswerEvaluationService defines the interface for judging answer quality on a golden set
+type AnswerEvaluationService interface {
+	EvaluateAnswers(
+		ctx context.Context,
+		golden domain.GoldenQuestions,
+	) (*domain.AnswerEvalReport, error)
 }
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/wonjinsin/simple-chatbot/internal/constants"
+	"github.com/wonjinsin/simple-chatbot/internal/domain"
+	"github.com/wonjinsin/simple-chatbot/internal/repository"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+)
+
+type UserServiceImpl struct {
+	identityTokenRepo repository.IdentityTokenRepository
+	userRepo          repository.UserRepository
+	conversationRepo  repository.ConversationRepository
+}
+
+func NewUserServiceImpl(
+	identityTokenRepo repository.IdentityTokenRepository,
+	userRepo repository.UserRepository,
+	conversationRepo repository.ConversationRepository,
+) *UserServiceImpl {
+	return &UserServiceImpl{
+		identityTokenRepo: identityTokenRepo,
+		userRepo:          userRepo,
+		conversationRepo:  conversationRepo,
+	}
+}
+
+// AuthenticateUser verifies an end-user token and returns its user, creating the user the
+// first time the identity is seen and refreshing the profile when the claims change
+func (s *UserServiceImpl) AuthenticateUser(ctx context.Context, token string) (*domain.User, error) {
+	// Step 1: Verify the token
+	claims, err := s.identityTokenRepo.VerifyIdentityToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Find the user of the identity
+	user, err := s.userRepo.GetUserByIdentity(ctx, claims.Issuer, claims.Subject)
+	if errors.HasCode(err, constants.NotFound) {
+		// First sight of the identity: create the user
+		return s.createUser(ctx, claims)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	// Step 3: Keep the profile in sync with the identity provider
+	if user.ProfileChanged(claims) {
+		updated, err := domain.NewUserFromClaims(claims)
+		if err != nil {
+			return nil, err
+		}
+		updated.ID = user.ID
+		if user, err = s.userRepo.UpdateUserProfile(ctx, updated); err != nil {
+			return nil, errors.Wrap(err, "failed to update user profile")
+		}
+	}
+
+	return user, nil
+}
+
+// GetUser returns the user with the given ID
+func (s *UserServiceImpl) GetUser(ctx context.Context, id int) (*domain.User, error) {
+	user, err := s.userRepo.GetUser(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	return user, nil
+}
+
+// ListConversationTurns returns a page of the user's conversation history, newest first, and
+// the total number of turns
+func (s *UserServiceImpl) ListConversationTurns(
+	ctx context.Context,
+	userID, offset, limit int,
+) (domain.ConversationTurns, int, error) {
+	turns, total, err := s.conversationRepo.ListConversationTurns(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list conversation turns")
+	}
+	return turns, total, nil
+}
+
+// createUser creates the user of an identity, tolerating a concurrent first request that
+// created it already
+func (s *UserServiceImpl) createUser(
+	ctx context.Context,
+	claims *domain.IdentityClaims,
+) (*domain.User, error) {
+	user, err := domain.NewUserFromClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.userRepo.CreateUser(ctx, user)
+	if errors.HasCode(err, constants.ConstraintError) {
+		created, err = s.userRepo.GetUserByIdentity(ctx, claims.Issuer, claims.Subject)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+	return created, nil
+}
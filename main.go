@@ -1,27 +0,0 @@
-package main
-
-import (
-	"context"
-	"fmt"
-	"log"
-
-	"github.com/tmc/langchaingo/llms"
-	"github.com/tmc/langchaingo/llms/ollama"
-)
-
-func main() {
-	llm, err := ollama.New(ollama.WithModel("deepseek-r1:8b"))
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	query := "very briefly, tell me the difference between a comet and a meteor"
-
-	ctx := context.Background()
-	completion, err := llms.GenerateFromSinglePrompt(ctx, llm, query)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	fmt.Println("Response:\n", completion)
-}
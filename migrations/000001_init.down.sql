@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS "conversation_turns";
+DROP TABLE IF EXISTS "users";
+DROP TABLE IF EXISTS "rate_limit_buckets";
+DROP TABLE IF EXISTS "inquiry_knowledges";
+DROP TABLE IF EXISTS "experiment_exposures";
+DROP TABLE IF EXISTS "audit_records";
+DROP TABLE IF EXISTS "answer_feedbacks";
+DROP TABLE IF EXISTS "api_keys";
+
+DROP EXTENSION IF EXISTS vector;
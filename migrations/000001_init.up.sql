@@ -0,0 +1,123 @@
+-- Initial schema generated from the ent schema (internal/repository/postgres/dao/schema).
+
+CREATE EXTENSION IF NOT EXISTS vector;
+
+CREATE TABLE "api_keys" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "key_id" character varying NOT NULL,
+    "name" character varying NOT NULL,
+    "secret_hash" character varying NOT NULL,
+    "scopes" jsonb NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "last_used_at" timestamptz NULL,
+    "revoked_at" timestamptz NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "api_keys_key_id_key" ON "api_keys" ("key_id");
+CREATE INDEX "apikey_name" ON "api_keys" ("name");
+
+CREATE TABLE "answer_feedbacks" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "answer_id" bigint NOT NULL,
+    "rating" character varying NOT NULL,
+    "reason" text NULL,
+    "correction" text NULL,
+    "knowledge_ids" jsonb NULL,
+    "user_id" bigint NULL,
+    "api_key_id" character varying NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "answerfeedback_answer_id" ON "answer_feedbacks" ("answer_id");
+CREATE INDEX "answerfeedback_rating_created_at" ON "answer_feedbacks" ("rating", "created_at");
+
+CREATE TABLE "audit_records" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "trid" character varying NULL,
+    "user_id" bigint NULL,
+    "api_key_id" character varying NULL,
+    "question" text NOT NULL,
+    "intent" character varying NULL,
+    "knowledge_ids" jsonb NULL,
+    "similarity_scores" jsonb NULL,
+    "template_name" character varying NULL,
+    "template_version" bigint NULL,
+    "model" character varying NULL,
+    "experiment" character varying NULL,
+    "variant" character varying NULL,
+    "raw_output" text NULL,
+    "answer" text NULL,
+    "embedding_latency_ms" bigint NOT NULL,
+    "vector_search_latency_ms" bigint NOT NULL,
+    "llm_latency_ms" bigint NOT NULL,
+    "total_latency_ms" bigint NOT NULL,
+    "error_code" character varying NULL,
+    "error" text NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "auditrecord_created_at" ON "audit_records" ("created_at");
+CREATE INDEX "auditrecord_intent_created_at" ON "audit_records" ("intent", "created_at");
+CREATE INDEX "auditrecord_error_code_created_at" ON "audit_records" ("error_code", "created_at");
+CREATE INDEX "auditrecord_trid" ON "audit_records" ("trid");
+
+CREATE TABLE "experiment_exposures" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "experiment" character varying NOT NULL,
+    "variant" character varying NOT NULL,
+    "trid" character varying NULL,
+    "latency_ms" bigint NOT NULL,
+    "feedback_score" double precision NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "experimentexposure_experiment_variant" ON "experiment_exposures" ("experiment", "variant");
+CREATE INDEX "experimentexposure_trid" ON "experiment_exposures" ("trid");
+
+CREATE TABLE "inquiry_knowledges" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "instruction" text NOT NULL,
+    "instruction_embedding" vector(1536) NULL,
+    "response" text NOT NULL,
+    "category" character varying NULL,
+    "intent" character varying NULL,
+    "flags" character varying NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "inquiryknowledge_instruction_embedding" ON "inquiry_knowledges" USING hnsw ("instruction_embedding" vector_cosine_ops);
+
+CREATE TABLE "rate_limit_buckets" (
+    "id" character varying NOT NULL,
+    "tokens" double precision NOT NULL,
+    "allowed" boolean NOT NULL DEFAULT true,
+    "updated_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "ratelimitbucket_updated_at" ON "rate_limit_buckets" ("updated_at");
+
+CREATE TABLE "users" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "issuer" character varying NOT NULL,
+    "subject" character varying NOT NULL,
+    "name" character varying NOT NULL,
+    "email" character varying NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "user_issuer_subject" ON "users" ("issuer", "subject");
+CREATE INDEX "user_email" ON "users" ("email");
+CREATE INDEX "user_created_at" ON "users" ("created_at");
+
+CREATE TABLE "conversation_turns" (
+    "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "trid" character varying NULL,
+    "question" text NOT NULL,
+    "answer" text NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "conversation_turns_users_conversation_turns" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+CREATE INDEX "conversationturn_user_id_created_at" ON "conversation_turns" ("user_id", "created_at");
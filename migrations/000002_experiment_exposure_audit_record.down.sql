@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "experimentexposure_audit_record_id";
+ALTER TABLE "experiment_exposures" DROP COLUMN IF EXISTS "audit_record_id";
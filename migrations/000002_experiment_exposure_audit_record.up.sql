@@ -0,0 +1,4 @@
+-- Link experiment exposures to the audited answer, so feedback on the answer scores the variant.
+
+ALTER TABLE "experiment_exposures" ADD COLUMN "audit_record_id" bigint NULL;
+CREATE INDEX "experimentexposure_audit_record_id" ON "experiment_exposures" ("audit_record_id");
@@ -0,0 +1,4 @@
+-- Keep the embedding each question was retrieved with, so the knowledge gap report does not
+-- embed the questions again.
+
+ALTER TABLE "audit_records" ADD COLUMN "question_embedding" vector(1536) NULL;
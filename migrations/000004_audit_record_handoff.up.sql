@@ -0,0 +1,3 @@
+-- Record whether answer moderation handed the customer off to a human agent.
+
+ALTER TABLE "audit_records" ADD COLUMN "handoff" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,13 @@
+// Package migrations embeds the golang-migrate SQL migrations of the database schema, so the
+// migrate CLI and the server apply the same files whatever their working directory.
+package migrations
+
+import "embed"
+
+// Dir is the directory of the migration files, relative to the repository root
+const Dir = "migrations"
+
+// FS holds the up and down migration files ({version}_{name}.up.sql / .down.sql)
+//
+//go:embed *.sql
+var FS embed.FS
@@ -12,11 +12,364 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/wonjinsin/simple-chatbot/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockAnswerRefineRepository is a mock of AnswerRefineRepository interface.
+type MockAnswerRefineRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnswerRefineRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAnswerRefineRepositoryMockRecorder is the mock recorder for MockAnswerRefineRepository.
+type MockAnswerRefineRepositoryMockRecorder struct {
+	mock *MockAnswerRefineRepository
+}
+
+// NewMockAnswerRefineRepository creates a new mock instance.
+func NewMockAnswerRefineRepository(ctrl *gomock.Controller) *MockAnswerRefineRepository {
+	mock := &MockAnswerRefineRepository{ctrl: ctrl}
+	mock.recorder = &MockAnswerRefineRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnswerRefineRepository) EXPECT() *MockAnswerRefineRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AnswerConversation mocks base method.
+func (m *MockAnswerRefineRepository) AnswerConversation(ctx context.Context, history domain.ChatMessages, question, contextStr string) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnswerConversation", ctx, history, question, contextStr)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerConversation indicates an expected call of AnswerConversation.
+func (mr *MockAnswerRefineRepositoryMockRecorder) AnswerConversation(ctx, history, question, contextStr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerConversation", reflect.TypeOf((*MockAnswerRefineRepository)(nil).AnswerConversation), ctx, history, question, contextStr)
+}
+
+// RefineAnswer mocks base method.
+func (m *MockAnswerRefineRepository) RefineAnswer(ctx context.Context, contextStr string, opts domain.GenerationOptions) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefineAnswer", ctx, contextStr, opts)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefineAnswer indicates an expected call of RefineAnswer.
+func (mr *MockAnswerRefineRepositoryMockRecorder) RefineAnswer(ctx, contextStr, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefineAnswer", reflect.TypeOf((*MockAnswerRefineRepository)(nil).RefineAnswer), ctx, contextStr, opts)
+}
+
+// StreamConversation mocks base method.
+func (m *MockAnswerRefineRepository) StreamConversation(ctx context.Context, history domain.ChatMessages, question, contextStr string, onChunk func(string) error) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamConversation", ctx, history, question, contextStr, onChunk)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamConversation indicates an expected call of StreamConversation.
+func (mr *MockAnswerRefineRepositoryMockRecorder) StreamConversation(ctx, history, question, contextStr, onChunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamConversation", reflect.TypeOf((*MockAnswerRefineRepository)(nil).StreamConversation), ctx, history, question, contextStr, onChunk)
+}
+
+// MockAnswerJudgeRepository is a mock of AnswerJudgeRepository interface.
+type MockAnswerJudgeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnswerJudgeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAnswerJudgeRepositoryMockRecorder is the mock recorder for MockAnswerJudgeRepository.
+type MockAnswerJudgeRepositoryMockRecorder struct {
+	mock *MockAnswerJudgeRepository
+}
+
+// NewMockAnswerJudgeRepository creates a new mock instance.
+func NewMockAnswerJudgeRepository(ctrl *gomock.Controller) *MockAnswerJudgeRepository {
+	mock := &MockAnswerJudgeRepository{ctrl: ctrl}
+	mock.recorder = &MockAnswerJudgeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnswerJudgeRepository) EXPECT() *MockAnswerJudgeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// JudgeAnswer mocks base method.
+func (m *MockAnswerJudgeRepository) JudgeAnswer(ctx context.Context, question, contextStr, reference, answer string) (*domain.AnswerJudgement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JudgeAnswer", ctx, question, contextStr, reference, answer)
+	ret0, _ := ret[0].(*domain.AnswerJudgement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JudgeAnswer indicates an expected call of JudgeAnswer.
+func (mr *MockAnswerJudgeRepositoryMockRecorder) JudgeAnswer(ctx, question, contextStr, reference, answer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JudgeAnswer", reflect.TypeOf((*MockAnswerJudgeRepository)(nil).JudgeAnswer), ctx, question, contextStr, reference, answer)
+}
+
+// MockInjectionClassifierRepository is a mock of InjectionClassifierRepository interface.
+type MockInjectionClassifierRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInjectionClassifierRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInjectionClassifierRepositoryMockRecorder is the mock recorder for MockInjectionClassifierRepository.
+type MockInjectionClassifierRepositoryMockRecorder struct {
+	mock *MockInjectionClassifierRepository
+}
+
+// NewMockInjectionClassifierRepository creates a new mock instance.
+func NewMockInjectionClassifierRepository(ctrl *gomock.Controller) *MockInjectionClassifierRepository {
+	mock := &MockInjectionClassifierRepository{ctrl: ctrl}
+	mock.recorder = &MockInjectionClassifierRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInjectionClassifierRepository) EXPECT() *MockInjectionClassifierRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClassifyInjection mocks base method.
+func (m *MockInjectionClassifierRepository) ClassifyInjection(ctx context.Context, text string) (*domain.InjectionClassification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClassifyInjection", ctx, text)
+	ret0, _ := ret[0].(*domain.InjectionClassification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClassifyInjection indicates an expected call of ClassifyInjection.
+func (mr *MockInjectionClassifierRepositoryMockRecorder) ClassifyInjection(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClassifyInjection", reflect.TypeOf((*MockInjectionClassifierRepository)(nil).ClassifyInjection), ctx, text)
+}
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateAPIKey), ctx, key)
+}
+
+// GetAPIKeyByKeyID mocks base method.
+func (m *MockAPIKeyRepository) GetAPIKeyByKeyID(ctx context.Context, keyID string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyByKeyID", ctx, keyID)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyByKeyID indicates an expected call of GetAPIKeyByKeyID.
+func (mr *MockAPIKeyRepositoryMockRecorder) GetAPIKeyByKeyID(ctx, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyByKeyID", reflect.TypeOf((*MockAPIKeyRepository)(nil).GetAPIKeyByKeyID), ctx, keyID)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAPIKeyRepository) ListAPIKeys(ctx context.Context) (domain.APIKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx)
+	ret0, _ := ret[0].(domain.APIKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAPIKeyRepositoryMockRecorder) ListAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAPIKeyRepository)(nil).ListAPIKeys), ctx)
+}
+
+// ReplaceAPIKey mocks base method.
+func (m *MockAPIKeyRepository) ReplaceAPIKey(ctx context.Context, oldKeyID string, newKey *domain.APIKey) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAPIKey", ctx, oldKeyID, newKey)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceAPIKey indicates an expected call of ReplaceAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) ReplaceAPIKey(ctx, oldKeyID, newKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).ReplaceAPIKey), ctx, oldKeyID, newKey)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, keyID string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, keyID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) RevokeAPIKey(ctx, keyID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).RevokeAPIKey), ctx, keyID, revokedAt)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockAPIKeyRepository) TouchAPIKey(ctx context.Context, keyID string, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, keyID, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockAPIKeyRepositoryMockRecorder) TouchAPIKey(ctx, keyID, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockAPIKeyRepository)(nil).TouchAPIKey), ctx, keyID, usedAt)
+}
+
+// MockBasicChatRepository is a mock of BasicChatRepository interface.
+type MockBasicChatRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBasicChatRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBasicChatRepositoryMockRecorder is the mock recorder for MockBasicChatRepository.
+type MockBasicChatRepositoryMockRecorder struct {
+	mock *MockBasicChatRepository
+}
+
+// NewMockBasicChatRepository creates a new mock instance.
+func NewMockBasicChatRepository(ctrl *gomock.Controller) *MockBasicChatRepository {
+	mock := &MockBasicChatRepository{ctrl: ctrl}
+	mock.recorder = &MockBasicChatRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBasicChatRepository) EXPECT() *MockBasicChatRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Chat mocks base method.
+func (m *MockBasicChatRepository) Chat(ctx context.Context, msg string) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Chat", ctx, msg)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Chat indicates an expected call of Chat.
+func (mr *MockBasicChatRepositoryMockRecorder) Chat(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chat", reflect.TypeOf((*MockBasicChatRepository)(nil).Chat), ctx, msg)
+}
+
+// ChatWithTemplate mocks base method.
+func (m *MockBasicChatRepository) ChatWithTemplate(ctx context.Context, templateName string, variables map[string]any) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatWithTemplate", ctx, templateName, variables)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatWithTemplate indicates an expected call of ChatWithTemplate.
+func (mr *MockBasicChatRepositoryMockRecorder) ChatWithTemplate(ctx, templateName, variables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatWithTemplate", reflect.TypeOf((*MockBasicChatRepository)(nil).ChatWithTemplate), ctx, templateName, variables)
+}
+
+// MockIdentityTokenRepository is a mock of IdentityTokenRepository interface.
+type MockIdentityTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityTokenRepositoryMockRecorder is the mock recorder for MockIdentityTokenRepository.
+type MockIdentityTokenRepositoryMockRecorder struct {
+	mock *MockIdentityTokenRepository
+}
+
+// NewMockIdentityTokenRepository creates a new mock instance.
+func NewMockIdentityTokenRepository(ctrl *gomock.Controller) *MockIdentityTokenRepository {
+	mock := &MockIdentityTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityTokenRepository) EXPECT() *MockIdentityTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// VerifyIdentityToken mocks base method.
+func (m *MockIdentityTokenRepository) VerifyIdentityToken(ctx context.Context, token string) (*domain.IdentityClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIdentityToken", ctx, token)
+	ret0, _ := ret[0].(*domain.IdentityClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIdentityToken indicates an expected call of VerifyIdentityToken.
+func (mr *MockIdentityTokenRepositoryMockRecorder) VerifyIdentityToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIdentityToken", reflect.TypeOf((*MockIdentityTokenRepository)(nil).VerifyIdentityToken), ctx, token)
+}
+
 // MockUserRepository is a mock of UserRepository interface.
 type MockUserRepository struct {
 	ctrl     *gomock.Controller
@@ -41,190 +394,701 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
-// FindByEmail mocks base method.
-func (m *MockUserRepository) FindByEmail(email string) (*domain.User, error) {
+// CreateUser mocks base method.
+func (m *MockUserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByEmail", email)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockUserRepositoryMockRecorder) FindByEmail(email any) *gomock.Call {
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), ctx, user)
 }
 
-// FindByID mocks base method.
-func (m *MockUserRepository) FindByID(id int) (*domain.User, error) {
+// GetUser mocks base method.
+func (m *MockUserRepository) GetUser(ctx context.Context, id int) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByID indicates an expected call of FindByID.
-func (mr *MockUserRepositoryMockRecorder) FindByID(id any) *gomock.Call {
+// GetUser indicates an expected call of GetUser.
+func (mr *MockUserRepositoryMockRecorder) GetUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserRepository)(nil).GetUser), ctx, id)
 }
 
-// List mocks base method.
-func (m *MockUserRepository) List(offset, limit int) (domain.Users, error) {
+// GetUserByIdentity mocks base method.
+func (m *MockUserRepository) GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", offset, limit)
-	ret0, _ := ret[0].(domain.Users)
+	ret := m.ctrl.Call(m, "GetUserByIdentity", ctx, issuer, subject)
+	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockUserRepositoryMockRecorder) List(offset, limit any) *gomock.Call {
+// GetUserByIdentity indicates an expected call of GetUserByIdentity.
+func (mr *MockUserRepositoryMockRecorder) GetUserByIdentity(ctx, issuer, subject any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByIdentity", reflect.TypeOf((*MockUserRepository)(nil).GetUserByIdentity), ctx, issuer, subject)
 }
 
-// Save mocks base method.
-func (m *MockUserRepository) Save(arg0 *domain.User) error {
+// UpdateUserProfile mocks base method.
+func (m *MockUserRepository) UpdateUserProfile(ctx context.Context, user *domain.User) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0)
+	ret := m.ctrl.Call(m, "UpdateUserProfile", ctx, user)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserProfile), ctx, user)
+}
+
+// MockConversationRepository is a mock of ConversationRepository interface.
+type MockConversationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockConversationRepositoryMockRecorder is the mock recorder for MockConversationRepository.
+type MockConversationRepositoryMockRecorder struct {
+	mock *MockConversationRepository
+}
+
+// NewMockConversationRepository creates a new mock instance.
+func NewMockConversationRepository(ctrl *gomock.Controller) *MockConversationRepository {
+	mock := &MockConversationRepository{ctrl: ctrl}
+	mock.recorder = &MockConversationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationRepository) EXPECT() *MockConversationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListConversationTurns mocks base method.
+func (m *MockConversationRepository) ListConversationTurns(ctx context.Context, userID, offset, limit int) (domain.ConversationTurns, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConversationTurns", ctx, userID, offset, limit)
+	ret0, _ := ret[0].(domain.ConversationTurns)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListConversationTurns indicates an expected call of ListConversationTurns.
+func (mr *MockConversationRepositoryMockRecorder) ListConversationTurns(ctx, userID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConversationTurns", reflect.TypeOf((*MockConversationRepository)(nil).ListConversationTurns), ctx, userID, offset, limit)
+}
+
+// SaveConversationTurn mocks base method.
+func (m *MockConversationRepository) SaveConversationTurn(ctx context.Context, turn *domain.ConversationTurn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConversationTurn", ctx, turn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Save indicates an expected call of Save.
-func (mr *MockUserRepositoryMockRecorder) Save(arg0 any) *gomock.Call {
+// SaveConversationTurn indicates an expected call of SaveConversationTurn.
+func (mr *MockConversationRepositoryMockRecorder) SaveConversationTurn(ctx, turn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserRepository)(nil).Save), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConversationTurn", reflect.TypeOf((*MockConversationRepository)(nil).SaveConversationTurn), ctx, turn)
 }
 
-// MockBasicChatRepository is a mock of BasicChatRepository interface.
-type MockBasicChatRepository struct {
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockBasicChatRepositoryMockRecorder
+	recorder *MockAuditRepositoryMockRecorder
 	isgomock struct{}
 }
 
-// MockBasicChatRepositoryMockRecorder is the mock recorder for MockBasicChatRepository.
-type MockBasicChatRepositoryMockRecorder struct {
-	mock *MockBasicChatRepository
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
 }
 
-// NewMockBasicChatRepository creates a new mock instance.
-func NewMockBasicChatRepository(ctrl *gomock.Controller) *MockBasicChatRepository {
-	mock := &MockBasicChatRepository{ctrl: ctrl}
-	mock.recorder = &MockBasicChatRepositoryMockRecorder{mock}
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBasicChatRepository) EXPECT() *MockBasicChatRepositoryMockRecorder {
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
 	return m.recorder
 }
 
-// AskBasicBranchChat mocks base method.
-func (m *MockBasicChatRepository) AskBasicBranchChat(ctx context.Context, msg string) (string, error) {
+// DeleteAuditRecordsBefore mocks base method.
+func (m *MockAuditRepository) DeleteAuditRecordsBefore(ctx context.Context, cutoff time.Time) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskBasicBranchChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DeleteAuditRecordsBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskBasicBranchChat indicates an expected call of AskBasicBranchChat.
-func (mr *MockBasicChatRepositoryMockRecorder) AskBasicBranchChat(ctx, msg any) *gomock.Call {
+// DeleteAuditRecordsBefore indicates an expected call of DeleteAuditRecordsBefore.
+func (mr *MockAuditRepositoryMockRecorder) DeleteAuditRecordsBefore(ctx, cutoff any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicBranchChat", reflect.TypeOf((*MockBasicChatRepository)(nil).AskBasicBranchChat), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuditRecordsBefore", reflect.TypeOf((*MockAuditRepository)(nil).DeleteAuditRecordsBefore), ctx, cutoff)
 }
 
-// AskBasicChat mocks base method.
-func (m *MockBasicChatRepository) AskBasicChat(ctx context.Context, msg string) (string, error) {
+// GetAuditRecord mocks base method.
+func (m *MockAuditRepository) GetAuditRecord(ctx context.Context, id int) (*domain.AuditRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskBasicChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetAuditRecord", ctx, id)
+	ret0, _ := ret[0].(*domain.AuditRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskBasicChat indicates an expected call of AskBasicChat.
-func (mr *MockBasicChatRepositoryMockRecorder) AskBasicChat(ctx, msg any) *gomock.Call {
+// GetAuditRecord indicates an expected call of GetAuditRecord.
+func (mr *MockAuditRepositoryMockRecorder) GetAuditRecord(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicChat", reflect.TypeOf((*MockBasicChatRepository)(nil).AskBasicChat), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditRecord", reflect.TypeOf((*MockAuditRepository)(nil).GetAuditRecord), ctx, id)
 }
 
-// AskBasicParallelChat mocks base method.
-func (m *MockBasicChatRepository) AskBasicParallelChat(ctx context.Context, msg string) (string, error) {
+// ListAuditRecords mocks base method.
+func (m *MockAuditRepository) ListAuditRecords(ctx context.Context, filter *domain.AuditFilter) (domain.AuditRecords, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskBasicParallelChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListAuditRecords", ctx, filter)
+	ret0, _ := ret[0].(domain.AuditRecords)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockAuditRepositoryMockRecorder) ListAuditRecords(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockAuditRepository)(nil).ListAuditRecords), ctx, filter)
+}
+
+// SaveAuditRecord mocks base method.
+func (m *MockAuditRepository) SaveAuditRecord(ctx context.Context, record *domain.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditRecord", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditRecord indicates an expected call of SaveAuditRecord.
+func (mr *MockAuditRepositoryMockRecorder) SaveAuditRecord(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditRecord", reflect.TypeOf((*MockAuditRepository)(nil).SaveAuditRecord), ctx, record)
+}
+
+// MockAnswerFeedbackRepository is a mock of AnswerFeedbackRepository interface.
+type MockAnswerFeedbackRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnswerFeedbackRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAnswerFeedbackRepositoryMockRecorder is the mock recorder for MockAnswerFeedbackRepository.
+type MockAnswerFeedbackRepositoryMockRecorder struct {
+	mock *MockAnswerFeedbackRepository
+}
+
+// NewMockAnswerFeedbackRepository creates a new mock instance.
+func NewMockAnswerFeedbackRepository(ctrl *gomock.Controller) *MockAnswerFeedbackRepository {
+	mock := &MockAnswerFeedbackRepository{ctrl: ctrl}
+	mock.recorder = &MockAnswerFeedbackRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnswerFeedbackRepository) EXPECT() *MockAnswerFeedbackRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListKnowledgeGapCandidates mocks base method.
+func (m *MockAnswerFeedbackRepository) ListKnowledgeGapCandidates(ctx context.Context, since time.Time, maxSimilarity float64, limit int) (domain.KnowledgeGapCandidates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKnowledgeGapCandidates", ctx, since, maxSimilarity, limit)
+	ret0, _ := ret[0].(domain.KnowledgeGapCandidates)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskBasicParallelChat indicates an expected call of AskBasicParallelChat.
-func (mr *MockBasicChatRepositoryMockRecorder) AskBasicParallelChat(ctx, msg any) *gomock.Call {
+// ListKnowledgeGapCandidates indicates an expected call of ListKnowledgeGapCandidates.
+func (mr *MockAnswerFeedbackRepositoryMockRecorder) ListKnowledgeGapCandidates(ctx, since, maxSimilarity, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicParallelChat", reflect.TypeOf((*MockBasicChatRepository)(nil).AskBasicParallelChat), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKnowledgeGapCandidates", reflect.TypeOf((*MockAnswerFeedbackRepository)(nil).ListKnowledgeGapCandidates), ctx, since, maxSimilarity, limit)
 }
 
-// AskBasicPromptTemplateChat mocks base method.
-func (m *MockBasicChatRepository) AskBasicPromptTemplateChat(ctx context.Context, msg string) (string, error) {
+// RankKnowledgeByNegativeFeedback mocks base method.
+func (m *MockAnswerFeedbackRepository) RankKnowledgeByNegativeFeedback(ctx context.Context, since time.Time, limit int) (domain.KnowledgeFeedbackStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskBasicPromptTemplateChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "RankKnowledgeByNegativeFeedback", ctx, since, limit)
+	ret0, _ := ret[0].(domain.KnowledgeFeedbackStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskBasicPromptTemplateChat indicates an expected call of AskBasicPromptTemplateChat.
-func (mr *MockBasicChatRepositoryMockRecorder) AskBasicPromptTemplateChat(ctx, msg any) *gomock.Call {
+// RankKnowledgeByNegativeFeedback indicates an expected call of RankKnowledgeByNegativeFeedback.
+func (mr *MockAnswerFeedbackRepositoryMockRecorder) RankKnowledgeByNegativeFeedback(ctx, since, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicPromptTemplateChat", reflect.TypeOf((*MockBasicChatRepository)(nil).AskBasicPromptTemplateChat), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankKnowledgeByNegativeFeedback", reflect.TypeOf((*MockAnswerFeedbackRepository)(nil).RankKnowledgeByNegativeFeedback), ctx, since, limit)
 }
 
-// AskWithGraph mocks base method.
-func (m *MockBasicChatRepository) AskWithGraph(ctx context.Context, msg string) (string, error) {
+// SaveAnswerFeedback mocks base method.
+func (m *MockAnswerFeedbackRepository) SaveAnswerFeedback(ctx context.Context, feedback *domain.AnswerFeedback) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskWithGraph", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SaveAnswerFeedback", ctx, feedback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAnswerFeedback indicates an expected call of SaveAnswerFeedback.
+func (mr *MockAnswerFeedbackRepositoryMockRecorder) SaveAnswerFeedback(ctx, feedback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAnswerFeedback", reflect.TypeOf((*MockAnswerFeedbackRepository)(nil).SaveAnswerFeedback), ctx, feedback)
+}
+
+// MockRateLimitRepository is a mock of RateLimitRepository interface.
+type MockRateLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitRepositoryMockRecorder is the mock recorder for MockRateLimitRepository.
+type MockRateLimitRepositoryMockRecorder struct {
+	mock *MockRateLimitRepository
+}
+
+// NewMockRateLimitRepository creates a new mock instance.
+func NewMockRateLimitRepository(ctrl *gomock.Controller) *MockRateLimitRepository {
+	mock := &MockRateLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockRateLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitRepository) EXPECT() *MockRateLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// PruneRateLimitBuckets mocks base method.
+func (m *MockRateLimitRepository) PruneRateLimitBuckets(ctx context.Context, idleFor time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneRateLimitBuckets", ctx, idleFor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneRateLimitBuckets indicates an expected call of PruneRateLimitBuckets.
+func (mr *MockRateLimitRepositoryMockRecorder) PruneRateLimitBuckets(ctx, idleFor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneRateLimitBuckets", reflect.TypeOf((*MockRateLimitRepository)(nil).PruneRateLimitBuckets), ctx, idleFor)
+}
+
+// TakeRateLimitToken mocks base method.
+func (m *MockRateLimitRepository) TakeRateLimitToken(ctx context.Context, policy *domain.RateLimitPolicy, clientKey string) (*domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeRateLimitToken", ctx, policy, clientKey)
+	ret0, _ := ret[0].(*domain.RateLimitDecision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskWithGraph indicates an expected call of AskWithGraph.
-func (mr *MockBasicChatRepositoryMockRecorder) AskWithGraph(ctx, msg any) *gomock.Call {
+// TakeRateLimitToken indicates an expected call of TakeRateLimitToken.
+func (mr *MockRateLimitRepositoryMockRecorder) TakeRateLimitToken(ctx, policy, clientKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskWithGraph", reflect.TypeOf((*MockBasicChatRepository)(nil).AskWithGraph), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeRateLimitToken", reflect.TypeOf((*MockRateLimitRepository)(nil).TakeRateLimitToken), ctx, policy, clientKey)
 }
 
-// AskWithGraphWithBranch mocks base method.
-func (m *MockBasicChatRepository) AskWithGraphWithBranch(ctx context.Context, arg1 string) (string, error) {
+// MockEmbeddingRepository is a mock of EmbeddingRepository interface.
+type MockEmbeddingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmbeddingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEmbeddingRepositoryMockRecorder is the mock recorder for MockEmbeddingRepository.
+type MockEmbeddingRepositoryMockRecorder struct {
+	mock *MockEmbeddingRepository
+}
+
+// NewMockEmbeddingRepository creates a new mock instance.
+func NewMockEmbeddingRepository(ctrl *gomock.Controller) *MockEmbeddingRepository {
+	mock := &MockEmbeddingRepository{ctrl: ctrl}
+	mock.recorder = &MockEmbeddingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmbeddingRepository) EXPECT() *MockEmbeddingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// EmbedString mocks base method.
+func (m *MockEmbeddingRepository) EmbedString(ctx context.Context, text string) (domain.Embedding, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskWithGraphWithBranch", ctx, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "EmbedString", ctx, text)
+	ret0, _ := ret[0].(domain.Embedding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbedString indicates an expected call of EmbedString.
+func (mr *MockEmbeddingRepositoryMockRecorder) EmbedString(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbedString", reflect.TypeOf((*MockEmbeddingRepository)(nil).EmbedString), ctx, text)
+}
+
+// EmbedStrings mocks base method.
+func (m *MockEmbeddingRepository) EmbedStrings(ctx context.Context, texts []string) (domain.Embeddings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmbedStrings", ctx, texts)
+	ret0, _ := ret[0].(domain.Embeddings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbedStrings indicates an expected call of EmbedStrings.
+func (mr *MockEmbeddingRepositoryMockRecorder) EmbedStrings(ctx, texts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbedStrings", reflect.TypeOf((*MockEmbeddingRepository)(nil).EmbedStrings), ctx, texts)
+}
+
+// MockPromptTemplateRepository is a mock of PromptTemplateRepository interface.
+type MockPromptTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromptTemplateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPromptTemplateRepositoryMockRecorder is the mock recorder for MockPromptTemplateRepository.
+type MockPromptTemplateRepositoryMockRecorder struct {
+	mock *MockPromptTemplateRepository
+}
+
+// NewMockPromptTemplateRepository creates a new mock instance.
+func NewMockPromptTemplateRepository(ctrl *gomock.Controller) *MockPromptTemplateRepository {
+	mock := &MockPromptTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockPromptTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromptTemplateRepository) EXPECT() *MockPromptTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPromptTemplate mocks base method.
+func (m *MockPromptTemplateRepository) GetPromptTemplate(ctx context.Context, name string, version int) (*domain.PromptTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromptTemplate", ctx, name, version)
+	ret0, _ := ret[0].(*domain.PromptTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromptTemplate indicates an expected call of GetPromptTemplate.
+func (mr *MockPromptTemplateRepositoryMockRecorder) GetPromptTemplate(ctx, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromptTemplate", reflect.TypeOf((*MockPromptTemplateRepository)(nil).GetPromptTemplate), ctx, name, version)
+}
+
+// ListPromptTemplates mocks base method.
+func (m *MockPromptTemplateRepository) ListPromptTemplates(ctx context.Context) (domain.PromptTemplates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromptTemplates", ctx)
+	ret0, _ := ret[0].(domain.PromptTemplates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromptTemplates indicates an expected call of ListPromptTemplates.
+func (mr *MockPromptTemplateRepositoryMockRecorder) ListPromptTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromptTemplates", reflect.TypeOf((*MockPromptTemplateRepository)(nil).ListPromptTemplates), ctx)
+}
+
+// MockExperimentRepository is a mock of ExperimentRepository interface.
+type MockExperimentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExperimentRepositoryMockRecorder is the mock recorder for MockExperimentRepository.
+type MockExperimentRepositoryMockRecorder struct {
+	mock *MockExperimentRepository
+}
+
+// NewMockExperimentRepository creates a new mock instance.
+func NewMockExperimentRepository(ctrl *gomock.Controller) *MockExperimentRepository {
+	mock := &MockExperimentRepository{ctrl: ctrl}
+	mock.recorder = &MockExperimentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperimentRepository) EXPECT() *MockExperimentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetActiveExperiment mocks base method.
+func (m *MockExperimentRepository) GetActiveExperiment(ctx context.Context) (*domain.Experiment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveExperiment", ctx)
+	ret0, _ := ret[0].(*domain.Experiment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveExperiment indicates an expected call of GetActiveExperiment.
+func (mr *MockExperimentRepositoryMockRecorder) GetActiveExperiment(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveExperiment", reflect.TypeOf((*MockExperimentRepository)(nil).GetActiveExperiment), ctx)
+}
+
+// ListExperiments mocks base method.
+func (m *MockExperimentRepository) ListExperiments(ctx context.Context) (domain.Experiments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiments", ctx)
+	ret0, _ := ret[0].(domain.Experiments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiments indicates an expected call of ListExperiments.
+func (mr *MockExperimentRepositoryMockRecorder) ListExperiments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiments", reflect.TypeOf((*MockExperimentRepository)(nil).ListExperiments), ctx)
+}
+
+// MockExperimentExposureRepository is a mock of ExperimentExposureRepository interface.
+type MockExperimentExposureRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentExposureRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExperimentExposureRepositoryMockRecorder is the mock recorder for MockExperimentExposureRepository.
+type MockExperimentExposureRepositoryMockRecorder struct {
+	mock *MockExperimentExposureRepository
+}
+
+// NewMockExperimentExposureRepository creates a new mock instance.
+func NewMockExperimentExposureRepository(ctrl *gomock.Controller) *MockExperimentExposureRepository {
+	mock := &MockExperimentExposureRepository{ctrl: ctrl}
+	mock.recorder = &MockExperimentExposureRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperimentExposureRepository) EXPECT() *MockExperimentExposureRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetVariantStats mocks base method.
+func (m *MockExperimentExposureRepository) GetVariantStats(ctx context.Context, experiment string) (domain.VariantStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantStats", ctx, experiment)
+	ret0, _ := ret[0].(domain.VariantStats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskWithGraphWithBranch indicates an expected call of AskWithGraphWithBranch.
-func (mr *MockBasicChatRepositoryMockRecorder) AskWithGraphWithBranch(ctx, arg1 any) *gomock.Call {
+// GetVariantStats indicates an expected call of GetVariantStats.
+func (mr *MockExperimentExposureRepositoryMockRecorder) GetVariantStats(ctx, experiment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantStats", reflect.TypeOf((*MockExperimentExposureRepository)(nil).GetVariantStats), ctx, experiment)
+}
+
+// SaveExperimentExposure mocks base method.
+func (m *MockExperimentExposureRepository) SaveExperimentExposure(ctx context.Context, exposure *domain.ExperimentExposure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveExperimentExposure", ctx, exposure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveExperimentExposure indicates an expected call of SaveExperimentExposure.
+func (mr *MockExperimentExposureRepositoryMockRecorder) SaveExperimentExposure(ctx, exposure any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskWithGraphWithBranch", reflect.TypeOf((*MockBasicChatRepository)(nil).AskWithGraphWithBranch), ctx, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveExperimentExposure", reflect.TypeOf((*MockExperimentExposureRepository)(nil).SaveExperimentExposure), ctx, exposure)
+}
+
+// ScoreExperimentExposure mocks base method.
+func (m *MockExperimentExposureRepository) ScoreExperimentExposure(ctx context.Context, answerID int, score float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScoreExperimentExposure", ctx, answerID, score)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScoreExperimentExposure indicates an expected call of ScoreExperimentExposure.
+func (mr *MockExperimentExposureRepositoryMockRecorder) ScoreExperimentExposure(ctx, answerID, score any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScoreExperimentExposure", reflect.TypeOf((*MockExperimentExposureRepository)(nil).ScoreExperimentExposure), ctx, answerID, score)
+}
+
+// MockInquiryKnowledgeRepository is a mock of InquiryKnowledgeRepository interface.
+type MockInquiryKnowledgeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInquiryKnowledgeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInquiryKnowledgeRepositoryMockRecorder is the mock recorder for MockInquiryKnowledgeRepository.
+type MockInquiryKnowledgeRepositoryMockRecorder struct {
+	mock *MockInquiryKnowledgeRepository
+}
+
+// NewMockInquiryKnowledgeRepository creates a new mock instance.
+func NewMockInquiryKnowledgeRepository(ctrl *gomock.Controller) *MockInquiryKnowledgeRepository {
+	mock := &MockInquiryKnowledgeRepository{ctrl: ctrl}
+	mock.recorder = &MockInquiryKnowledgeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInquiryKnowledgeRepository) EXPECT() *MockInquiryKnowledgeRepositoryMockRecorder {
+	return m.recorder
 }
 
-// AskWithTool mocks base method.
-func (m *MockBasicChatRepository) AskWithTool(ctx context.Context, msg string) (string, error) {
+// BatchSaveInquiryKnowledge mocks base method.
+func (m *MockInquiryKnowledgeRepository) BatchSaveInquiryKnowledge(ctx context.Context, items domain.InquiryKnowledges) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskWithTool", ctx, msg)
+	ret := m.ctrl.Call(m, "BatchSaveInquiryKnowledge", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSaveInquiryKnowledge indicates an expected call of BatchSaveInquiryKnowledge.
+func (mr *MockInquiryKnowledgeRepositoryMockRecorder) BatchSaveInquiryKnowledge(ctx, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSaveInquiryKnowledge", reflect.TypeOf((*MockInquiryKnowledgeRepository)(nil).BatchSaveInquiryKnowledge), ctx, items)
+}
+
+// CountEmbeddedInquiryKnowledge mocks base method.
+func (m *MockInquiryKnowledgeRepository) CountEmbeddedInquiryKnowledge(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEmbeddedInquiryKnowledge", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEmbeddedInquiryKnowledge indicates an expected call of CountEmbeddedInquiryKnowledge.
+func (mr *MockInquiryKnowledgeRepositoryMockRecorder) CountEmbeddedInquiryKnowledge(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEmbeddedInquiryKnowledge", reflect.TypeOf((*MockInquiryKnowledgeRepository)(nil).CountEmbeddedInquiryKnowledge), ctx)
+}
+
+// FindSimilars mocks base method.
+func (m *MockInquiryKnowledgeRepository) FindSimilars(ctx context.Context, embedding domain.Embedding, limit int, filter domain.KnowledgeFilter) (domain.InquirySimilarityResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilars", ctx, embedding, limit, filter)
+	ret0, _ := ret[0].(domain.InquirySimilarityResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilars indicates an expected call of FindSimilars.
+func (mr *MockInquiryKnowledgeRepositoryMockRecorder) FindSimilars(ctx, embedding, limit, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilars", reflect.TypeOf((*MockInquiryKnowledgeRepository)(nil).FindSimilars), ctx, embedding, limit, filter)
+}
+
+// MockDatabaseHealthRepository is a mock of DatabaseHealthRepository interface.
+type MockDatabaseHealthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseHealthRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseHealthRepositoryMockRecorder is the mock recorder for MockDatabaseHealthRepository.
+type MockDatabaseHealthRepositoryMockRecorder struct {
+	mock *MockDatabaseHealthRepository
+}
+
+// NewMockDatabaseHealthRepository creates a new mock instance.
+func NewMockDatabaseHealthRepository(ctrl *gomock.Controller) *MockDatabaseHealthRepository {
+	mock := &MockDatabaseHealthRepository{ctrl: ctrl}
+	mock.recorder = &MockDatabaseHealthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseHealthRepository) EXPECT() *MockDatabaseHealthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEmbeddingDimensions mocks base method.
+func (m *MockDatabaseHealthRepository) GetEmbeddingDimensions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmbeddingDimensions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmbeddingDimensions indicates an expected call of GetEmbeddingDimensions.
+func (mr *MockDatabaseHealthRepositoryMockRecorder) GetEmbeddingDimensions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmbeddingDimensions", reflect.TypeOf((*MockDatabaseHealthRepository)(nil).GetEmbeddingDimensions), ctx)
+}
+
+// GetMigrationState mocks base method.
+func (m *MockDatabaseHealthRepository) GetMigrationState(ctx context.Context) (*domain.MigrationState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationState", ctx)
+	ret0, _ := ret[0].(*domain.MigrationState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationState indicates an expected call of GetMigrationState.
+func (mr *MockDatabaseHealthRepositoryMockRecorder) GetMigrationState(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationState", reflect.TypeOf((*MockDatabaseHealthRepository)(nil).GetMigrationState), ctx)
+}
+
+// GetVectorExtensionVersion mocks base method.
+func (m *MockDatabaseHealthRepository) GetVectorExtensionVersion(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorExtensionVersion", ctx)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AskWithTool indicates an expected call of AskWithTool.
-func (mr *MockBasicChatRepositoryMockRecorder) AskWithTool(ctx, msg any) *gomock.Call {
+// GetVectorExtensionVersion indicates an expected call of GetVectorExtensionVersion.
+func (mr *MockDatabaseHealthRepositoryMockRecorder) GetVectorExtensionVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorExtensionVersion", reflect.TypeOf((*MockDatabaseHealthRepository)(nil).GetVectorExtensionVersion), ctx)
+}
+
+// PingDatabase mocks base method.
+func (m *MockDatabaseHealthRepository) PingDatabase(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingDatabase", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PingDatabase indicates an expected call of PingDatabase.
+func (mr *MockDatabaseHealthRepositoryMockRecorder) PingDatabase(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskWithTool", reflect.TypeOf((*MockBasicChatRepository)(nil).AskWithTool), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingDatabase", reflect.TypeOf((*MockDatabaseHealthRepository)(nil).PingDatabase), ctx)
 }
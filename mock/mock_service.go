@@ -12,11 +12,112 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/wonjinsin/simple-chatbot/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockAPIKeyService is a mock of APIKeyService interface.
+type MockAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyServiceMockRecorder is the mock recorder for MockAPIKeyService.
+type MockAPIKeyServiceMockRecorder struct {
+	mock *MockAPIKeyService
+}
+
+// NewMockAPIKeyService creates a new mock instance.
+func NewMockAPIKeyService(ctrl *gomock.Controller) *MockAPIKeyService {
+	mock := &MockAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyService) EXPECT() *MockAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockAPIKeyService) AuthenticateAPIKey(ctx context.Context, token string) (*domain.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, token)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockAPIKeyServiceMockRecorder) AuthenticateAPIKey(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockAPIKeyService)(nil).AuthenticateAPIKey), ctx, token)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyService) CreateAPIKey(ctx context.Context, name string, scopes []string) (*domain.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, name, scopes)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyServiceMockRecorder) CreateAPIKey(ctx, name, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyService)(nil).CreateAPIKey), ctx, name, scopes)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAPIKeyService) ListAPIKeys(ctx context.Context) (domain.APIKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx)
+	ret0, _ := ret[0].(domain.APIKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAPIKeyServiceMockRecorder) ListAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAPIKeyService)(nil).ListAPIKeys), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyService) RevokeAPIKey(ctx context.Context, keyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyServiceMockRecorder) RevokeAPIKey(ctx, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyService)(nil).RevokeAPIKey), ctx, keyID)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockAPIKeyService) RotateAPIKey(ctx context.Context, keyID string) (*domain.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", ctx, keyID)
+	ret0, _ := ret[0].(*domain.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockAPIKeyServiceMockRecorder) RotateAPIKey(ctx, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockAPIKeyService)(nil).RotateAPIKey), ctx, keyID)
+}
+
 // MockUserService is a mock of UserService interface.
 type MockUserService struct {
 	ctrl     *gomock.Controller
@@ -41,19 +142,19 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
-// CreateUser mocks base method.
-func (m *MockUserService) CreateUser(ctx context.Context, name, email string) (*domain.User, error) {
+// AuthenticateUser mocks base method.
+func (m *MockUserService) AuthenticateUser(ctx context.Context, token string) (*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, name, email)
+	ret := m.ctrl.Call(m, "AuthenticateUser", ctx, token)
 	ret0, _ := ret[0].(*domain.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserServiceMockRecorder) CreateUser(ctx, name, email any) *gomock.Call {
+// AuthenticateUser indicates an expected call of AuthenticateUser.
+func (mr *MockUserServiceMockRecorder) AuthenticateUser(ctx, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserService)(nil).CreateUser), ctx, name, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockUserService)(nil).AuthenticateUser), ctx, token)
 }
 
 // GetUser mocks base method.
@@ -71,19 +172,126 @@ func (mr *MockUserServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserService)(nil).GetUser), ctx, id)
 }
 
-// ListUsers mocks base method.
-func (m *MockUserService) ListUsers(ctx context.Context, offset, limit int) (domain.Users, error) {
+// ListConversationTurns mocks base method.
+func (m *MockUserService) ListConversationTurns(ctx context.Context, userID, offset, limit int) (domain.ConversationTurns, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx, offset, limit)
-	ret0, _ := ret[0].(domain.Users)
+	ret := m.ctrl.Call(m, "ListConversationTurns", ctx, userID, offset, limit)
+	ret0, _ := ret[0].(domain.ConversationTurns)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListConversationTurns indicates an expected call of ListConversationTurns.
+func (mr *MockUserServiceMockRecorder) ListConversationTurns(ctx, userID, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConversationTurns", reflect.TypeOf((*MockUserService)(nil).ListConversationTurns), ctx, userID, offset, limit)
+}
+
+// MockHealthService is a mock of HealthService interface.
+type MockHealthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthServiceMockRecorder is the mock recorder for MockHealthService.
+type MockHealthServiceMockRecorder struct {
+	mock *MockHealthService
+}
+
+// NewMockHealthService creates a new mock instance.
+func NewMockHealthService(ctrl *gomock.Controller) *MockHealthService {
+	mock := &MockHealthService{ctrl: ctrl}
+	mock.recorder = &MockHealthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthService) EXPECT() *MockHealthServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckReadiness mocks base method.
+func (m *MockHealthService) CheckReadiness(ctx context.Context) *domain.HealthReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReadiness", ctx)
+	ret0, _ := ret[0].(*domain.HealthReport)
+	return ret0
+}
+
+// CheckReadiness indicates an expected call of CheckReadiness.
+func (mr *MockHealthServiceMockRecorder) CheckReadiness(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReadiness", reflect.TypeOf((*MockHealthService)(nil).CheckReadiness), ctx)
+}
+
+// MockRateLimitService is a mock of RateLimitService interface.
+type MockRateLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitServiceMockRecorder is the mock recorder for MockRateLimitService.
+type MockRateLimitServiceMockRecorder struct {
+	mock *MockRateLimitService
+}
+
+// NewMockRateLimitService creates a new mock instance.
+func NewMockRateLimitService(ctrl *gomock.Controller) *MockRateLimitService {
+	mock := &MockRateLimitService{ctrl: ctrl}
+	mock.recorder = &MockRateLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitService) EXPECT() *MockRateLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockRateLimitService) Acquire(policy *domain.RateLimitPolicy, clientKey string) (func(), bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", policy, clientKey)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockRateLimitServiceMockRecorder) Acquire(policy, clientKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockRateLimitService)(nil).Acquire), policy, clientKey)
+}
+
+// Allow mocks base method.
+func (m *MockRateLimitService) Allow(ctx context.Context, policy *domain.RateLimitPolicy, clientKey string) (*domain.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, policy, clientKey)
+	ret0, _ := ret[0].(*domain.RateLimitDecision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserServiceMockRecorder) ListUsers(ctx, offset, limit any) *gomock.Call {
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimitServiceMockRecorder) Allow(ctx, policy, clientKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserService)(nil).ListUsers), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimitService)(nil).Allow), ctx, policy, clientKey)
+}
+
+// PruneIdleBuckets mocks base method.
+func (m *MockRateLimitService) PruneIdleBuckets(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneIdleBuckets", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneIdleBuckets indicates an expected call of PruneIdleBuckets.
+func (mr *MockRateLimitServiceMockRecorder) PruneIdleBuckets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneIdleBuckets", reflect.TypeOf((*MockRateLimitService)(nil).PruneIdleBuckets), ctx)
 }
 
 // MockBasicChatService is a mock of BasicChatService interface.
@@ -111,10 +319,10 @@ func (m *MockBasicChatService) EXPECT() *MockBasicChatServiceMockRecorder {
 }
 
 // AskBasicChat mocks base method.
-func (m *MockBasicChatService) AskBasicChat(ctx context.Context, msg string) (string, error) {
+func (m *MockBasicChatService) AskBasicChat(ctx context.Context, msg string) (*domain.Answer, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AskBasicChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(*domain.Answer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -126,16 +334,356 @@ func (mr *MockBasicChatServiceMockRecorder) AskBasicChat(ctx, msg any) *gomock.C
 }
 
 // AskBasicPromptTemplateChat mocks base method.
-func (m *MockBasicChatService) AskBasicPromptTemplateChat(ctx context.Context, msg string) (string, error) {
+func (m *MockBasicChatService) AskBasicPromptTemplateChat(ctx context.Context, templateName string, variables map[string]any) (*domain.Answer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AskBasicPromptTemplateChat", ctx, msg)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "AskBasicPromptTemplateChat", ctx, templateName, variables)
+	ret0, _ := ret[0].(*domain.Answer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AskBasicPromptTemplateChat indicates an expected call of AskBasicPromptTemplateChat.
-func (mr *MockBasicChatServiceMockRecorder) AskBasicPromptTemplateChat(ctx, msg any) *gomock.Call {
+func (mr *MockBasicChatServiceMockRecorder) AskBasicPromptTemplateChat(ctx, templateName, variables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicPromptTemplateChat", reflect.TypeOf((*MockBasicChatService)(nil).AskBasicPromptTemplateChat), ctx, templateName, variables)
+}
+
+// MockInquiryService is a mock of InquiryService interface.
+type MockInquiryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInquiryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInquiryServiceMockRecorder is the mock recorder for MockInquiryService.
+type MockInquiryServiceMockRecorder struct {
+	mock *MockInquiryService
+}
+
+// NewMockInquiryService creates a new mock instance.
+func NewMockInquiryService(ctrl *gomock.Controller) *MockInquiryService {
+	mock := &MockInquiryService{ctrl: ctrl}
+	mock.recorder = &MockInquiryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInquiryService) EXPECT() *MockInquiryServiceMockRecorder {
+	return m.recorder
+}
+
+// Ask mocks base method.
+func (m *MockInquiryService) Ask(ctx context.Context, msg string, slots domain.Slots) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ask", ctx, msg, slots)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ask indicates an expected call of Ask.
+func (mr *MockInquiryServiceMockRecorder) Ask(ctx, msg, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockInquiryService)(nil).Ask), ctx, msg, slots)
+}
+
+// Chat mocks base method.
+func (m *MockInquiryService) Chat(ctx context.Context, messages domain.ChatMessages, filter domain.KnowledgeFilter) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Chat", ctx, messages, filter)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Chat indicates an expected call of Chat.
+func (mr *MockInquiryServiceMockRecorder) Chat(ctx, messages, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chat", reflect.TypeOf((*MockInquiryService)(nil).Chat), ctx, messages, filter)
+}
+
+// ChatStream mocks base method.
+func (m *MockInquiryService) ChatStream(ctx context.Context, messages domain.ChatMessages, filter domain.KnowledgeFilter, onChunk func(string) error) (*domain.Answer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatStream", ctx, messages, filter, onChunk)
+	ret0, _ := ret[0].(*domain.Answer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatStream indicates an expected call of ChatStream.
+func (mr *MockInquiryServiceMockRecorder) ChatStream(ctx, messages, filter, onChunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatStream", reflect.TypeOf((*MockInquiryService)(nil).ChatStream), ctx, messages, filter, onChunk)
+}
+
+// EmbedInquiryOrigins mocks base method.
+func (m *MockInquiryService) EmbedInquiryOrigins(ctx context.Context) (*domain.IngestionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmbedInquiryOrigins", ctx)
+	ret0, _ := ret[0].(*domain.IngestionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmbedInquiryOrigins indicates an expected call of EmbedInquiryOrigins.
+func (mr *MockInquiryServiceMockRecorder) EmbedInquiryOrigins(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmbedInquiryOrigins", reflect.TypeOf((*MockInquiryService)(nil).EmbedInquiryOrigins), ctx)
+}
+
+// MockExperimentService is a mock of ExperimentService interface.
+type MockExperimentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExperimentServiceMockRecorder is the mock recorder for MockExperimentService.
+type MockExperimentServiceMockRecorder struct {
+	mock *MockExperimentService
+}
+
+// NewMockExperimentService creates a new mock instance.
+func NewMockExperimentService(ctrl *gomock.Controller) *MockExperimentService {
+	mock := &MockExperimentService{ctrl: ctrl}
+	mock.recorder = &MockExperimentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExperimentService) EXPECT() *MockExperimentServiceMockRecorder {
+	return m.recorder
+}
+
+// CompareVariants mocks base method.
+func (m *MockExperimentService) CompareVariants(ctx context.Context, name string) (domain.VariantStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareVariants", ctx, name)
+	ret0, _ := ret[0].(domain.VariantStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareVariants indicates an expected call of CompareVariants.
+func (mr *MockExperimentServiceMockRecorder) CompareVariants(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareVariants", reflect.TypeOf((*MockExperimentService)(nil).CompareVariants), ctx, name)
+}
+
+// ListExperiments mocks base method.
+func (m *MockExperimentService) ListExperiments(ctx context.Context) (domain.Experiments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExperiments", ctx)
+	ret0, _ := ret[0].(domain.Experiments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperiments indicates an expected call of ListExperiments.
+func (mr *MockExperimentServiceMockRecorder) ListExperiments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperiments", reflect.TypeOf((*MockExperimentService)(nil).ListExperiments), ctx)
+}
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// PruneExpiredRecords mocks base method.
+func (m *MockAuditService) PruneExpiredRecords(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneExpiredRecords", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneExpiredRecords indicates an expected call of PruneExpiredRecords.
+func (mr *MockAuditServiceMockRecorder) PruneExpiredRecords(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneExpiredRecords", reflect.TypeOf((*MockAuditService)(nil).PruneExpiredRecords), ctx)
+}
+
+// SearchAuditRecords mocks base method.
+func (m *MockAuditService) SearchAuditRecords(ctx context.Context, filter *domain.AuditFilter) (domain.AuditRecords, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditRecords", ctx, filter)
+	ret0, _ := ret[0].(domain.AuditRecords)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchAuditRecords indicates an expected call of SearchAuditRecords.
+func (mr *MockAuditServiceMockRecorder) SearchAuditRecords(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditRecords", reflect.TypeOf((*MockAuditService)(nil).SearchAuditRecords), ctx, filter)
+}
+
+// MockFeedbackService is a mock of FeedbackService interface.
+type MockFeedbackService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedbackServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedbackServiceMockRecorder is the mock recorder for MockFeedbackService.
+type MockFeedbackServiceMockRecorder struct {
+	mock *MockFeedbackService
+}
+
+// NewMockFeedbackService creates a new mock instance.
+func NewMockFeedbackService(ctrl *gomock.Controller) *MockFeedbackService {
+	mock := &MockFeedbackService{ctrl: ctrl}
+	mock.recorder = &MockFeedbackServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedbackService) EXPECT() *MockFeedbackServiceMockRecorder {
+	return m.recorder
+}
+
+// FindKnowledgeGaps mocks base method.
+func (m *MockFeedbackService) FindKnowledgeGaps(ctx context.Context, since time.Time, limit int) (domain.KnowledgeGaps, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKnowledgeGaps", ctx, since, limit)
+	ret0, _ := ret[0].(domain.KnowledgeGaps)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKnowledgeGaps indicates an expected call of FindKnowledgeGaps.
+func (mr *MockFeedbackServiceMockRecorder) FindKnowledgeGaps(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKnowledgeGaps", reflect.TypeOf((*MockFeedbackService)(nil).FindKnowledgeGaps), ctx, since, limit)
+}
+
+// RankKnowledgeByNegativeFeedback mocks base method.
+func (m *MockFeedbackService) RankKnowledgeByNegativeFeedback(ctx context.Context, since time.Time, limit int) (domain.KnowledgeFeedbackStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RankKnowledgeByNegativeFeedback", ctx, since, limit)
+	ret0, _ := ret[0].(domain.KnowledgeFeedbackStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RankKnowledgeByNegativeFeedback indicates an expected call of RankKnowledgeByNegativeFeedback.
+func (mr *MockFeedbackServiceMockRecorder) RankKnowledgeByNegativeFeedback(ctx, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RankKnowledgeByNegativeFeedback", reflect.TypeOf((*MockFeedbackService)(nil).RankKnowledgeByNegativeFeedback), ctx, since, limit)
+}
+
+// SubmitFeedback mocks base method.
+func (m *MockFeedbackService) SubmitFeedback(ctx context.Context, answerID int, rating, reason, correction string) (*domain.AnswerFeedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitFeedback", ctx, answerID, rating, reason, correction)
+	ret0, _ := ret[0].(*domain.AnswerFeedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitFeedback indicates an expected call of SubmitFeedback.
+func (mr *MockFeedbackServiceMockRecorder) SubmitFeedback(ctx, answerID, rating, reason, correction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFeedback", reflect.TypeOf((*MockFeedbackService)(nil).SubmitFeedback), ctx, answerID, rating, reason, correction)
+}
+
+// MockEvaluationService is a mock of EvaluationService interface.
+type MockEvaluationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEvaluationServiceMockRecorder is the mock recorder for MockEvaluationService.
+type MockEvaluationServiceMockRecorder struct {
+	mock *MockEvaluationService
+}
+
+// NewMockEvaluationService creates a new mock instance.
+func NewMockEvaluationService(ctrl *gomock.Controller) *MockEvaluationService {
+	mock := &MockEvaluationService{ctrl: ctrl}
+	mock.recorder = &MockEvaluationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluationService) EXPECT() *MockEvaluationServiceMockRecorder {
+	return m.recorder
+}
+
+// EvaluateRetrieval mocks base method.
+func (m *MockEvaluationService) EvaluateRetrieval(ctx context.Context, items domain.InquiryKnowledges, cfg domain.RetrievalEvalConfig) (*domain.RetrievalReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateRetrieval", ctx, items, cfg)
+	ret0, _ := ret[0].(*domain.RetrievalReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateRetrieval indicates an expected call of EvaluateRetrieval.
+func (mr *MockEvaluationServiceMockRecorder) EvaluateRetrieval(ctx, items, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateRetrieval", reflect.TypeOf((*MockEvaluationService)(nil).EvaluateRetrieval), ctx, items, cfg)
+}
+
+// MockAnswerEvaluationService is a mock of AnswerEvaluationService interface.
+type MockAnswerEvaluationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnswerEvaluationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAnswerEvaluationServiceMockRecorder is the mock recorder for MockAnswerEvaluationService.
+type MockAnswerEvaluationServiceMockRecorder struct {
+	mock *MockAnswerEvaluationService
+}
+
+// NewMockAnswerEvaluationService creates a new mock instance.
+func NewMockAnswerEvaluationService(ctrl *gomock.Controller) *MockAnswerEvaluationService {
+	mock := &MockAnswerEvaluationService{ctrl: ctrl}
+	mock.recorder = &MockAnswerEvaluationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnswerEvaluationService) EXPECT() *MockAnswerEvaluationServiceMockRecorder {
+	return m.recorder
+}
+
+// EvaluateAnswers mocks base method.
+func (m *MockAnswerEvaluationService) EvaluateAnswers(ctx context.Context, golden domain.GoldenQuestions) (*domain.AnswerEvalReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateAnswers", ctx, golden)
+	ret0, _ := ret[0].(*domain.AnswerEvalReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateAnswers indicates an expected call of EvaluateAnswers.
+func (mr *MockAnswerEvaluationServiceMockRecorder) EvaluateAnswers(ctx, golden any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AskBasicPromptTemplateChat", reflect.TypeOf((*MockBasicChatService)(nil).AskBasicPromptTemplateChat), ctx, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAnswers", reflect.TypeOf((*MockAnswerEvaluationService)(nil).EvaluateAnswers), ctx, golden)
 }
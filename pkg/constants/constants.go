@@ -6,4 +6,8 @@ type ContextKey string
 const (
 	// ContextKeyTrID is the key for storing TrID in context
 	ContextKeyTrID ContextKey = "tr_id"
+	// ContextKeyAPIKeyID is the key for storing the authenticated API key ID in context
+	ContextKeyAPIKeyID ContextKey = "api_key_id"
+	// ContextKeyUserID is the key for storing the authenticated end-user ID in context
+	ContextKeyUserID ContextKey = "user_id"
 )
@@ -10,12 +10,21 @@ const (
 
 // HTTP Headers
 const (
-	HeaderContentType   = "Content-Type"
-	HeaderAuthorization = "Authorization"
-	HeaderAccept        = "Accept"
+	HeaderContentType     = "Content-Type"
+	HeaderAuthorization   = "Authorization"
+	HeaderAccept          = "Accept"
+	HeaderWWWAuthenticate = "WWW-Authenticate"
+	HeaderRetryAfter      = "Retry-After"
+	HeaderRateLimitLimit  = "X-RateLimit-Limit"
+	HeaderRateLimitRemain = "X-RateLimit-Remaining"
+	HeaderRequestID       = "X-Request-ID"
+	HeaderTrID            = "X-Trid"
+	// HeaderClientRequestID is OpenAI's header for caller-supplied request IDs
+	HeaderClientRequestID = "X-Client-Request-Id"
 )
 
 // Content Types
 const (
 	ContentTypeJSONCharset = "application/json; charset=utf-8"
+	ContentTypeEventStream = "text/event-stream"
 )
@@ -6,11 +6,24 @@ const (
 	MaxNameLength  = 200
 	MinEmailLength = 3
 	MaxEmailLength = 320 // RFC 5321 limit
+
+	// Transaction ID validation (inbound request IDs)
+	MaxTrIDLength = 64
 )
 
 // Regex patterns
 const (
 	EmailPattern = `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
+	// TrIDPattern only allows characters that are safe in headers, logs and SQL comments
+	TrIDPattern = `^[a-zA-Z0-9._:-]+$`
+
+	// PII patterns match anywhere in free text; pkg/pii validates card and IBAN candidates.
+	// Phone numbers without a country code must start a word and end in four digits, so IP
+	// addresses, amounts ("10 000 000") and the tail of longer IDs are not taken for one.
+	PhonePattern = `\+\d{1,3}[ .-]?(?:\(\d{2,4}\)|\d{2,4})[ .-]\d{3,4}[ .-]\d{3,4}\b|` +
+		`(?:\(\d{2,4}\)|\b\d{2,4})[ .-]\d{3,4}[ .-]\d{4}\b|\+\d{8,14}\b`
+	CardPattern = `\b\d(?:[ -]?\d){12,18}\b`
+	IBANPattern = `\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`
 )
 
 // ID generation
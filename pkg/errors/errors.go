@@ -9,8 +9,11 @@ import (
 
 // CustomError represents an error with a 4-digit code
 type CustomError struct {
-	Code    pkgConstants.ErrorCode // 4-digit code (e.g., "0201")
-	Message string
+	Code          pkgConstants.ErrorCode // 4-digit code (e.g., "0201")
+	Message       string                 // Internal detail including the cause chain (for logs)
+	PublicMessage string                 // Client-safe message (for responses)
+	Err           error                  // Underlying cause, if any
+	Stack         []uintptr              // Call stack at creation (debug environments only)
 }
 
 // Error implements error interface
@@ -18,46 +21,66 @@ func (e *CustomError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the underlying cause so errors.Is and errors.As see through CustomError
+func (e *CustomError) Unwrap() error {
+	return e.Err
+}
+
 // New creates a new CustomError with code and message.
-// If an underlying error is provided, it combines the messages.
+// The message is client-safe; if an underlying error is provided, its detail is only kept in the
+// internal message.
 func New(code pkgConstants.ErrorCode, message string, err error) *CustomError {
 	finalMessage := message
 	if err != nil {
 		finalMessage = fmt.Sprintf("%s: %s", message, err.Error())
 	}
 	return &CustomError{
-		Code:    code,
-		Message: finalMessage,
+		Code:          code,
+		Message:       finalMessage,
+		PublicMessage: message,
+		Err:           err,
+		Stack:         stackOf(err),
 	}
 }
 
 // Wrap wraps an existing error with context.
-// Accepts an optional error code. If provided, uses that code; otherwise preserves existing code or
-// uses InternalError.
+// Accepts an optional error code. If provided, uses that code; otherwise preserves existing code,
+// reports deadline errors as UpstreamTimeout or uses InternalError.
+// The public message of a wrapped CustomError is preserved unless the code changes; plain errors
+// get the generic public message of their code.
 func Wrap(err error, message string, code ...pkgConstants.ErrorCode) error {
 	if err == nil {
 		return nil
 	}
 
+	var customErr *CustomError
+	isCustom := errors.As(err, &customErr)
+
 	// Determine which code to use
 	var finalCode pkgConstants.ErrorCode
 	if len(code) > 0 && code[0] != "" {
 		// Use provided code
 		finalCode = code[0]
-	} else {
+	} else if isCustom {
 		// If already CustomError, preserve its code
-		var customErr *CustomError
-		if errors.As(err, &customErr) {
-			finalCode = customErr.Code
-		} else {
-			// Otherwise use generic internal error
-			finalCode = pkgConstants.InternalError
-		}
+		finalCode = customErr.Code
+	} else {
+		// Otherwise classify the plain error
+		finalCode = classify(err)
+	}
+
+	// Determine the client-safe message
+	publicMessage := PublicMessageOf(finalCode)
+	if isCustom && customErr.Code == finalCode {
+		publicMessage = customErr.PublicMessage
 	}
 
 	return &CustomError{
-		Code:    finalCode,
-		Message: fmt.Sprintf("%s: %s", message, err.Error()),
+		Code:          finalCode,
+		Message:       fmt.Sprintf("%s: %s", message, err.Error()),
+		PublicMessage: publicMessage,
+		Err:           err,
+		Stack:         stackOf(err),
 	}
 }
 
@@ -74,3 +97,23 @@ func GetCode(err error) pkgConstants.ErrorCode {
 func HasCode(err error, code pkgConstants.ErrorCode) bool {
 	return GetCode(err) == code
 }
+
+// PublicMessage returns the client-safe message of an error.
+// Errors without a CustomError get the generic message of UnknownError.
+func PublicMessage(err error) string {
+	var customErr *CustomError
+	if errors.As(err, &customErr) && customErr.PublicMessage != "" {
+		return customErr.PublicMessage
+	}
+	return PublicMessageOf(GetCode(err))
+}
+
+// Is reports whether any error in err's chain matches target (see the standard errors.Is)
+func Is(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// As finds the first error in err's chain that matches target (see the standard errors.As)
+func As(err error, target any) bool {
+	return errors.As(err, target)
+}
@@ -0,0 +1,64 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	pkgConstants "github.com/wonjinsin/simple-chatbot/internal/constants"
+)
+
+// httpStatuses maps each error code to the HTTP status it is reported with
+var httpStatuses = map[pkgConstants.ErrorCode]int{
+	pkgConstants.UnknownError:       http.StatusInternalServerError,
+	pkgConstants.InvalidParameter:   http.StatusBadRequest,
+	pkgConstants.Unauthorized:       http.StatusUnauthorized,
+	pkgConstants.Forbidden:          http.StatusForbidden,
+	pkgConstants.NotFound:           http.StatusNotFound,
+	pkgConstants.ConstraintError:    http.StatusConflict,
+	pkgConstants.PromptInjection:    http.StatusUnprocessableEntity,
+	pkgConstants.RateLimited:        http.StatusTooManyRequests,
+	pkgConstants.InternalError:      http.StatusInternalServerError,
+	pkgConstants.UpstreamError:      http.StatusBadGateway,
+	pkgConstants.ServiceUnavailable: http.StatusServiceUnavailable,
+	pkgConstants.UpstreamTimeout:    http.StatusGatewayTimeout,
+
+	pkgConstants.AnswerPolicyViolation: http.StatusInternalServerError,
+}
+
+// CodeHTTPStatus returns the HTTP status of an error code.
+// Unknown codes are reported as 500 Internal Server Error.
+func CodeHTTPStatus(code pkgConstants.ErrorCode) int {
+	if status, ok := httpStatuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// PublicMessageOf returns the generic client-safe message for an error code
+func PublicMessageOf(code pkgConstants.ErrorCode) string {
+	switch code {
+	case pkgConstants.UpstreamError:
+		return "upstream provider error"
+	case pkgConstants.UpstreamTimeout:
+		return "upstream provider timed out"
+	default:
+		return strings.ToLower(http.StatusText(CodeHTTPStatus(code)))
+	}
+}
+
+// HTTPStatus returns the HTTP status for an error based on its code
+func HTTPStatus(err error) int {
+	return CodeHTTPStatus(GetCode(err))
+}
+
+// classify determines the code of an error that is not a CustomError. A deadline exceeded
+// inside the service (a slow query, the request timeout) is reported as unavailable; only the
+// provider repositories report UpstreamTimeout, since they know the timeout was upstream.
+func classify(err error) pkgConstants.ErrorCode {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return pkgConstants.ServiceUnavailable
+	}
+	return pkgConstants.InternalError
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// maxStackDepth limits the number of frames captured per error
+const maxStackDepth = 32
+
+var stackCaptureEnabled atomic.Bool
+
+// EnableStackCapture turns stack capture on or off for newly created errors.
+// Capturing is meant for debug environments; it is off by default.
+func EnableStackCapture(enabled bool) {
+	stackCaptureEnabled.Store(enabled)
+}
+
+// stackOf reuses the stack of a wrapped CustomError so the origin is kept,
+// or captures the current stack when capturing is enabled
+func stackOf(cause error) []uintptr {
+	if !stackCaptureEnabled.Load() {
+		return nil
+	}
+
+	var customErr *CustomError
+	if errors.As(cause, &customErr) && len(customErr.Stack) > 0 {
+		return customErr.Stack
+	}
+
+	pcs := make([]uintptr, maxStackDepth)
+	// Skip runtime.Callers, stackOf and New/Wrap
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
+
+// StackTrace formats the stack captured where err originated.
+// Returns an empty string when no stack was captured.
+func StackTrace(err error) string {
+	var customErr *CustomError
+	if !errors.As(err, &customErr) || len(customErr.Stack) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	frames := runtime.CallersFrames(customErr.Stack)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
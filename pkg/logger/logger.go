@@ -10,6 +10,8 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/utils"
 )
 
 // Initialize sets up the global logger
@@ -46,79 +48,35 @@ func Initialize(env string) {
 	}
 }
 
-// GetTrIDFromContext extracts TrID from context
-func GetTrIDFromContext(ctx context.Context) string {
-	if ctx == nil {
-		return ""
-	}
-	if trID, ok := ctx.Value(constants.ContextKeyTrID).(string); ok {
-		return trID
-	}
-	return ""
-}
-
-// LogError logs an error with TrID from context
+// LogError logs an error with TrID from context, including its stack when one was captured
 func LogError(ctx context.Context, msg string, err error) {
-	trID := GetTrIDFromContext(ctx)
-	if trID != "" {
-		log.Error().
-			Str("trid", trID).
-			Err(err).
-			Msg(msg)
-	} else {
-		log.Error().
-			Err(err).
-			Msg(msg)
+	event := withContext(ctx, log.Error())
+	if stack := errors.StackTrace(err); stack != "" {
+		event = event.Str("stack", stack)
 	}
+	event.
+		Err(err).
+		Msg(msg)
 }
 
 // LogInfo logs an info message with TrID from context
 func LogInfo(ctx context.Context, msg string) {
-	trID := GetTrIDFromContext(ctx)
-	if trID != "" {
-		log.Info().
-			Str("trid", trID).
-			Msg(msg)
-	} else {
-		log.Info().
-			Msg(msg)
-	}
+	withContext(ctx, log.Info()).Msg(msg)
 }
 
 // LogWarn logs a warning message with TrID from context
 func LogWarn(ctx context.Context, msg string) {
-	trID := GetTrIDFromContext(ctx)
-	if trID != "" {
-		log.Warn().
-			Str("trid", trID).
-			Msg(msg)
-	} else {
-		log.Warn().
-			Msg(msg)
-	}
+	withContext(ctx, log.Warn()).Msg(msg)
 }
 
 // LogDebug logs a debug message with TrID from context
 func LogDebug(ctx context.Context, msg string) {
-	trID := GetTrIDFromContext(ctx)
-	if trID != "" {
-		log.Debug().
-			Str("trid", trID).
-			Msg(msg)
-	} else {
-		log.Debug().
-			Msg(msg)
-	}
+	withContext(ctx, log.Debug()).Msg(msg)
 }
 
 // WithFields returns a logger with additional fields and TrID from context
 func WithFields(ctx context.Context, fields map[string]interface{}) *zerolog.Event {
-	trID := GetTrIDFromContext(ctx)
-	event := log.Info()
-
-	if trID != "" {
-		event = event.Str("trid", trID)
-	}
+	event := withContext(ctx, log.Info())
 
 	for k, v := range fields {
 		event = event.Interface(k, v)
@@ -126,3 +84,19 @@ func WithFields(ctx context.Context, fields map[string]interface{}) *zerolog.Eve
 
 	return event
 }
+
+// withContext adds the TrID and authenticated API key or user ID from context to a log event
+func withContext(ctx context.Context, event *zerolog.Event) *zerolog.Event {
+	if trID := utils.GetTrID(ctx); trID != "" {
+		event = event.Str("trid", trID)
+	}
+	if ctx != nil {
+		if keyID, ok := ctx.Value(constants.ContextKeyAPIKeyID).(string); ok && keyID != "" {
+			event = event.Str("api_key_id", keyID)
+		}
+		if userID, ok := ctx.Value(constants.ContextKeyUserID).(int); ok {
+			event = event.Int("user_id", userID)
+		}
+	}
+	return event
+}
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "simple_chatbot"
+
+// Pipeline stages observed by ObserveStage
+const (
+	StageEmbedding    = "embedding"
+	StageVectorSearch = "vector_search"
+	StageLLM          = "llm"
+)
+
+// Cache lookup results counted by ObserveCache
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route pattern and status code.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"method", "route", "status"})
+
+	stageDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rag_stage_duration_seconds",
+		Help:      "RAG pipeline stage latency (embedding, vector_search, llm).",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"stage"})
+
+	top1Similarity = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "retrieval_top1_similarity",
+		Help:      "Similarity score of the best retrieved knowledge entry.",
+		Buckets:   prometheus.LinearBuckets(0.1, 0.1, 10),
+	})
+
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_lookups_total",
+		Help:      "Cache lookups by cache and result (hit, miss).",
+	}, []string{"cache", "result"})
+
+	ingestionRows = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingestion_rows_total",
+		Help:      "Knowledge rows embedded and saved by ingestion.",
+	})
+
+	promptInjections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "prompt_injections_total",
+		Help:      "Prompt injections detected by source (question, context), detector (heuristic, llm) and action taken.",
+	}, []string{"source", "detector", "action"})
+
+	answerPolicyViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "answer_policy_violations_total",
+		Help:      "Generated answers violating the answer policy by rule and action taken (regenerate, fallback, handoff).",
+	}, []string{"rule", "action"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		stageDuration,
+		top1Similarity,
+		cacheLookups,
+		ingestionRows,
+		promptInjections,
+		answerPolicyViolations,
+	)
+}
+
+// Handler returns the HTTP handler exposing all metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// RegisterDBStats exposes the connection pool stats of a database handle
+func RegisterDBStats(db *sql.DB, dbName string) {
+	registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// ObserveHTTPRequest records a served HTTP request
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveStage records the latency of a RAG pipeline stage
+func ObserveStage(stage string, duration time.Duration) {
+	stageDuration.WithLabelValues(stage).Observe(duration.Seconds())
+}
+
+// ObserveTop1Similarity records the similarity score of the best retrieved entry
+func ObserveTop1Similarity(score float64) {
+	top1Similarity.Observe(score)
+}
+
+// ObserveCache records a cache lookup result (CacheHit or CacheMiss)
+func ObserveCache(cache, result string) {
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+// AddIngestionRows records knowledge rows saved by ingestion
+func AddIngestionRows(n int) {
+	ingestionRows.Add(float64(n))
+}
+
+// ObservePromptInjection records a prompt injection detected by a guardrail
+func ObservePromptInjection(source, detector, action string) {
+	promptInjections.WithLabelValues(source, detector, action).Inc()
+}
+
+// ObserveAnswerPolicyViolation records a generated answer violating a rule of the answer policy
+func ObserveAnswerPolicyViolation(rule, action string) {
+	answerPolicyViolations.WithLabelValues(rule, action).Inc()
+}
@@ -0,0 +1,238 @@
+// Package pii detects personal data (emails, phone numbers, payment card numbers and IBANs) in
+// free text and replaces it with placeholders, either reversibly through a Vault or for good
+// with Mask.
+package pii
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+)
+
+// Kind is a kind of personal data
+type Kind string
+
+const (
+	KindEmail Kind = "EMAIL"
+	KindPhone Kind = "PHONE"
+	KindCard  Kind = "CARD"
+	KindIBAN  Kind = "IBAN"
+)
+
+// detector finds one kind of personal data; valid rejects candidates that only look like it
+type detector struct {
+	kind    Kind
+	pattern *regexp.Regexp
+	valid   func(value string) bool
+}
+
+// detectors run in priority order: a span claimed by one is not matched by the next
+var detectors = []detector{
+	{
+		kind: KindEmail,
+		pattern: regexp.MustCompile(
+			`\b` + strings.TrimSuffix(strings.TrimPrefix(constants.EmailPattern, "^"), "$"),
+		),
+	},
+	{kind: KindIBAN, pattern: regexp.MustCompile(constants.IBANPattern), valid: validIBAN},
+	{kind: KindCard, pattern: regexp.MustCompile(constants.CardPattern), valid: validLuhn},
+	{kind: KindPhone, pattern: regexp.MustCompile(constants.PhonePattern)},
+}
+
+// placeholderPattern matches the placeholders written by Vault.Redact
+var placeholderPattern = regexp.MustCompile(`\[(EMAIL|PHONE|CARD|IBAN)_\d+\]`)
+
+// maxPlaceholderLength bounds how much streamed text is held back waiting for a placeholder's end
+const maxPlaceholderLength = 16
+
+// Match is personal data found in a text
+type Match struct {
+	Kind       Kind
+	Value      string
+	Start, End int // Byte offsets of the value
+}
+
+// Detect returns the personal data found in text, ordered by position
+func Detect(text string) []Match {
+	var matches []Match
+	for _, d := range detectors {
+		for _, loc := range d.pattern.FindAllStringIndex(text, -1) {
+			value := text[loc[0]:loc[1]]
+			if d.valid != nil && !d.valid(value) {
+				continue
+			}
+			overlaps := slices.ContainsFunc(matches, func(m Match) bool {
+				return loc[0] < m.End && m.Start < loc[1]
+			})
+			if !overlaps {
+				matches = append(matches, Match{Kind: d.kind, Value: value, Start: loc[0], End: loc[1]})
+			}
+		}
+	}
+	slices.SortFunc(matches, func(a, b Match) int { return a.Start - b.Start })
+	return matches
+}
+
+// Mask replaces the personal data in text with its kind (e.g. "[EMAIL]"). It suits logs and
+// stored records, where the data never has to be restored.
+func Mask(text string) string {
+	return replace(text, func(m Match) string { return "[" + string(m.Kind) + "]" })
+}
+
+// replace rebuilds text with every match replaced by the result of placeholder
+func replace(text string, placeholder func(Match) string) string {
+	matches := Detect(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		b.WriteString(text[last:m.Start])
+		b.WriteString(placeholder(m))
+		last = m.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// Vault replaces personal data with numbered placeholders (e.g. "[EMAIL_1]") and remembers the
+// originals, so text generated from the redacted text can be personalised again. The same value
+// always gets the same placeholder. A Vault is safe for concurrent use.
+type Vault struct {
+	mu            sync.Mutex
+	byValue       map[string]string
+	byPlaceholder map[string]string
+	counts        map[Kind]int
+}
+
+// NewVault creates an empty vault
+func NewVault() *Vault {
+	return &Vault{
+		byValue:       make(map[string]string),
+		byPlaceholder: make(map[string]string),
+		counts:        make(map[Kind]int),
+	}
+}
+
+// Redact replaces the personal data in text with placeholders
+func (v *Vault) Redact(text string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return replace(text, func(m Match) string {
+		if placeholder, ok := v.byValue[m.Value]; ok {
+			return placeholder
+		}
+		v.counts[m.Kind]++
+		placeholder := fmt.Sprintf("[%s_%d]", m.Kind, v.counts[m.Kind])
+		v.byValue[m.Value] = placeholder
+		v.byPlaceholder[placeholder] = m.Value
+		return placeholder
+	})
+}
+
+// Restore replaces the placeholders of this vault in text with the original values.
+// Placeholders the vault did not write are left as they are.
+func (v *Vault) Restore(text string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		if value, ok := v.byPlaceholder[placeholder]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
+// Len returns the number of distinct values the vault holds
+func (v *Vault) Len() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return len(v.byValue)
+}
+
+// RestoreStream wraps onChunk so streamed chunks are restored before they are emitted. A chunk
+// ending inside a possible placeholder is held back until the placeholder is complete; flush
+// emits whatever is still held back once the stream ends.
+func (v *Vault) RestoreStream(
+	onChunk func(chunk string) error,
+) (emit func(chunk string) error, flush func() error) {
+	var pending string
+	emit = func(chunk string) error {
+		pending += chunk
+		ready := pending
+		if open := strings.LastIndex(pending, "["); open >= 0 &&
+			!strings.Contains(pending[open:], "]") &&
+			len(pending)-open < maxPlaceholderLength {
+			ready = pending[:open]
+		}
+		pending = pending[len(ready):]
+		if ready == "" {
+			return nil
+		}
+		return onChunk(v.Restore(ready))
+	}
+	flush = func() error {
+		if pending == "" {
+			return nil
+		}
+		rest := pending
+		pending = ""
+		return onChunk(v.Restore(rest))
+	}
+	return emit, flush
+}
+
+// validLuhn reports whether the digits of value pass the Luhn checksum of card numbers
+func validLuhn(value string) bool {
+	sum, double, digits := 0, false, 0
+	for i := len(value) - 1; i >= 0; i-- {
+		c := value[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
+
+// validIBAN reports whether value passes the ISO 13616 mod-97 check of IBANs
+func validIBAN(value string) bool {
+	iban := strings.ReplaceAll(value, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	// Move the country code and check digits to the end and convert letters to numbers
+	var numeric strings.Builder
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			numeric.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			fmt.Fprintf(&numeric, "%d", c-'A'+10)
+		default:
+			return false
+		}
+	}
+
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
@@ -0,0 +1,171 @@
+package pii
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	type found struct {
+		Kind  Kind
+		Value string
+	}
+	tests := []struct {
+		name string
+		text string
+		want []found
+	}{
+		// Emails
+		{
+			name: "email",
+			text: "Write to jane.doe+orders@example.co.uk please",
+			want: []found{{KindEmail, "jane.doe+orders@example.co.uk"}},
+		},
+		{name: "at sign without domain", text: "Meet me @ the store, user@localhost"},
+
+		// Phone numbers
+		{
+			name: "phone with country code",
+			text: "Call me on +1 555 123 4567",
+			want: []found{{KindPhone, "+1 555 123 4567"}},
+		},
+		{
+			name: "phone with area code in parentheses",
+			text: "My number is (555) 123-4567.",
+			want: []found{{KindPhone, "(555) 123-4567"}},
+		},
+		{
+			name: "phone with dashes",
+			text: "555-123-4567",
+			want: []found{{KindPhone, "555-123-4567"}},
+		},
+		{
+			name: "compact international phone",
+			text: "whatsapp +447911123456",
+			want: []found{{KindPhone, "+447911123456"}},
+		},
+		{name: "ip address", text: "The server is at 192.168.100.200"},
+		{name: "amount", text: "We raised 10 000 000 last year"},
+		{name: "tail of a longer id", text: "Tracking ID 98765432-123-4567"},
+		{name: "order number", text: "Order 12345 shipped on 2024-10-19"},
+
+		// Card numbers
+		{
+			name: "card with spaces",
+			text: "Card 4111 1111 1111 1111 was charged",
+			want: []found{{KindCard, "4111 1111 1111 1111"}},
+		},
+		{
+			name: "amex without separators",
+			text: "378282246310005",
+			want: []found{{KindCard, "378282246310005"}},
+		},
+		{name: "card failing luhn", text: "Reference 4111111111111112"},
+
+		// IBANs
+		{
+			name: "iban with spaces",
+			text: "Refund to DE89 3704 0044 0532 0130 00 please",
+			want: []found{{KindIBAN, "DE89 3704 0044 0532 0130 00"}},
+		},
+		{
+			name: "iban without spaces",
+			text: "GB82WEST12345698765432",
+			want: []found{{KindIBAN, "GB82WEST12345698765432"}},
+		},
+		{name: "iban failing mod 97", text: "NL92ABNA0417164300"},
+		{name: "sku that looks like an iban", text: "SKU AB12 CDEF GHIJ"},
+
+		// Several kinds
+		{
+			name: "ordered by position",
+			text: "+1 555 123 4567 or jane@example.com",
+			want: []found{{KindPhone, "+1 555 123 4567"}, {KindEmail, "jane@example.com"}},
+		},
+		{name: "no personal data", text: "Where is my order?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []found
+			for _, m := range Detect(tt.text) {
+				got = append(got, found{m.Kind, m.Value})
+				if tt.text[m.Start:m.End] != m.Value {
+					t.Errorf("match %q has offsets [%d:%d] pointing at %q", m.Value, m.Start, m.End, tt.text[m.Start:m.End])
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Detect(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidLuhn(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"4111111111111111", true},
+		{"4111 1111 1111 1111", true},
+		{"5500-0000-0000-0004", true},
+		{"378282246310005", true},
+		{"4111111111111112", false},
+		{"0000000000000000000", true},
+		{"000000000000", false},         // Too short for a card
+		{"41111111111111111111", false}, // Too long for a card
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := validLuhn(tt.value); got != tt.want {
+				t.Errorf("validLuhn(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidIBAN(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"DE89370400440532013000", true},
+		{"DE89 3704 0044 0532 0130 00", true},
+		{"GB82 WEST 1234 5698 7654 32", true},
+		{"NL91ABNA0417164300", true},
+		{"DE89370400440532013001", false},
+		{"GB82 WEST 1234 5698 7654 33", false},
+		{"DE8937040044", false}, // Too short
+		{"de89370400440532013000", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := validIBAN(tt.value); got != tt.want {
+				t.Errorf("validIBAN(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMask(t *testing.T) {
+	text := "Email jane@example.com, call +1 555 123 4567, server 192.168.100.200"
+	want := "Email [EMAIL], call [PHONE], server 192.168.100.200"
+	if got := Mask(text); got != want {
+		t.Errorf("Mask(%q) = %q, want %q", text, got, want)
+	}
+}
+
+func TestVaultRedactRestore(t *testing.T) {
+	v := NewVault()
+	redacted := v.Redact("I am jane@example.com, again jane@example.com, card 4111 1111 1111 1111")
+	want := "I am [EMAIL_1], again [EMAIL_1], card [CARD_1]"
+	if redacted != want {
+		t.Fatalf("Redact() = %q, want %q", redacted, want)
+	}
+	if got := v.Restore("Sent to [EMAIL_1], not [EMAIL_2]"); got != "Sent to jane@example.com, not [EMAIL_2]" {
+		t.Errorf("Restore() = %q", got)
+	}
+}
@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a thread-safe token bucket rate limiter.
+// Tokens are refilled continuously at a fixed rate up to the bucket capacity.
+type TokenBucket struct {
+	mu           sync.Mutex
+	capacity     float64
+	tokens       float64
+	refillPerSec float64
+	last         time.Time
+}
+
+// NewTokenBucket creates a full token bucket with the given capacity and refill rate
+func NewTokenBucket(capacity int, refillPerSecond float64) *TokenBucket {
+	return &TokenBucket{
+		capacity:     float64(capacity),
+		tokens:       float64(capacity),
+		refillPerSec: refillPerSecond,
+		last:         time.Now(),
+	}
+}
+
+// NewPerMinute creates a token bucket allowing n tokens per minute
+func NewPerMinute(n int) *TokenBucket {
+	return NewTokenBucket(n, float64(n)/60.0)
+}
+
+// Reserve takes n tokens from the bucket and returns how long the caller must wait
+// before the reservation is satisfied. Requests larger than the capacity are clamped
+// to the capacity so they can still proceed once the bucket is full.
+func (b *TokenBucket) Reserve(n int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.refill(now)
+
+	need := math.Min(float64(n), b.capacity)
+	b.tokens -= need
+	if b.tokens >= 0 || b.refillPerSec <= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.refillPerSec * float64(time.Second))
+}
+
+// TryTake takes n tokens only if they are all available. It reports whether the tokens were
+// taken, how many whole tokens remain and, when refused, how long until n tokens are available.
+func (b *TokenBucket) TryTake(n int) (bool, int, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+
+	need := float64(n)
+	if b.tokens >= need {
+		b.tokens -= need
+		return true, int(b.tokens), 0
+	}
+	if b.refillPerSec <= 0 || need > b.capacity {
+		return false, int(b.tokens), 0
+	}
+	return false, int(b.tokens), time.Duration((need - b.tokens) / b.refillPerSec * float64(time.Second))
+}
+
+// Wait blocks until n tokens are available or the context is done. The tokens are only
+// taken when Wait returns nil; a done context takes none and gives back a pending reservation.
+func (b *TokenBucket) Wait(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := b.Reserve(n)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel(n)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancel gives back the tokens of a reservation that will not be used
+func (b *TokenBucket) cancel(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(time.Now())
+	b.tokens = math.Min(b.capacity, b.tokens+math.Min(float64(n), b.capacity))
+}
+
+// refill adds tokens accrued since the last refill (caller must hold the lock)
+func (b *TokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.refillPerSec)
+		b.last = now
+	}
+}
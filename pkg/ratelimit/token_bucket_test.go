@@ -0,0 +1,192 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// newFrozenBucket returns a bucket holding tokens that does not refill until the test moves
+// its clock, so results do not depend on how fast the test runs
+func newFrozenBucket(capacity int, refillPerSecond, tokens float64) *TokenBucket {
+	b := NewTokenBucket(capacity, refillPerSecond)
+	b.tokens = tokens
+	b.last = time.Now().Add(time.Hour)
+	return b
+}
+
+func TestTokenBucketRefill(t *testing.T) {
+	tests := []struct {
+		name         string
+		capacity     int
+		refillPerSec float64
+		tokens       float64
+		elapsed      time.Duration
+		want         float64
+	}{
+		{name: "empty bucket", capacity: 10, refillPerSec: 2, tokens: 0, elapsed: time.Second, want: 2},
+		{name: "partial second", capacity: 10, refillPerSec: 2, tokens: 1, elapsed: 250 * time.Millisecond, want: 1.5},
+		{name: "capped at capacity", capacity: 10, refillPerSec: 2, tokens: 9, elapsed: time.Minute, want: 10},
+		{name: "repays debt", capacity: 10, refillPerSec: 2, tokens: -3, elapsed: time.Second, want: -1},
+		{name: "no time elapsed", capacity: 10, refillPerSec: 2, tokens: 4, elapsed: 0, want: 4},
+		{name: "clock moved back", capacity: 10, refillPerSec: 2, tokens: 4, elapsed: -time.Second, want: 4},
+		{name: "no refill rate", capacity: 10, refillPerSec: 0, tokens: 4, elapsed: time.Minute, want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewTokenBucket(tt.capacity, tt.refillPerSec)
+			b.tokens = tt.tokens
+			b.refill(b.last.Add(tt.elapsed))
+			if math.Abs(b.tokens-tt.want) > 1e-9 {
+				t.Errorf("tokens after %v = %v, want %v", tt.elapsed, b.tokens, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenBucketTryTake(t *testing.T) {
+	tests := []struct {
+		name          string
+		capacity      int
+		refillPerSec  float64
+		tokens        float64
+		n             int
+		wantTaken     bool
+		wantRemaining int
+		wantRetry     time.Duration
+		wantTokens    float64
+	}{
+		{
+			name: "enough tokens", capacity: 10, refillPerSec: 1, tokens: 5, n: 2,
+			wantTaken: true, wantRemaining: 3, wantTokens: 3,
+		},
+		{
+			name: "exactly enough", capacity: 10, refillPerSec: 1, tokens: 2, n: 2,
+			wantTaken: true, wantRemaining: 0, wantTokens: 0,
+		},
+		{
+			name: "remaining rounds down", capacity: 10, refillPerSec: 1, tokens: 3.75, n: 1,
+			wantTaken: true, wantRemaining: 2, wantTokens: 2.75,
+		},
+		{
+			name: "short retries once refilled", capacity: 10, refillPerSec: 2, tokens: 0.5, n: 2,
+			wantRetry: 750 * time.Millisecond, wantTokens: 0.5,
+		},
+		{
+			name: "empty retries after one token", capacity: 60, refillPerSec: 1, tokens: 0, n: 1,
+			wantRetry: time.Second,
+		},
+		{
+			name: "more than capacity never succeeds", capacity: 4, refillPerSec: 1, tokens: 4, n: 5,
+			wantRemaining: 4, wantTokens: 4,
+		},
+		{
+			name: "no refill rate never succeeds", capacity: 4, refillPerSec: 0, tokens: 0, n: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newFrozenBucket(tt.capacity, tt.refillPerSec, tt.tokens)
+			taken, remaining, retry := b.TryTake(tt.n)
+			if taken != tt.wantTaken || remaining != tt.wantRemaining || retry != tt.wantRetry {
+				t.Errorf(
+					"TryTake(%d) = (%v, %d, %v), want (%v, %d, %v)",
+					tt.n, taken, remaining, retry, tt.wantTaken, tt.wantRemaining, tt.wantRetry,
+				)
+			}
+			if b.tokens != tt.wantTokens {
+				t.Errorf("tokens after TryTake(%d) = %v, want %v", tt.n, b.tokens, tt.wantTokens)
+			}
+		})
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	tests := []struct {
+		name         string
+		capacity     int
+		refillPerSec float64
+		tokens       float64
+		n            int
+		wantDelay    time.Duration
+		wantTokens   float64
+	}{
+		{name: "available", capacity: 10, refillPerSec: 2, tokens: 5, n: 3, wantDelay: 0, wantTokens: 2},
+		{name: "borrows from the refill", capacity: 10, refillPerSec: 2, tokens: 1, n: 3, wantDelay: time.Second, wantTokens: -2},
+		{name: "queued behind a debt", capacity: 10, refillPerSec: 2, tokens: -2, n: 2, wantDelay: 2 * time.Second, wantTokens: -4},
+		{name: "clamped to capacity", capacity: 4, refillPerSec: 1, tokens: 4, n: 10, wantDelay: 0, wantTokens: 0},
+		{name: "no refill rate", capacity: 4, refillPerSec: 0, tokens: 0, n: 1, wantDelay: 0, wantTokens: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newFrozenBucket(tt.capacity, tt.refillPerSec, tt.tokens)
+			if delay := b.Reserve(tt.n); delay != tt.wantDelay {
+				t.Errorf("Reserve(%d) = %v, want %v", tt.n, delay, tt.wantDelay)
+			}
+			if b.tokens != tt.wantTokens {
+				t.Errorf("tokens after Reserve(%d) = %v, want %v", tt.n, b.tokens, tt.wantTokens)
+			}
+		})
+	}
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name       string
+		ctx        func() (context.Context, context.CancelFunc)
+		tokens     float64
+		n          int
+		wantErr    error
+		wantTokens float64
+	}{
+		{
+			name:       "available",
+			ctx:        func() (context.Context, context.CancelFunc) { return context.Background(), func() {} },
+			tokens:     5,
+			n:          2,
+			wantTokens: 3,
+		},
+		{
+			name:       "cancelled before waiting takes nothing",
+			ctx:        func() (context.Context, context.CancelFunc) { return cancelled, func() {} },
+			tokens:     5,
+			n:          2,
+			wantErr:    context.Canceled,
+			wantTokens: 5,
+		},
+		{
+			name: "cancelled while waiting gives the tokens back",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			tokens:     0,
+			n:          2,
+			wantErr:    context.DeadlineExceeded,
+			wantTokens: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// One token per hour, so a short wait never completes
+			b := newFrozenBucket(10, 1.0/3600, tt.tokens)
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			if err := b.Wait(ctx, tt.n); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Wait(%d) error = %v, want %v", tt.n, err, tt.wantErr)
+			}
+			if b.tokens != tt.wantTokens {
+				t.Errorf("tokens after Wait(%d) = %v, want %v", tt.n, b.tokens, tt.wantTokens)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/wonjinsin/simple-chatbot/pkg/constants"
+)
+
+// GetTrID extracts TrID from context
+func GetTrID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if trID, ok := ctx.Value(constants.ContextKeyTrID).(string); ok {
+		return trID
+	}
+	return ""
+}
+
+// GetUserID extracts the authenticated end-user ID from context
+func GetUserID(ctx context.Context) (int, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	userID, ok := ctx.Value(constants.ContextKeyUserID).(int)
+	return userID, ok
+}
+
+// GetAPIKeyID extracts the authenticated API key ID from context
+func GetAPIKeyID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if keyID, ok := ctx.Value(constants.ContextKeyAPIKeyID).(string); ok {
+		return keyID
+	}
+	return ""
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/wonjinsin/simple-chatbot/pkg/constants"
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
+	"github.com/wonjinsin/simple-chatbot/pkg/tracing"
 )
 
 // ParsePagination extracts pagination parameters from HTTP request
@@ -57,9 +58,10 @@ func ExtractPathParam(path, prefix string) string {
 
 // StandardResponse represents the standard HTTP response format
 type StandardResponse struct {
-	TrID   string `json:"trid"`
-	Code   string `json:"code"`
-	Result any    `json:"result,omitempty"`
+	TrID    string `json:"trid"`
+	TraceID string `json:"trace_id,omitempty"`
+	Code    string `json:"code"`
+	Result  any    `json:"result,omitempty"`
 }
 
 // WriteStandardJSON writes a standard JSON response with TrID
@@ -72,12 +74,7 @@ func WriteStandardJSON(
 	customCode ...string,
 ) {
 	// Get TrID from context
-	trID := ""
-	if ctx := r.Context(); ctx != nil {
-		if id, ok := ctx.Value(constants.ContextKeyTrID).(string); ok {
-			trID = id
-		}
-	}
+	trID := GetTrID(r.Context())
 
 	// Determine response code: use custom code if provided, otherwise format HTTP status
 	var codeStr string
@@ -89,9 +86,10 @@ func WriteStandardJSON(
 
 	// Create standard response using struct (preserves field order)
 	response := StandardResponse{
-		TrID:   trID,
-		Code:   codeStr,
-		Result: result,
+		TrID:    trID,
+		TraceID: tracing.TraceID(r.Context()),
+		Code:    codeStr,
+		Result:  result,
 	}
 
 	w.Header().Set(constants.HeaderContentType, constants.ContentTypeJSONCharset)
@@ -101,3 +99,10 @@ func WriteStandardJSON(
 		log.Printf("json encode error: %v", err)
 	}
 }
+
+// WriteErrorJSON writes a standard JSON error response.
+// The HTTP status and response code are derived from the error's code.
+func WriteErrorJSON(w http.ResponseWriter, r *http.Request, err error, result any) {
+	code := errors.GetCode(err)
+	WriteStandardJSON(w, r, errors.CodeHTTPStatus(code), result, string(code))
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"regexp"
 	"time"
 
 	"github.com/wonjinsin/simple-chatbot/internal/constants"
@@ -10,6 +11,8 @@ import (
 	"github.com/wonjinsin/simple-chatbot/pkg/errors"
 )
 
+var trIDRegex = regexp.MustCompile(pkgConstants.TrIDPattern)
+
 // GenerateID generates a unique ID using timestamp and counter
 func GenerateID(counter int64) string {
 	n := time.Now().UnixNano()
@@ -43,3 +46,8 @@ func GenerateRandomID(length int) (string, error) {
 
 	return base64.URLEncoding.EncodeToString(bytes)[:length], nil
 }
+
+// IsValidTrID checks if an inbound transaction ID is short and only uses safe characters
+func IsValidTrID(trID string) bool {
+	return len(trID) <= pkgConstants.MaxTrIDLength && trIDRegex.MatchString(trID)
+}
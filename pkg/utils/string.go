@@ -50,3 +50,13 @@ func NormalizeName(name string) string {
 func IsEmptyOrWhitespace(s string) bool {
 	return len(strings.TrimSpace(s)) == 0
 }
+
+// EstimateTokens roughly estimates the number of LLM tokens in the given texts
+// using the common heuristic of ~4 characters per token
+func EstimateTokens(texts ...string) int {
+	chars := 0
+	for _, text := range texts {
+		chars += len(text)
+	}
+	return (chars + 3) / 4
+}